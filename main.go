@@ -5,15 +5,19 @@ import (
 	"flag"
 	"log"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
-	tfmux "github.com/hashicorp/terraform-plugin-mux"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-provider-tfe/tfe"
 )
 
 const (
 	tfeProviderName = "registry.terraform.io/hashicorp/tfe"
+
+	tracingShutdownTimeout = 10 * time.Second
 )
 
 func main() {
@@ -34,23 +38,37 @@ func main() {
 	// terraform-plugin-mux here is used to combine multiple Terraform providers
 	// built using different SDK and frameworks in order to combine them into a
 	// single logical provider for Terraform to work with.
-	// Here, we use one provider (tfe.Provider) that relies on the standard
-	// terraform-plugin-sdk, and this is the main framework for used in this
-	// provider. The second provider (tfe.PluginProviderServer) relies on the
+	// Here, we use one provider (tfe.ProviderServer, serving tfe.Provider)
+	// that relies on the standard terraform-plugin-sdk, and this is the main
+	// framework for used in this provider. The second provider (tfe.PluginProviderServer) relies on the
 	// lower level terraform-plugin-go to handle far more complex behavior, and
 	// only should be used for functionality that is not present in the
 	// common terraform-plugin- sdk framework.
-	mux, err := tfmux.NewSchemaServerFactory(
-		ctx, tfe.Provider().GRPCProvider, tfe.PluginProviderServer,
+	// The third provider (tfe.FrameworkProvider) relies on
+	// terraform-plugin-framework and implements the ephemeral resources, which
+	// the other two do not support.
+	mux, err := tf5muxserver.NewMuxServer(
+		ctx,
+		tfe.ProviderServer,
+		tfe.PluginProviderServer,
+		providerserver.NewProtocol5(tfe.FrameworkProvider()),
 	)
 	if err != nil {
-		log.Printf("[ERROR] Could not setup a NewSchemaServerFactory using the providers: %v", err)
+		log.Printf("[ERROR] Could not setup a NewMuxServer using the providers: %v", err)
 		os.Exit(1)
 	}
 
 	err = tf5server.Serve(tfeProviderName, func() tfprotov5.ProviderServer {
-		return mux.Server()
+		return mux.ProviderServer()
 	}, serveOpts...)
+
+	// Export the traces of API calls which are still queued before exiting.
+	shutdownCtx, cancel := context.WithTimeout(ctx, tracingShutdownTimeout)
+	defer cancel()
+	if shutdownErr := tfe.ShutdownTracing(shutdownCtx); shutdownErr != nil {
+		log.Printf("[WARN] Could not export the remaining API call traces: %v", shutdownErr)
+	}
+
 	if err != nil {
 		log.Printf("[ERROR] Could not start serving the ProviderServer: %v", err)
 		os.Exit(1)
@@ -0,0 +1,338 @@
+// Package testhelper provides helpers to run acceptance and unit tests of
+// Terraform Cloud and Terraform Enterprise configurations.
+//
+// CallbackServer is a stub receiver for run task and notification webhooks.
+// Run tasks pointed at the /pass or /fail path report a passed or failed
+// result back to Terraform Cloud, and notifications sent to the
+// /notifications path are recorded, so tests can assert on the callbacks
+// that were received.
+//
+// FixtureServer is a stub of the Terraform Cloud API for unit tests, and
+// RequestRecorder records the requests sent by a client.
+package testhelper
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+const (
+	// RunTaskSignatureHeader holds the HMAC signature of run task requests.
+	RunTaskSignatureHeader = "X-TFC-Task-Signature"
+
+	// NotificationSignatureHeader holds the HMAC signature of notifications.
+	NotificationSignatureHeader = "X-TFE-Notification-Signature"
+
+	// TaskResultPassed and TaskResultFailed are the statuses a run task can
+	// report back.
+	TaskResultPassed = "passed"
+	TaskResultFailed = "failed"
+)
+
+// RunTaskRequest is the payload Terraform Cloud sends to a run task.
+type RunTaskRequest struct {
+	PayloadVersion             int    `json:"payload_version"`
+	AccessToken                string `json:"access_token"`
+	Stage                      string `json:"stage"`
+	IsSpeculative              bool   `json:"is_speculative"`
+	TaskResultID               string `json:"task_result_id"`
+	TaskResultEnforcementLevel string `json:"task_result_enforcement_level"`
+	TaskResultCallbackURL      string `json:"task_result_callback_url"`
+	RunAppURL                  string `json:"run_app_url"`
+	RunID                      string `json:"run_id"`
+	RunMessage                 string `json:"run_message"`
+	RunCreatedAt               string `json:"run_created_at"`
+	RunCreatedBy               string `json:"run_created_by"`
+	WorkspaceID                string `json:"workspace_id"`
+	WorkspaceName              string `json:"workspace_name"`
+	WorkspaceAppURL            string `json:"workspace_app_url"`
+	OrganizationName           string `json:"organization_name"`
+	PlanJSONAPIURL             string `json:"plan_json_api_url"`
+}
+
+// IsVerification reports whether the request is the test request Terraform
+// Cloud sends when a run task is created or updated. No result is reported
+// back for these requests.
+func (r RunTaskRequest) IsVerification() bool {
+	return r.Stage == "test" || r.AccessToken == "test-token"
+}
+
+// NotificationPayload is the payload Terraform Cloud sends to a generic
+// notification destination.
+type NotificationPayload struct {
+	PayloadVersion              int            `json:"payload_version"`
+	NotificationConfigurationID string         `json:"notification_configuration_id"`
+	RunURL                      string         `json:"run_url"`
+	RunID                       string         `json:"run_id"`
+	RunMessage                  string         `json:"run_message"`
+	RunCreatedAt                string         `json:"run_created_at"`
+	RunCreatedBy                string         `json:"run_created_by"`
+	WorkspaceID                 string         `json:"workspace_id"`
+	WorkspaceName               string         `json:"workspace_name"`
+	OrganizationName            string         `json:"organization_name"`
+	Notifications               []Notification `json:"notifications"`
+}
+
+// Notification is a single notification within a payload.
+type Notification struct {
+	Message      string `json:"message"`
+	Trigger      string `json:"trigger"`
+	RunStatus    string `json:"run_status"`
+	RunUpdatedAt string `json:"run_updated_at"`
+	RunUpdatedBy string `json:"run_updated_by"`
+}
+
+// IsVerification reports whether the payload is the test notification
+// Terraform Cloud sends when a notification configuration is verified.
+func (p NotificationPayload) IsVerification() bool {
+	for _, n := range p.Notifications {
+		if n.Trigger == "verification" {
+			return true
+		}
+	}
+	return false
+}
+
+// Options configures a callback handler.
+type Options struct {
+	// HMACKey, when set, is used to verify the signature of incoming run
+	// task requests and notifications. Requests with an invalid signature
+	// are rejected.
+	HMACKey string
+
+	// Message is reported back together with the run task result.
+	Message string
+
+	// HTTPClient is used to report run task results. Defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// CallbackHandler is an http.Handler receiving run task requests and
+// notifications. It can be mounted in any server reachable by Terraform
+// Cloud, or served by NewCallbackServer.
+type CallbackHandler struct {
+	options Options
+
+	mu            sync.Mutex
+	runTasks      []RunTaskRequest
+	notifications []NotificationPayload
+	errors        []error
+	callbacks     sync.WaitGroup
+}
+
+// NewCallbackHandler returns a callback handler with the given options.
+func NewCallbackHandler(options Options) *CallbackHandler {
+	if options.HTTPClient == nil {
+		options.HTTPClient = http.DefaultClient
+	}
+	return &CallbackHandler{options: options}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *CallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/pass":
+		h.serveRunTask(w, r, body, TaskResultPassed)
+	case "/fail":
+		h.serveRunTask(w, r, body, TaskResultFailed)
+	case "/notifications":
+		h.serveNotification(w, r, body)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (h *CallbackHandler) serveRunTask(w http.ResponseWriter, r *http.Request, body []byte, status string) {
+	if !h.validSignature(r.Header.Get(RunTaskSignatureHeader), body) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var req RunTaskRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	h.mu.Lock()
+	h.runTasks = append(h.runTasks, req)
+	h.mu.Unlock()
+
+	w.WriteHeader(http.StatusOK)
+
+	if req.IsVerification() || req.TaskResultCallbackURL == "" {
+		return
+	}
+
+	// Terraform Cloud expects the request to be acknowledged right away, and
+	// the result to be reported back afterwards.
+	h.callbacks.Add(1)
+	go func() {
+		defer h.callbacks.Done()
+		if err := h.reportResult(req, status); err != nil {
+			h.mu.Lock()
+			h.errors = append(h.errors, err)
+			h.mu.Unlock()
+		}
+	}()
+}
+
+func (h *CallbackHandler) serveNotification(w http.ResponseWriter, r *http.Request, body []byte) {
+	if !h.validSignature(r.Header.Get(NotificationSignatureHeader), body) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var payload NotificationPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	h.mu.Lock()
+	h.notifications = append(h.notifications, payload)
+	h.mu.Unlock()
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// reportResult reports the result of a run task to its callback URL.
+func (h *CallbackHandler) reportResult(req RunTaskRequest, status string) error {
+	result := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "task-results",
+			"attributes": map[string]interface{}{
+				"status":  status,
+				"message": h.options.Message,
+			},
+		},
+	}
+
+	body, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	callback, err := http.NewRequest(http.MethodPatch, req.TaskResultCallbackURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	callback.Header.Set("Authorization", "Bearer "+req.AccessToken)
+	callback.Header.Set("Content-Type", "application/vnd.api+json")
+
+	resp, err := h.options.HTTPClient.Do(callback)
+	if err != nil {
+		return fmt.Errorf("Error reporting result of task %s: %w", req.TaskResultID, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("Error reporting result of task %s: unexpected status %s", req.TaskResultID, resp.Status)
+	}
+
+	return nil
+}
+
+// validSignature verifies the HMAC-SHA512 signature of a request body.
+func (h *CallbackHandler) validSignature(signature string, body []byte) bool {
+	if h.options.HMACKey == "" {
+		return true
+	}
+
+	mac := hmac.New(sha512.New, []byte(h.options.HMACKey))
+	mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
+
+// RunTaskRequests returns the run task requests received so far, including
+// verification requests.
+func (h *CallbackHandler) RunTaskRequests() []RunTaskRequest {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]RunTaskRequest(nil), h.runTasks...)
+}
+
+// Notifications returns the notifications received so far, including
+// verification notifications.
+func (h *CallbackHandler) Notifications() []NotificationPayload {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]NotificationPayload(nil), h.notifications...)
+}
+
+// Wait blocks until all pending run task results are reported, and returns
+// the errors that occurred while reporting them.
+func (h *CallbackHandler) Wait() []error {
+	h.callbacks.Wait()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]error(nil), h.errors...)
+}
+
+// CallbackServer serves a CallbackHandler on a local address.
+type CallbackServer struct {
+	*CallbackHandler
+
+	// URL is the base URL of the server, e.g. http://127.0.0.1:50123.
+	URL string
+}
+
+// NewCallbackServer starts a callback server which is closed when the test
+// finishes. Terraform Cloud must be able to reach the server, so it is most
+// useful with Terraform Enterprise on the same network, or behind a tunnel.
+func NewCallbackServer(t testing.TB, options Options) *CallbackServer {
+	t.Helper()
+
+	handler := NewCallbackHandler(options)
+	server := httptest.NewServer(handler)
+	t.Cleanup(func() {
+		server.Close()
+		for _, err := range handler.Wait() {
+			t.Errorf("run task callback: %s", err)
+		}
+	})
+
+	return &CallbackServer{
+		CallbackHandler: handler,
+		URL:             server.URL,
+	}
+}
+
+// PassURL returns the URL of a run task which passes.
+func (s *CallbackServer) PassURL() string {
+	return s.URL + "/pass"
+}
+
+// FailURL returns the URL of a run task which fails.
+func (s *CallbackServer) FailURL() string {
+	return s.URL + "/fail"
+}
+
+// NotificationsURL returns the URL of a generic notification destination.
+func (s *CallbackServer) NotificationsURL() string {
+	return s.URL + "/notifications"
+}
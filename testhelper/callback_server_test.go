@@ -0,0 +1,168 @@
+package testhelper
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func sign(key string, body []byte) string {
+	mac := hmac.New(sha512.New, []byte(key))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func post(t *testing.T, url, header, signature string, payload interface{}) int {
+	t.Helper()
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signature != "" {
+		req.Header.Set(header, signature)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	return resp.StatusCode
+}
+
+func TestCallbackServer_runTask(t *testing.T) {
+	type result struct {
+		authorization string
+		status        string
+	}
+	results := make(chan result, 2)
+
+	tfc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		var callback struct {
+			Data struct {
+				Attributes struct {
+					Status string `json:"status"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(body, &callback); err != nil {
+			t.Errorf("unexpected callback body %s: %v", body, err)
+		}
+
+		results <- result{
+			authorization: r.Header.Get("Authorization"),
+			status:        callback.Data.Attributes.Status,
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(tfc.Close)
+
+	server := NewCallbackServer(t, Options{Message: "stub"})
+
+	cases := map[string]struct {
+		url    string
+		status string
+	}{
+		"pass": {
+			url:    server.PassURL(),
+			status: TaskResultPassed,
+		},
+		"fail": {
+			url:    server.FailURL(),
+			status: TaskResultFailed,
+		},
+	}
+
+	for name, tc := range cases {
+		code := post(t, tc.url, "", "", RunTaskRequest{
+			AccessToken:           "secret",
+			Stage:                 "post_plan",
+			TaskResultID:          "taskrs-" + name,
+			TaskResultCallbackURL: tfc.URL + "/api/v2/task-results/taskrs-" + name + "/callback",
+		})
+		if code != http.StatusOK {
+			t.Fatalf("%s: expected status 200, got %d", name, code)
+		}
+
+		r := <-results
+		if r.authorization != "Bearer secret" {
+			t.Fatalf("%s: unexpected authorization %q", name, r.authorization)
+		}
+		if r.status != tc.status {
+			t.Fatalf("%s: expected status %s, got %s", name, tc.status, r.status)
+		}
+	}
+
+	if errs := server.Wait(); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if requests := server.RunTaskRequests(); len(requests) != 2 {
+		t.Fatalf("expected 2 run task requests, got %d", len(requests))
+	}
+}
+
+func TestCallbackServer_runTaskVerification(t *testing.T) {
+	server := NewCallbackServer(t, Options{})
+
+	code := post(t, server.PassURL(), "", "", RunTaskRequest{
+		AccessToken: "test-token",
+		Stage:       "test",
+		// The callback URL is unreachable, so reporting a result would fail.
+		TaskResultCallbackURL: "http://127.0.0.1:0/callback",
+	})
+	if code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", code)
+	}
+
+	if errs := server.Wait(); len(errs) > 0 {
+		t.Fatalf("expected no result to be reported, got %v", errs)
+	}
+
+	requests := server.RunTaskRequests()
+	if len(requests) != 1 || !requests[0].IsVerification() {
+		t.Fatalf("expected a single verification request, got %+v", requests)
+	}
+}
+
+func TestCallbackServer_notifications(t *testing.T) {
+	server := NewCallbackServer(t, Options{HMACKey: "hmac-key"})
+
+	payload := NotificationPayload{
+		PayloadVersion: 1,
+		Notifications: []Notification{
+			{Trigger: "verification"},
+		},
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if code := post(t, server.NotificationsURL(), NotificationSignatureHeader, "invalid", payload); code != http.StatusUnauthorized {
+		t.Fatalf("expected status 401 for an invalid signature, got %d", code)
+	}
+
+	if code := post(t, server.NotificationsURL(), NotificationSignatureHeader, sign("hmac-key", body), payload); code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", code)
+	}
+
+	notifications := server.Notifications()
+	if len(notifications) != 1 || !notifications[0].IsVerification() {
+		t.Fatalf("expected a single verification notification, got %+v", notifications)
+	}
+}
@@ -0,0 +1,50 @@
+package testhelper
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// FixtureServer is a stub of the Terraform Cloud API for unit tests. It
+// answers the ping request the go-tfe client sends when it is created, and
+// passes all other requests to the handler of the test.
+type FixtureServer struct {
+	// URL is the base URL of the server, e.g. http://127.0.0.1:50123.
+	URL string
+
+	// Client is a go-tfe client sending its requests to the server.
+	Client *tfe.Client
+}
+
+// NewFixtureServer starts a fixture server which is closed when the test
+// finishes. Responses have the JSON:API content type, unless the handler
+// sets another one.
+func NewFixtureServer(t testing.TB, handler http.Handler) *FixtureServer {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := tfe.NewClient(&tfe.Config{
+		Address: server.URL,
+		Token:   "not-a-token",
+	})
+	if err != nil {
+		t.Fatalf("error creating tfe client: %v", err)
+	}
+
+	return &FixtureServer{
+		URL:    server.URL,
+		Client: client,
+	}
+}
@@ -0,0 +1,31 @@
+package testhelper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestFixtureServer(t *testing.T) {
+	server := NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/hashicorp":
+			fmt.Fprint(w, `{"data":{"id":"hashicorp","type":"organizations","attributes":{"name":"hashicorp"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	org, err := server.Client.Organizations.Read(context.Background(), "hashicorp")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if org.Name != "hashicorp" {
+		t.Fatalf("expected organization hashicorp, got %s", org.Name)
+	}
+
+	if _, err := server.Client.Organizations.Read(context.Background(), "missing"); err == nil {
+		t.Fatal("expected an error for a missing fixture")
+	}
+}
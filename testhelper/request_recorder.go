@@ -0,0 +1,38 @@
+package testhelper
+
+import (
+	"net/http"
+	"sync"
+)
+
+// RequestRecorder is a transport which records the method and path of all
+// requests sent through it, so tests can assert which API requests are made.
+type RequestRecorder struct {
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	requests []string
+}
+
+// NewRequestRecorder returns a recorder sending the requests through the
+// given transport.
+func NewRequestRecorder(transport http.RoundTripper) *RequestRecorder {
+	return &RequestRecorder{transport: transport}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *RequestRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	r.requests = append(r.requests, req.Method+" "+req.URL.Path)
+	r.mu.Unlock()
+
+	return r.transport.RoundTrip(req)
+}
+
+// Requests returns the recorded requests in the order they were sent.
+func (r *RequestRecorder) Requests() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.requests...)
+}
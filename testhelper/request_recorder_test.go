@@ -0,0 +1,34 @@
+package testhelper
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	recorder := NewRequestRecorder(http.DefaultTransport)
+	client := &http.Client{Transport: recorder}
+
+	for _, path := range []string{"/api/v2/ping", "/api/v2/organizations"} {
+		resp, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	want := []string{
+		"GET /api/v2/ping",
+		"GET /api/v2/organizations",
+	}
+	if got := recorder.Requests(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("wrong requests\ngot: %v\nwant: %v", got, want)
+	}
+}
@@ -0,0 +1,580 @@
+package tfe
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// adminToolVersion is a Terraform, OPA or Sentinel version managed through
+// the Admin API. go-tfe does not know about OPA and Sentinel versions, nor
+// about the architectures of a version yet. All tools share the same
+// attributes and only differ in the resource type, which jsonapi can not set
+// dynamically, so the documents are written and read as plain JSON.
+type adminToolVersion struct {
+	Data adminToolVersionData `json:"data"`
+}
+
+type adminToolVersionData struct {
+	ID         string                     `json:"id,omitempty"`
+	Type       string                     `json:"type"`
+	Attributes adminToolVersionAttributes `json:"attributes"`
+}
+
+type adminToolVersionAttributes struct {
+	Version          string                          `json:"version"`
+	URL              string                          `json:"url,omitempty"`
+	SHA              string                          `json:"sha,omitempty"`
+	Official         bool                            `json:"official"`
+	Enabled          bool                            `json:"enabled"`
+	Beta             bool                            `json:"beta"`
+	Deprecated       bool                            `json:"deprecated"`
+	DeprecatedReason *string                         `json:"deprecated-reason"`
+	Archs            []*adminToolVersionArchitecture `json:"archs,omitempty"`
+}
+
+// adminToolVersionArchitecture is the binary of a version for a single
+// operating system and architecture.
+type adminToolVersionArchitecture struct {
+	URL  string `json:"url"`
+	SHA  string `json:"sha"`
+	OS   string `json:"os"`
+	Arch string `json:"arch"`
+}
+
+type adminToolVersionListOptions struct {
+	tfe.ListOptions
+
+	Filter string `url:"filter[version],omitempty"`
+}
+
+type adminToolVersionList struct {
+	Data []adminToolVersionData `json:"data"`
+	Meta struct {
+		Pagination *tfe.Pagination `json:"pagination"`
+	} `json:"meta"`
+}
+
+// adminToolVersionSchema returns the schema shared by the Terraform, OPA and
+// Sentinel version resources. Versions of the tools published on the
+// releases site can also be discovered from there.
+func adminToolVersionSchema(kind string) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"version": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"url": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			AtLeastOneOf:  []string{"url", "archs", "from_releases"},
+			RequiredWith:  []string{"sha"},
+			ConflictsWith: []string{"from_releases"},
+		},
+		"sha": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			RequiredWith:  []string{"url"},
+			ConflictsWith: []string{"from_releases"},
+		},
+		"archs": {
+			Type:          schema.TypeSet,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"from_releases"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"url": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"sha": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"os": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "linux",
+					},
+					"arch": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"amd64", "arm64"}, false),
+					},
+				},
+			},
+		},
+		"official": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"beta": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"deprecated": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"deprecated_reason": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	if _, ok := adminToolReleaseProducts[kind]; !ok {
+		s["url"].AtLeastOneOf = []string{"url", "archs"}
+		s["url"].ConflictsWith = nil
+		s["sha"].ConflictsWith = nil
+		s["archs"].ConflictsWith = nil
+		return s
+	}
+
+	s["from_releases"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	// The checksums of the binaries are downloaded from the releases site,
+	// so it must be reached over https.
+	s["releases_url"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "https://releases.hashicorp.com",
+		ValidateFunc: validation.IsURLWithHTTPS,
+	}
+
+	return s
+}
+
+// customizeDiffAdminToolVersionRelease marks the url, sha and archs of a
+// version discovered from the releases site as unknown when they are
+// discovered again.
+func customizeDiffAdminToolVersionRelease(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("from_releases").(bool) || !d.HasChanges("version", "from_releases", "releases_url") {
+		return nil
+	}
+
+	for _, key := range []string{"url", "sha", "archs"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expandAdminToolVersion returns the configured version. The url and sha
+// are computed from the amd64 architecture when only archs are configured,
+// so they are only sent when configured to not conflict with changed archs.
+func expandAdminToolVersion(d *schema.ResourceData, kind string) *adminToolVersion {
+	v := &adminToolVersion{
+		Data: adminToolVersionData{
+			Type: kind,
+			Attributes: adminToolVersionAttributes{
+				Version:    d.Get("version").(string),
+				Official:   d.Get("official").(bool),
+				Enabled:    d.Get("enabled").(bool),
+				Beta:       d.Get("beta").(bool),
+				Deprecated: d.Get("deprecated").(bool),
+			},
+		},
+	}
+
+	if reason, ok := d.GetOk("deprecated_reason"); ok {
+		v.Data.Attributes.DeprecatedReason = tfe.String(reason.(string))
+	}
+
+	// Versions discovered from the releases site always send the discovered
+	// url, sha and archs.
+	fromReleases, _ := d.Get("from_releases").(bool)
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.GetAttr("url").IsNull() || fromReleases {
+		v.Data.Attributes.URL = d.Get("url").(string)
+		v.Data.Attributes.SHA = d.Get("sha").(string)
+	}
+	if config.IsNull() || !config.GetAttr("archs").IsNull() || fromReleases {
+		for _, raw := range d.Get("archs").(*schema.Set).List() {
+			arch := raw.(map[string]interface{})
+			v.Data.Attributes.Archs = append(v.Data.Attributes.Archs, &adminToolVersionArchitecture{
+				URL:  arch["url"].(string),
+				SHA:  arch["sha"].(string),
+				OS:   arch["os"].(string),
+				Arch: arch["arch"].(string),
+			})
+		}
+	}
+
+	return v
+}
+
+func flattenAdminToolVersionArchs(archs []*adminToolVersionArchitecture) []interface{} {
+	result := make([]interface{}, 0, len(archs))
+	for _, arch := range archs {
+		result = append(result, map[string]interface{}{
+			"url":  arch.URL,
+			"sha":  arch.SHA,
+			"os":   arch.OS,
+			"arch": arch.Arch,
+		})
+	}
+	return result
+}
+
+// doAdminToolVersionRequest sends a request to the Admin API of a tool and
+// decodes the JSON response into model, if any.
+func doAdminToolVersionRequest(ctx context.Context, client *tfe.Client, method, path string, reqAttr, model interface{}) error {
+	req, err := client.NewRequest(method, path, reqAttr)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := req.Do(ctx, &buf); err != nil {
+		return err
+	}
+
+	if model == nil || buf.Len() == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(buf.Bytes(), model)
+}
+
+func readAdminToolVersion(ctx context.Context, client *tfe.Client, kind, id string) (*adminToolVersion, error) {
+	v := &adminToolVersion{}
+	path := fmt.Sprintf("admin/%s/%s", kind, url.PathEscape(id))
+	if err := doAdminToolVersionRequest(ctx, client, "GET", path, nil, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// fetchAdminToolVersionID returns the ID of the given version number of a
+// tool. kind is one of terraform-versions, opa-versions or sentinel-versions.
+func fetchAdminToolVersionID(ctx context.Context, client *tfe.Client, kind, version string) (string, error) {
+	options := &adminToolVersionListOptions{Filter: version}
+	for {
+		var list adminToolVersionList
+		if err := doAdminToolVersionRequest(ctx, client, "GET", "admin/"+kind, options, &list); err != nil {
+			return "", fmt.Errorf("error reading %s: %w", kind, err)
+		}
+
+		// Older versions of the API ignore the filter, so the versions are
+		// matched here as well.
+		for _, v := range list.Data {
+			if v.Attributes.Version == version {
+				return v.ID, nil
+			}
+		}
+
+		pagination := list.Meta.Pagination
+		if pagination == nil || pagination.CurrentPage >= pagination.TotalPages {
+			break
+		}
+		options.PageNumber = pagination.NextPage
+	}
+
+	return "", fmt.Errorf("version %s not found in %s", version, kind)
+}
+
+func resourceTFEAdminToolVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, kind string) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	if err := discoverAdminToolVersionRelease(d, kind); err != nil {
+		return err
+	}
+
+	options := expandAdminToolVersion(d, kind)
+
+	log.Printf("[DEBUG] Create new version in %s: %s", kind, options.Data.Attributes.Version)
+	v := &adminToolVersion{}
+	if err := doAdminToolVersionRequest(ctx, tfeClient, "POST", "admin/"+kind, options, v); err != nil {
+		return fmt.Errorf("Error creating version %s in %s: %w", options.Data.Attributes.Version, kind, err)
+	}
+
+	d.SetId(v.Data.ID)
+
+	// Not all attributes are accepted when creating a version, like whether
+	// it is deprecated, so they are set with an update.
+	return resourceTFEAdminToolVersionUpdate(ctx, d, meta, kind)
+}
+
+func resourceTFEAdminToolVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}, kind string) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read version %s in %s", d.Id(), kind)
+	v, err := readAdminToolVersion(ctx, tfeClient, kind, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Version %s in %s no longer exists", d.Id(), kind)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading version %s in %s: %w", d.Id(), kind, err)
+	}
+
+	attributes := v.Data.Attributes
+	d.Set("version", attributes.Version)
+	d.Set("url", attributes.URL)
+	d.Set("sha", attributes.SHA)
+	d.Set("official", attributes.Official)
+	d.Set("enabled", attributes.Enabled)
+	d.Set("beta", attributes.Beta)
+	d.Set("deprecated", attributes.Deprecated)
+	d.Set("deprecated_reason", attributes.DeprecatedReason)
+	d.Set("archs", flattenAdminToolVersionArchs(attributes.Archs))
+
+	return nil
+}
+
+func resourceTFEAdminToolVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, kind string) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// New versions were already discovered when created.
+	_, fromReleases := adminToolReleaseProducts[kind]
+	if fromReleases && !d.IsNewResource() && d.HasChanges("version", "from_releases", "releases_url") {
+		if err := discoverAdminToolVersionRelease(d, kind); err != nil {
+			return err
+		}
+	}
+
+	options := expandAdminToolVersion(d, kind)
+	options.Data.ID = d.Id()
+
+	log.Printf("[DEBUG] Update version %s in %s", d.Id(), kind)
+	path := fmt.Sprintf("admin/%s/%s", kind, url.PathEscape(d.Id()))
+	if err := doAdminToolVersionRequest(ctx, tfeClient, "PATCH", path, options, nil); err != nil {
+		return fmt.Errorf("Error updating version %s in %s: %w", d.Id(), kind, err)
+	}
+
+	return resourceTFEAdminToolVersionRead(ctx, d, meta, kind)
+}
+
+func resourceTFEAdminToolVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, kind string) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Delete version %s in %s", d.Id(), kind)
+	path := fmt.Sprintf("admin/%s/%s", kind, url.PathEscape(d.Id()))
+	if err := doAdminToolVersionRequest(ctx, tfeClient, "DELETE", path, nil, nil); err != nil {
+		if isErrResourceNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting version %s in %s: %w", d.Id(), kind, err)
+	}
+
+	return nil
+}
+
+// resourceTFEAdminToolVersionImport imports a tool version by its ID, or by
+// its version number.
+func resourceTFEAdminToolVersionImport(ctx context.Context, d *schema.ResourceData, meta interface{}, kind string) ([]*schema.ResourceData, error) {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	if !strings.HasPrefix(d.Id(), "tool-") {
+		id, err := fetchAdminToolVersionID(ctx, tfeClient, kind, d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving version %s in %s: %w", d.Id(), kind, err)
+		}
+		d.SetId(id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// adminToolReleaseProducts maps the kinds of tools to their product names on
+// the releases site. OPA is not published there.
+var adminToolReleaseProducts = map[string]string{
+	"terraform-versions": "terraform",
+	"sentinel-versions":  "sentinel",
+}
+
+var (
+	// toolReleaseClient downloads the files of the releases site.
+	toolReleaseClient = &http.Client{Timeout: 30 * time.Second}
+
+	// toolReleasePublicKey is the key the SHA256SUMS files are signed with.
+	toolReleasePublicKey = hashicorpReleasesPublicKey
+)
+
+// toolRelease is the index of a version of a product on the releases site,
+// e.g. https://releases.hashicorp.com/terraform/1.6.0/index.json.
+type toolRelease struct {
+	Shasums           string   `json:"shasums"`
+	ShasumsSignature  string   `json:"shasums_signature"`
+	ShasumsSignatures []string `json:"shasums_signatures"`
+	Builds            []struct {
+		OS       string `json:"os"`
+		Arch     string `json:"arch"`
+		Filename string `json:"filename"`
+		URL      string `json:"url"`
+	} `json:"builds"`
+}
+
+// discoverAdminToolVersionRelease sets the url, sha and archs of a version
+// from the releases site, when from_releases is set. Only the Linux builds
+// for the architectures supported by Terraform Enterprise are used, and the
+// url and sha are those of the amd64 build.
+func discoverAdminToolVersionRelease(d *schema.ResourceData, kind string) error {
+	if fromReleases, _ := d.Get("from_releases").(bool); !fromReleases {
+		return nil
+	}
+
+	product := adminToolReleaseProducts[kind]
+	releasesURL := d.Get("releases_url").(string)
+	version := d.Get("version").(string)
+
+	archs, err := fetchToolReleaseArchs(releasesURL, product, version)
+	if err != nil {
+		return fmt.Errorf("Error discovering %s %s from %s: %w", product, version, releasesURL, err)
+	}
+
+	for _, arch := range archs {
+		if arch.Arch == "amd64" {
+			d.Set("url", arch.URL)
+			d.Set("sha", arch.SHA)
+		}
+	}
+	d.Set("archs", flattenAdminToolVersionArchs(archs))
+
+	return nil
+}
+
+// fetchToolReleaseArchs returns the Linux builds of a version of a product on
+// the releases site, with the checksums from its SHA256SUMS file. The
+// signature of the SHA256SUMS file is verified with the HashiCorp release key.
+func fetchToolReleaseArchs(releasesURL, product, version string) ([]*adminToolVersionArchitecture, error) {
+	client := toolReleaseClient
+	base := fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(releasesURL, "/"), url.PathEscape(product), url.PathEscape(version))
+
+	log.Printf("[DEBUG] Read release index %s/index.json", base)
+	release := &toolRelease{}
+	if err := getToolReleaseFile(client, base+"/index.json", func(body io.Reader) error {
+		return json.NewDecoder(body).Decode(release)
+	}); err != nil {
+		return nil, err
+	}
+
+	var signature []byte
+	if err := getToolReleaseFile(client, base+"/"+url.PathEscape(release.signatureFilename()), func(body io.Reader) error {
+		var err error
+		signature, err = io.ReadAll(body)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	shasums := make(map[string]string)
+	if err := getToolReleaseFile(client, base+"/"+url.PathEscape(release.Shasums), func(body io.Reader) error {
+		var checksums bytes.Buffer
+		if err := verifyToolReleaseSignature(io.TeeReader(body, &checksums), bytes.NewReader(signature)); err != nil {
+			return err
+		}
+
+		scanner := bufio.NewScanner(&checksums)
+		for scanner.Scan() {
+			// Each line holds a checksum and a filename, like
+			// "<sha>  terraform_1.6.0_linux_amd64.zip".
+			if fields := strings.Fields(scanner.Text()); len(fields) == 2 {
+				shasums[fields[1]] = fields[0]
+			}
+		}
+		return scanner.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	var archs []*adminToolVersionArchitecture
+	for _, build := range release.Builds {
+		if build.OS != "linux" || (build.Arch != "amd64" && build.Arch != "arm64") {
+			continue
+		}
+
+		sha, ok := shasums[build.Filename]
+		if !ok {
+			return nil, fmt.Errorf("no checksum found for %s", build.Filename)
+		}
+
+		archs = append(archs, &adminToolVersionArchitecture{
+			URL:  build.URL,
+			SHA:  sha,
+			OS:   build.OS,
+			Arch: build.Arch,
+		})
+	}
+
+	if len(archs) == 0 {
+		return nil, fmt.Errorf("no Linux builds found")
+	}
+
+	return archs, nil
+}
+
+func getToolReleaseFile(client *http.Client, fileURL string, decode func(io.Reader) error) error {
+	resp, err := client.Get(fileURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error reading %s: %s", fileURL, resp.Status)
+	}
+
+	return decode(resp.Body)
+}
+
+// signatureFilename returns the name of the signature of the SHA256SUMS file.
+// Releases signed with multiple keys list a signature per key.
+func (r *toolRelease) signatureFilename() string {
+	for _, filename := range r.ShasumsSignatures {
+		if strings.HasSuffix(filename, "_SHA256SUMS.sig") {
+			return filename
+		}
+	}
+	if r.ShasumsSignature != "" {
+		return r.ShasumsSignature
+	}
+	return r.Shasums + ".sig"
+}
+
+// verifyToolReleaseSignature verifies the detached signature of a SHA256SUMS
+// file with the release key.
+func verifyToolReleaseSignature(checksums, signature io.Reader) error {
+	keyring, err := openpgp.ReadArmoredKeyRing(strings.NewReader(toolReleasePublicKey))
+	if err != nil {
+		return fmt.Errorf("error reading the release key: %w", err)
+	}
+
+	if _, err := openpgp.CheckDetachedSignature(keyring, checksums, signature, nil); err != nil {
+		return fmt.Errorf("error verifying the signature of the checksums: %w", err)
+	}
+
+	return nil
+}
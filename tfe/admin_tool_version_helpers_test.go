@@ -0,0 +1,262 @@
+package tfe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAdminToolVersionCreateAndImport(t *testing.T) {
+	var created adminToolVersion
+
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v2/admin/opa-versions":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Errorf("unexpected error decoding the request: %v", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			created.Data.ID = "tool-1"
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(created)
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/admin/opa-versions/tool-1":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Errorf("unexpected error decoding the request: %v", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			json.NewEncoder(w).Encode(created)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/admin/opa-versions/tool-1":
+			json.NewEncoder(w).Encode(created)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/admin/opa-versions":
+			if r.URL.Query().Get("page[number]") == "2" {
+				fmt.Fprint(w, `{"data":[
+					{"id":"tool-1","type":"opa-versions","attributes":{"version":"0.44.0"}}
+				],"meta":{"pagination":{"current-page":2,"total-pages":2}}}`)
+				return
+			}
+			// Simulate a server ignoring the version filter.
+			fmt.Fprint(w, `{"data":[
+				{"id":"tool-2","type":"opa-versions","attributes":{"version":"0.43.0"}}
+			],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+	meta := ConfiguredClient{Client: client}
+
+	d := resourceTFEOPAVersion().TestResourceData()
+	d.Set("version", "0.44.0")
+	d.Set("url", "https://www.hashicorp.com")
+	d.Set("sha", "abc")
+	d.Set("enabled", true)
+	d.Set("deprecated_reason", "")
+	d.Set("archs", []interface{}{
+		map[string]interface{}{"url": "https://www.hashicorp.com/arm64", "sha": "def", "os": "linux", "arch": "arm64"},
+	})
+
+	if err := resourceTFEOPAVersionCreate(ctx, d, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if created.Data.Type != "opa-versions" {
+		t.Errorf("expected type opa-versions, got %q", created.Data.Type)
+	}
+	if created.Data.Attributes.DeprecatedReason != nil {
+		t.Errorf("expected no deprecated reason, got %q", *created.Data.Attributes.DeprecatedReason)
+	}
+	if d.Id() != "tool-1" {
+		t.Errorf("expected ID tool-1, got %q", d.Id())
+	}
+	if got := d.Get("enabled").(bool); !got {
+		t.Error("expected the version to be enabled")
+	}
+	if got := d.Get("archs").(*schema.Set).Len(); got != 1 {
+		t.Errorf("expected 1 architecture, got %d", got)
+	}
+	if len(created.Data.Attributes.Archs) != 1 || created.Data.Attributes.Archs[0].Arch != "arm64" {
+		t.Errorf("expected the arm64 architecture to be sent, got %v", created.Data.Attributes.Archs)
+	}
+
+	id, err := fetchAdminToolVersionID(ctx, client, "opa-versions", "0.44.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "tool-1" {
+		t.Errorf("expected ID tool-1, got %q", id)
+	}
+
+	if _, err := fetchAdminToolVersionID(ctx, client, "opa-versions", "1.0.0"); err == nil {
+		t.Error("expected an error for an unknown version")
+	}
+}
+
+// testToolReleaseKey replaces the release key with a generated key for the
+// duration of a test, and returns the key to sign SHA256SUMS files with.
+func testToolReleaseKey(t *testing.T) *openpgp.Entity {
+	entity, err := openpgp.NewEntity("releases", "", "releases@example.com", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var key bytes.Buffer
+	w, err := armor.Encode(&key, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w.Close()
+
+	publicKey := toolReleasePublicKey
+	toolReleasePublicKey = key.String()
+	t.Cleanup(func() { toolReleasePublicKey = publicKey })
+
+	return entity
+}
+
+// testToolReleasesServer serves a release of sentinel 0.24.0 over https, with
+// its SHA256SUMS file signed by signer.
+func testToolReleasesServer(t *testing.T, signer *openpgp.Entity) *httptest.Server {
+	shasums := "aaa  sentinel_0.24.0_darwin_arm64.zip\nbbb  sentinel_0.24.0_linux_amd64.zip\nccc  sentinel_0.24.0_linux_arm64.zip\n"
+
+	var signature bytes.Buffer
+	if err := openpgp.DetachSign(&signature, signer, strings.NewReader(shasums), nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/releases/sentinel/0.24.0/index.json":
+			fmt.Fprintf(w, `{"name":"sentinel","version":"0.24.0","shasums":"sentinel_0.24.0_SHA256SUMS","shasums_signature":"sentinel_0.24.0_SHA256SUMS.sig","builds":[
+				{"os":"darwin","arch":"arm64","filename":"sentinel_0.24.0_darwin_arm64.zip","url":"%[1]s/sentinel_0.24.0_darwin_arm64.zip"},
+				{"os":"linux","arch":"amd64","filename":"sentinel_0.24.0_linux_amd64.zip","url":"%[1]s/sentinel_0.24.0_linux_amd64.zip"},
+				{"os":"linux","arch":"arm64","filename":"sentinel_0.24.0_linux_arm64.zip","url":"%[1]s/sentinel_0.24.0_linux_arm64.zip"}
+			]}`, server.URL+"/releases/sentinel/0.24.0")
+		case "/releases/sentinel/0.24.0/sentinel_0.24.0_SHA256SUMS":
+			fmt.Fprint(w, shasums)
+		case "/releases/sentinel/0.24.0/sentinel_0.24.0_SHA256SUMS.sig":
+			w.Write(signature.Bytes())
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := toolReleaseClient
+	toolReleaseClient = server.Client()
+	t.Cleanup(func() { toolReleaseClient = client })
+
+	return server
+}
+
+func TestAdminToolVersionFromReleases(t *testing.T) {
+	var created adminToolVersion
+
+	releases := testToolReleasesServer(t, testToolReleaseKey(t))
+
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v2/admin/sentinel-versions":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Errorf("unexpected error decoding the request: %v", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			created.Data.ID = "tool-1"
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(created)
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/admin/sentinel-versions/tool-1":
+			json.NewEncoder(w).Encode(created)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/admin/sentinel-versions/tool-1":
+			json.NewEncoder(w).Encode(created)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+	meta := ConfiguredClient{Client: client}
+
+	d := resourceTFESentinelVersion().TestResourceData()
+	d.Set("version", "0.24.0")
+	d.Set("from_releases", true)
+	d.Set("releases_url", releases.URL+"/releases/")
+
+	if err := resourceTFESentinelVersionCreate(ctx, d, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	attributes := created.Data.Attributes
+	if attributes.URL != releases.URL+"/releases/sentinel/0.24.0/sentinel_0.24.0_linux_amd64.zip" || attributes.SHA != "bbb" {
+		t.Errorf("expected the amd64 build to be sent as url and sha, got %q and %q", attributes.URL, attributes.SHA)
+	}
+	if len(attributes.Archs) != 2 {
+		t.Fatalf("expected the 2 Linux builds to be sent, got %d", len(attributes.Archs))
+	}
+	for _, arch := range attributes.Archs {
+		if arch.OS != "linux" || (arch.Arch == "arm64" && arch.SHA != "ccc") {
+			t.Errorf("unexpected architecture: %+v", arch)
+		}
+	}
+
+	// Versions missing from the releases site are not created.
+	d = resourceTFESentinelVersion().TestResourceData()
+	d.Set("version", "0.0.1")
+	d.Set("from_releases", true)
+	d.Set("releases_url", releases.URL+"/releases")
+
+	if err := resourceTFESentinelVersionCreate(ctx, d, meta); err == nil || !strings.Contains(err.Error(), "404 Not Found") {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestAdminToolVersionFromReleases_invalidSignature(t *testing.T) {
+	testToolReleaseKey(t)
+
+	// The checksums are signed with another key than the release key.
+	signer, err := openpgp.NewEntity("other", "", "other@example.com", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	releases := testToolReleasesServer(t, signer)
+
+	_, err = fetchToolReleaseArchs(releases.URL+"/releases", "sentinel", "0.24.0")
+	if err == nil || !strings.Contains(err.Error(), "error verifying the signature of the checksums") {
+		t.Fatalf("expected a signature error, got %v", err)
+	}
+}
+
+func TestAdminToolVersionSchema_releases(t *testing.T) {
+	sentinel := adminToolVersionSchema("sentinel-versions")
+	if _, errs := sentinel["releases_url"].ValidateFunc("http://releases.example.com", "releases_url"); len(errs) == 0 {
+		t.Errorf("expected releases_url to require https")
+	}
+	if _, errs := sentinel["releases_url"].ValidateFunc("https://releases.example.com", "releases_url"); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+
+	if _, err := openpgp.ReadArmoredKeyRing(strings.NewReader(hashicorpReleasesPublicKey)); err != nil {
+		t.Errorf("unexpected error reading the release key: %v", err)
+	}
+
+	// OPA is not published on the releases site.
+	opa := adminToolVersionSchema("opa-versions")
+	if _, ok := opa["from_releases"]; ok {
+		t.Errorf("expected OPA versions not to support from_releases")
+	}
+}
@@ -1,12 +1,22 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func fetchAgentPoolID(orgName string, poolName string, client *tfe.Client) (string, error) {
+const (
+	agentPoolBusy = "busy"
+	agentPoolIdle = "idle"
+)
+
+func fetchAgentPoolID(ctx context.Context, orgName string, poolName string, client *tfe.Client) (string, error) {
 	// to reduce the number of pages returned, search based on the name. TFE instances which
 	// do not support agent pool search will just ignore the query parameter
 	options := tfe.AgentPoolListOptions{
@@ -36,3 +46,56 @@ func fetchAgentPoolID(orgName string, poolName string, client *tfe.Client) (stri
 
 	return "", tfe.ErrResourceNotFound
 }
+
+// agentPoolScopeRestricted reports whether a scoping change can take the
+// agent pool away from workspaces which were allowed to use it before.
+func agentPoolScopeRestricted(oldScoped, newScoped bool, oldIDs, newIDs *schema.Set) bool {
+	if newScoped {
+		return false
+	}
+	if oldScoped {
+		return true
+	}
+	return oldIDs.Difference(newIDs).Len() > 0
+}
+
+// waitForAgentPoolIdle waits until none of the agents of an agent pool are
+// busy running a job.
+func waitForAgentPoolIdle(ctx context.Context, client *tfe.Client, agentPoolID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{agentPoolBusy},
+		Target:  []string{agentPoolIdle},
+		Refresh: func() (interface{}, string, error) {
+			options := &tfe.AgentListOptions{}
+			for {
+				l, err := client.Agents.List(ctx, agentPoolID, options)
+				if err != nil {
+					return nil, "", fmt.Errorf("Error retrieving agents of agent pool %s: %w", agentPoolID, err)
+				}
+
+				for _, agent := range l.Items {
+					if agent.Status == agentPoolBusy {
+						log.Printf("[DEBUG] Agent %s of agent pool %s is busy", agent.ID, agentPoolID)
+						return l, agentPoolBusy, nil
+					}
+				}
+
+				// Exit the loop when we've seen all pages.
+				if l.CurrentPage >= l.TotalPages {
+					return l, agentPoolIdle, nil
+				}
+
+				// Update the page number to get the next page.
+				options.PageNumber = l.NextPage
+			}
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for the agents of agent pool %s to finish their jobs: %w", agentPoolID, err)
+	}
+
+	return nil
+}
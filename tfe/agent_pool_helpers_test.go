@@ -0,0 +1,56 @@
+package tfe
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAgentPoolScopeRestricted(t *testing.T) {
+	ids := func(values ...interface{}) *schema.Set {
+		return schema.NewSet(schema.HashString, values)
+	}
+
+	cases := map[string]struct {
+		oldScoped bool
+		newScoped bool
+		oldIDs    *schema.Set
+		newIDs    *schema.Set
+		result    bool
+	}{
+		"stays organization scoped": {
+			oldScoped: true,
+			newScoped: true,
+			oldIDs:    ids(),
+			newIDs:    ids(),
+		},
+		"becomes organization scoped": {
+			oldScoped: false,
+			newScoped: true,
+			oldIDs:    ids("ws-1"),
+			newIDs:    ids(),
+		},
+		"becomes workspace scoped": {
+			oldScoped: true,
+			newScoped: false,
+			oldIDs:    ids(),
+			newIDs:    ids("ws-1"),
+			result:    true,
+		},
+		"workspace added": {
+			oldIDs: ids("ws-1"),
+			newIDs: ids("ws-1", "ws-2"),
+		},
+		"workspace removed": {
+			oldIDs: ids("ws-1", "ws-2"),
+			newIDs: ids("ws-1"),
+			result: true,
+		},
+	}
+
+	for name, tc := range cases {
+		if result := agentPoolScopeRestricted(tc.oldScoped, tc.newScoped, tc.oldIDs, tc.newIDs); result != tc.result {
+			t.Fatalf("%s: expected %t, got %t", name, tc.result, result)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// The audit trail token of an organization is an organization token of the
+// audit-trails type, which is only allowed to read the audit trail. go-tfe
+// only manages the regular organization token.
+const auditTrailTokenType = "audit-trails"
+
+func auditTrailTokenPath(organization string) string {
+	return fmt.Sprintf("organizations/%s/authentication-token?token=%s", url.QueryEscape(organization), auditTrailTokenType)
+}
+
+func createAuditTrailToken(ctx context.Context, client *tfe.Client, organization string, options tfe.OrganizationTokenCreateOptions) (*tfe.OrganizationToken, error) {
+	req, err := client.NewRequest("POST", auditTrailTokenPath(organization), &options)
+	if err != nil {
+		return nil, err
+	}
+
+	ot := &tfe.OrganizationToken{}
+	if err := req.Do(ctx, ot); err != nil {
+		return nil, err
+	}
+
+	return ot, nil
+}
+
+func readAuditTrailToken(ctx context.Context, client *tfe.Client, organization string) (*tfe.OrganizationToken, error) {
+	req, err := client.NewRequest("GET", auditTrailTokenPath(organization), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ot := &tfe.OrganizationToken{}
+	if err := req.Do(ctx, ot); err != nil {
+		return nil, err
+	}
+
+	return ot, nil
+}
+
+func deleteAuditTrailToken(ctx context.Context, client *tfe.Client, organization string) error {
+	req, err := client.NewRequest("DELETE", auditTrailTokenPath(organization), nil)
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
@@ -0,0 +1,60 @@
+package tfe
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAuditTrailToken(t *testing.T) {
+	var requests []string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		if r.URL.Path != "/api/v2/organizations/hashicorp/authentication-token" || r.URL.Query().Get("token") != "audit-trails" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data":{"id":"at-123","type":"authentication-tokens","attributes":{"token":"secret"}}}`)
+		case "GET":
+			fmt.Fprint(w, `{"data":{"id":"at-123","type":"authentication-tokens","attributes":{}}}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+
+	client := server.Client
+
+	token, err := createAuditTrailToken(ctx, client, "hashicorp", tfe.OrganizationTokenCreateOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error creating: %v", err)
+	}
+	if token.Token != "secret" {
+		t.Fatalf("expected token secret, got %q", token.Token)
+	}
+
+	if _, err := readAuditTrailToken(ctx, client, "hashicorp"); err != nil {
+		t.Fatalf("unexpected error reading: %v", err)
+	}
+
+	if err := deleteAuditTrailToken(ctx, client, "hashicorp"); err != nil {
+		t.Fatalf("unexpected error deleting: %v", err)
+	}
+
+	// Only the audit trail token is managed, never the organization token.
+	for _, req := range requests {
+		if !strings.HasSuffix(req, "?token=audit-trails") {
+			t.Fatalf("expected the audit trail token to be requested, got: %s", req)
+		}
+	}
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got: %v", requests)
+	}
+}
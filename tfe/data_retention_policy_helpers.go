@@ -0,0 +1,134 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+const (
+	dataRetentionPolicyDeleteOlderType = "data-retention-policy-delete-olders"
+	dataRetentionPolicyDontDeleteType  = "data-retention-policy-dont-deletes"
+
+	// dataRetentionPolicyLegacyType is the only type of data retention policy
+	// of Terraform Enterprise v202311-1 and v202312-1, which always deletes
+	// older data.
+	dataRetentionPolicyLegacyType = "data-retention-policies"
+)
+
+// dataRetentionPolicyDeleteOlderOptions sets a data retention policy which
+// deletes data older than the given number of days. go-tfe only supports the
+// legacy data retention policies.
+type dataRetentionPolicyDeleteOlderOptions struct {
+	Type                 string `jsonapi:"primary,data-retention-policy-delete-olders"`
+	DeleteOlderThanNDays int    `jsonapi:"attr,delete-older-than-n-days"`
+}
+
+// dataRetentionPolicyDontDeleteOptions sets a data retention policy which
+// never deletes data, overriding the policy of the organization or of the
+// installation.
+type dataRetentionPolicyDontDeleteOptions struct {
+	Type string `jsonapi:"primary,data-retention-policy-dont-deletes"`
+}
+
+// dataRetentionPolicy is a data retention policy of any type.
+type dataRetentionPolicy struct {
+	ID                   string
+	Type                 string
+	DeleteOlderThanNDays int
+}
+
+// dataRetentionPolicyDocument holds a data retention policy as returned by the
+// API. Its type depends on the kind of policy, so it is decoded as plain JSON.
+type dataRetentionPolicyDocument struct {
+	Data *struct {
+		ID         string `json:"id"`
+		Type       string `json:"type"`
+		Attributes struct {
+			DeleteOlderThanNDays int `json:"delete-older-than-n-days"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+// dataRetentionPolicyPath returns the path of the data retention policy of a
+// workspace, if given, or of an organization otherwise.
+func dataRetentionPolicyPath(organization, workspaceID string) string {
+	if workspaceID != "" {
+		return fmt.Sprintf("workspaces/%s/relationships/data-retention-policy", url.QueryEscape(workspaceID))
+	}
+	return fmt.Sprintf("organizations/%s/relationships/data-retention-policy", url.QueryEscape(organization))
+}
+
+// readDataRetentionPolicy returns the data retention policy set on a workspace
+// or an organization. It returns tfe.ErrResourceNotFound if no policy is set.
+func readDataRetentionPolicy(ctx context.Context, client *tfe.Client, organization, workspaceID string) (*dataRetentionPolicy, error) {
+	req, err := client.NewRequest("GET", dataRetentionPolicyPath(organization, workspaceID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &dataRetentionPolicyDocument{}
+	if err := req.DoJSON(ctx, doc); err != nil {
+		return nil, err
+	}
+
+	return doc.policy()
+}
+
+// setDataRetentionPolicy replaces the data retention policy of a workspace or
+// an organization. A policy which never deletes data is set when
+// deleteOlderThanNDays is zero.
+func setDataRetentionPolicy(ctx context.Context, client *tfe.Client, organization, workspaceID string, deleteOlderThanNDays int) (*dataRetentionPolicy, error) {
+	var options interface{} = &dataRetentionPolicyDontDeleteOptions{}
+	if deleteOlderThanNDays > 0 {
+		options = &dataRetentionPolicyDeleteOlderOptions{DeleteOlderThanNDays: deleteOlderThanNDays}
+	}
+
+	req, err := client.NewRequest("POST", dataRetentionPolicyPath(organization, workspaceID), options)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &dataRetentionPolicyDocument{}
+	if err := req.DoJSON(ctx, doc); err != nil {
+		return nil, err
+	}
+
+	return doc.policy()
+}
+
+// deleteDataRetentionPolicy removes the data retention policy of a workspace
+// or an organization, so the policy of the organization or of the installation
+// applies again.
+func deleteDataRetentionPolicy(ctx context.Context, client *tfe.Client, organization, workspaceID string) error {
+	req, err := client.NewRequest("DELETE", dataRetentionPolicyPath(organization, workspaceID), nil)
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
+
+func (doc *dataRetentionPolicyDocument) policy() (*dataRetentionPolicy, error) {
+	if doc.Data == nil || doc.Data.ID == "" {
+		return nil, tfe.ErrResourceNotFound
+	}
+
+	policy := &dataRetentionPolicy{
+		ID:                   doc.Data.ID,
+		Type:                 doc.Data.Type,
+		DeleteOlderThanNDays: doc.Data.Attributes.DeleteOlderThanNDays,
+	}
+
+	switch policy.Type {
+	case dataRetentionPolicyDeleteOlderType, dataRetentionPolicyDontDeleteType:
+	case dataRetentionPolicyLegacyType:
+		policy.Type = dataRetentionPolicyDeleteOlderType
+	default:
+		return nil, fmt.Errorf("unsupported data retention policy type %q", policy.Type)
+	}
+
+	return policy, nil
+}
@@ -1,13 +1,14 @@
 package tfe
 
 import (
-	tfe "github.com/hashicorp/go-tfe"
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEAgentPool() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEAgentPoolRead,
+		ReadContext: crudContext(dataSourceTFEAgentPoolRead),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -17,20 +18,23 @@ func dataSourceTFEAgentPool() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 		},
 	}
 }
 
-func dataSourceTFEAgentPoolRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceTFEAgentPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
-	id, err := fetchAgentPoolID(organization, name, tfeClient)
+	id, err := fetchAgentPoolID(ctx, organization, name, tfeClient)
 	if err != nil {
 		return err
 	}
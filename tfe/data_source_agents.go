@@ -0,0 +1,127 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTFEAgents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFEAgentsRead),
+
+		Schema: map[string]*schema.Schema{
+			"agent_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"last_ping_since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"agents": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"last_ping_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTFEAgentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// Get the agent pool ID.
+	agentPoolID := d.Get("agent_pool_id").(string)
+
+	options := &tfe.AgentListOptions{}
+
+	// Only list the agents which pinged recently, if requested.
+	if v, ok := d.GetOk("last_ping_since"); ok {
+		lastPingSince, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing last_ping_since %s: %w", v.(string), err)
+		}
+		options.LastPingSince = lastPingSince
+	}
+
+	var ids []interface{}
+	var agents []interface{}
+
+	log.Printf("[DEBUG] List agents of agent pool: %s", agentPoolID)
+	for {
+		l, err := tfeClient.Agents.List(ctx, agentPoolID, options)
+		if err != nil {
+			if err == tfe.ErrResourceNotFound {
+				return fmt.Errorf("could not find agent pool %s", agentPoolID)
+			}
+			return fmt.Errorf("Error retrieving agents of agent pool %s: %w", agentPoolID, err)
+		}
+
+		for _, agent := range l.Items {
+			ids = append(ids, agent.ID)
+			agents = append(agents, map[string]interface{}{
+				"id":           agent.ID,
+				"name":         agent.Name,
+				"ip_address":   agent.IP,
+				"status":       agent.Status,
+				"last_ping_at": agent.LastPingAt,
+			})
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	d.SetId(agentPoolID)
+	d.Set("ids", ids)
+	d.Set("agents", agents)
+
+	return nil
+}
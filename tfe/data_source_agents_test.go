@@ -0,0 +1,53 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEAgentsDataSource_basic(t *testing.T) {
+	skipIfEnterprise(t)
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEAgentsDataSourceConfig(org.Name, rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_agents.foobar", "id", "tfe_agent_pool.foobar", "id"),
+					// No agents are registered with a new agent pool.
+					resource.TestCheckResourceAttr("data.tfe_agents.foobar", "ids.#", "0"),
+					resource.TestCheckResourceAttr("data.tfe_agents.foobar", "agents.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEAgentsDataSourceConfig(organization string, rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_agent_pool" "foobar" {
+  name         = "agent-pool-test-%d"
+  organization = "%s"
+}
+
+data "tfe_agents" "foobar" {
+  agent_pool_id = tfe_agent_pool.foobar.id
+}`, rInt, organization)
+}
@@ -0,0 +1,271 @@
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTFEAuditEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFEAuditEventsRead),
+
+		Schema: map[string]*schema.Schema{
+			"since": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"until": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"actor_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"page_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"truncated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"actor_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"actor_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"actor_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"impersonator_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"request_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"meta": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// auditEventsFilter selects the audit trail events to return.
+type auditEventsFilter struct {
+	Until         time.Time
+	ActorIDs      map[string]bool
+	ResourceTypes map[string]bool
+}
+
+func (f *auditEventsFilter) match(event *tfe.AuditTrail) bool {
+	if !f.Until.IsZero() && event.Timestamp.After(f.Until) {
+		return false
+	}
+	if len(f.ActorIDs) > 0 && !f.ActorIDs[event.Auth.AccessorID] {
+		return false
+	}
+	if len(f.ResourceTypes) > 0 && !f.ResourceTypes[event.Resource.Type] {
+		return false
+	}
+	return true
+}
+
+// listAuditEvents lists the audit trail events since the given time which
+// match the filter, up to maxResults events. It also reports whether more
+// events matched than were returned.
+func listAuditEvents(ctx context.Context, client *tfe.Client, since time.Time, filter *auditEventsFilter, pageSize, maxResults int) ([]*tfe.AuditTrail, bool, error) {
+	var events []*tfe.AuditTrail
+
+	options := &tfe.AuditTrailListOptions{
+		Since: since,
+		ListOptions: &tfe.ListOptions{
+			PageSize: pageSize,
+		},
+	}
+	for {
+		l, err := client.AuditTrails.List(ctx, options)
+		if err != nil {
+			return nil, false, fmt.Errorf("Error retrieving audit trail events: %w", err)
+		}
+
+		for _, event := range l.Items {
+			if !filter.match(event) {
+				continue
+			}
+			if len(events) == maxResults {
+				return events, true, nil
+			}
+			events = append(events, event)
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.AuditTrailPagination == nil || l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return events, false, nil
+}
+
+func dataSourceTFEAuditEventsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// The values are validated by the schema.
+	since, _ := time.Parse(time.RFC3339, d.Get("since").(string))
+
+	filter := &auditEventsFilter{
+		ActorIDs:      make(map[string]bool),
+		ResourceTypes: make(map[string]bool),
+	}
+	if v, ok := d.GetOk("until"); ok {
+		filter.Until, _ = time.Parse(time.RFC3339, v.(string))
+		if filter.Until.Before(since) {
+			return fmt.Errorf("until (%s) must not be before since (%s)", v.(string), d.Get("since").(string))
+		}
+	}
+	for _, id := range d.Get("actor_ids").(*schema.Set).List() {
+		filter.ActorIDs[id.(string)] = true
+	}
+	for _, t := range d.Get("resource_types").(*schema.Set).List() {
+		filter.ResourceTypes[t.(string)] = true
+	}
+
+	log.Printf("[DEBUG] List audit trail events since %s", since.Format(time.RFC3339))
+	events, truncated, err := listAuditEvents(ctx, tfeClient, since, filter, d.Get("page_size").(int), d.Get("max_results").(int))
+	if err != nil {
+		return err
+	}
+
+	result := make([]interface{}, 0, len(events))
+	for _, event := range events {
+		impersonatorID := ""
+		if event.Auth.ImpersonatorID != nil {
+			impersonatorID = *event.Auth.ImpersonatorID
+		}
+
+		metadata := ""
+		if len(event.Resource.Meta) > 0 {
+			b, err := json.Marshal(event.Resource.Meta)
+			if err != nil {
+				return fmt.Errorf("Error encoding the metadata of audit trail event %s: %w", event.ID, err)
+			}
+			metadata = string(b)
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                event.ID,
+			"type":              event.Type,
+			"version":           event.Version,
+			"timestamp":         event.Timestamp.Format(time.RFC3339),
+			"actor_id":          event.Auth.AccessorID,
+			"actor_type":        event.Auth.Type,
+			"actor_description": event.Auth.Description,
+			"impersonator_id":   impersonatorID,
+			"request_id":        event.Request.ID,
+			"resource_id":       event.Resource.ID,
+			"resource_type":     event.Resource.Type,
+			"action":            event.Resource.Action,
+			"meta":              metadata,
+		})
+	}
+
+	id := d.Get("since").(string)
+	if v, ok := d.GetOk("until"); ok {
+		id = fmt.Sprintf("%s/%s", id, v.(string))
+	}
+
+	d.SetId(id)
+	d.Set("events", result)
+	d.Set("truncated", truncated)
+
+	return nil
+}
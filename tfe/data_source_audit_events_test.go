@@ -0,0 +1,94 @@
+package tfe
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organization/audit-trail":
+			if r.URL.Query().Get("since") == "" || r.URL.Query().Get("page[size]") != "2" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Query().Get("page[number]") {
+			case "", "1":
+				fmt.Fprint(w, `{"data":[
+					{"id":"ae-1","timestamp":"2023-01-01T10:00:00Z","auth":{"accessor_id":"user-1"},"resource":{"id":"ws-1","type":"workspace","action":"create"}},
+					{"id":"ae-2","timestamp":"2023-01-01T11:00:00Z","auth":{"accessor_id":"user-2"},"resource":{"id":"run-1","type":"run","action":"apply"}}
+				],"pagination":{"current_page":1,"next_page":2,"total_pages":2}}`)
+			case "2":
+				fmt.Fprint(w, `{"data":[
+					{"id":"ae-3","timestamp":"2023-01-01T12:00:00Z","auth":{"accessor_id":"user-1"},"resource":{"id":"ws-1","type":"workspace","action":"update"}},
+					{"id":"ae-4","timestamp":"2023-01-02T10:00:00Z","auth":{"accessor_id":"user-1"},"resource":{"id":"ws-1","type":"workspace","action":"destroy"}}
+				],"pagination":{"current_page":2,"total_pages":2}}`)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2023, 1, 1, 23, 59, 59, 0, time.UTC)
+
+	cases := map[string]struct {
+		filter     *auditEventsFilter
+		maxResults int
+		want       []string
+		truncated  bool
+	}{
+		"all events": {
+			filter:     &auditEventsFilter{},
+			maxResults: 10,
+			want:       []string{"ae-1", "ae-2", "ae-3", "ae-4"},
+		},
+		"until": {
+			filter:     &auditEventsFilter{Until: until},
+			maxResults: 10,
+			want:       []string{"ae-1", "ae-2", "ae-3"},
+		},
+		"actors and resource types": {
+			filter: &auditEventsFilter{
+				ActorIDs:      map[string]bool{"user-1": true},
+				ResourceTypes: map[string]bool{"workspace": true},
+			},
+			maxResults: 10,
+			want:       []string{"ae-1", "ae-3", "ae-4"},
+		},
+		"max results": {
+			filter:     &auditEventsFilter{},
+			maxResults: 3,
+			want:       []string{"ae-1", "ae-2", "ae-3"},
+			truncated:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			events, truncated, err := listAuditEvents(ctx, client, since, tc.filter, 2, tc.maxResults)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []string
+			for _, event := range events {
+				got = append(got, event.ID)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+				t.Fatalf("wrong events\ngot: %v\nwant: %v", got, tc.want)
+			}
+			if truncated != tc.truncated {
+				t.Fatalf("expected truncated to be %t, got %t", tc.truncated, truncated)
+			}
+		})
+	}
+}
@@ -1,16 +1,16 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
 
-	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEIPRanges() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEIPRangesRead,
+		ReadContext: crudContext(dataSourceTFEIPRangesRead),
 
 		Schema: map[string]*schema.Schema{
 			"api": {
@@ -37,8 +37,8 @@ func dataSourceTFEIPRanges() *schema.Resource {
 	}
 }
 
-func dataSourceTFEIPRangesRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceTFEIPRangesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Reading IP Ranges")
 	ipRanges, err := tfeClient.Meta.IPRanges.Read(ctx, "")
@@ -0,0 +1,100 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFENotificationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFENotificationConfigurationRead),
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"destination_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"triggers": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"email_addresses": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"email_user_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceTFENotificationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Read notification configuration %s of workspace: %s", name, workspaceID)
+	configs, err := listWorkspaceNotificationConfigurations(ctx, tfeClient, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	found := findNotificationConfigurationsByName(configs, name)
+	switch len(found) {
+	case 0:
+		return fmt.Errorf("could not find notification configuration %s in workspace %s", name, workspaceID)
+	case 1:
+	default:
+		var ids []string
+		for _, nc := range found {
+			ids = append(ids, nc.ID)
+		}
+		return fmt.Errorf("found %d notification configurations named %s in workspace %s (%s)",
+			len(found), name, workspaceID, strings.Join(ids, ", "))
+	}
+
+	nc := flattenNotificationConfiguration(found[0])
+
+	d.SetId(found[0].ID)
+	d.Set("destination_type", nc["destination_type"])
+	d.Set("enabled", nc["enabled"])
+	d.Set("url", nc["url"])
+	d.Set("triggers", nc["triggers"])
+	d.Set("email_addresses", nc["email_addresses"])
+	d.Set("email_user_ids", nc["email_user_ids"])
+
+	return nil
+}
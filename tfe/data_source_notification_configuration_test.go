@@ -0,0 +1,130 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFENotificationConfigurationDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFENotificationConfigurationDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_notification_configuration.foobar", "id",
+						"tfe_notification_configuration.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_notification_configuration.foobar", "destination_type", "slack"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_notification_configuration.foobar", "enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_notification_configuration.foobar", "url", "https://hooks.slack.com/services/T00000000/B00000000/XXXXXXXX"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_notification_configuration.foobar", "triggers.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestTFENotificationConfigurationDataSource_read(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-123/notification-configurations":
+			fmt.Fprint(w, `{"data":[
+				{"id":"nc-1","type":"notification-configurations","attributes":{"name":"slack","destination-type":"slack","enabled":true,"url":"https://example.com","triggers":["run:errored"]}},
+				{"id":"nc-2","type":"notification-configurations","attributes":{"name":"email","destination-type":"email"}},
+				{"id":"nc-3","type":"notification-configurations","attributes":{"name":"email","destination-type":"email"}}
+			],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	cases := map[string]struct {
+		name    string
+		wantErr string
+	}{
+		"found": {
+			name: "slack",
+		},
+		"not found": {
+			name:    "teams",
+			wantErr: "could not find notification configuration teams in workspace ws-123",
+		},
+		"ambiguous": {
+			name:    "email",
+			wantErr: "found 2 notification configurations named email in workspace ws-123 (nc-2, nc-3)",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := dataSourceTFENotificationConfiguration().TestResourceData()
+			d.Set("workspace_id", "ws-123")
+			d.Set("name", tc.name)
+
+			err := dataSourceTFENotificationConfigurationRead(ctx, d, ConfiguredClient{Client: client})
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("expected error %q, got: %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if d.Id() != "nc-1" {
+				t.Fatalf("expected ID nc-1, got %s", d.Id())
+			}
+			if d.Get("destination_type").(string) != "slack" || !d.Get("enabled").(bool) || d.Get("url").(string) != "https://example.com" {
+				t.Fatalf("wrong attributes: %s, %t, %s", d.Get("destination_type"), d.Get("enabled"), d.Get("url"))
+			}
+			if !d.Get("triggers").(*schema.Set).Contains("run:errored") {
+				t.Fatalf("wrong triggers: %v", d.Get("triggers").(*schema.Set).List())
+			}
+		})
+	}
+}
+
+func testAccTFENotificationConfigurationDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name             = "slack-alerts"
+  enabled          = true
+  destination_type = "slack"
+  url              = "https://hooks.slack.com/services/T00000000/B00000000/XXXXXXXX"
+  triggers         = ["run:errored"]
+  workspace_id     = tfe_workspace.foobar.id
+}
+
+data "tfe_notification_configuration" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  name         = tfe_notification_configuration.foobar.name
+}`, rInt)
+}
@@ -0,0 +1,112 @@
+package tfe
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFENotificationConfigurations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFENotificationConfigurationsRead),
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"notification_configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"destination_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"triggers": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"email_addresses": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"email_user_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTFENotificationConfigurationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+
+	log.Printf("[DEBUG] List notification configurations of workspace: %s", workspaceID)
+	configs, err := listWorkspaceNotificationConfigurations(ctx, tfeClient, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]interface{}, 0, len(configs))
+	names := make([]interface{}, 0, len(configs))
+	notificationConfigurations := make([]interface{}, 0, len(configs))
+	for _, nc := range configs {
+		ids = append(ids, nc.ID)
+		names = append(names, nc.Name)
+		notificationConfigurations = append(notificationConfigurations, flattenNotificationConfiguration(nc))
+	}
+
+	d.SetId(workspaceID)
+	d.Set("ids", ids)
+	d.Set("names", names)
+	d.Set("notification_configurations", notificationConfigurations)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFENotificationConfigurationsDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFENotificationConfigurationsDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.tfe_notification_configurations.foobar", "ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_notification_configurations.foobar", "names.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.tfe_notification_configurations.foobar", "notification_configurations.*", map[string]string{
+							"name":             "slack-alerts",
+							"destination_type": "slack",
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.tfe_notification_configurations.foobar", "notification_configurations.*", map[string]string{
+							"name":             "generic-alerts",
+							"destination_type": "generic",
+							"url":              "http://example.com",
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFENotificationConfigurationsDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_notification_configuration" "slack" {
+  name             = "slack-alerts"
+  destination_type = "slack"
+  url              = "https://hooks.slack.com/services/T00000000/B00000000/XXXXXXXX"
+  workspace_id     = tfe_workspace.foobar.id
+}
+
+resource "tfe_notification_configuration" "generic" {
+  name             = "generic-alerts"
+  destination_type = "generic"
+  url              = "http://example.com"
+  workspace_id     = tfe_workspace.foobar.id
+}
+
+data "tfe_notification_configurations" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+
+  depends_on = [
+    tfe_notification_configuration.slack,
+    tfe_notification_configuration.generic,
+  ]
+}`, rInt)
+}
@@ -0,0 +1,196 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTFENotificationHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFENotificationHealthRead),
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"workspace_id", "organization"},
+			},
+
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"destination_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice(
+						[]string{
+							string(tfe.NotificationDestinationTypeEmail),
+							string(tfe.NotificationDestinationTypeGeneric),
+							string(tfe.NotificationDestinationTypeSlack),
+							string(tfe.NotificationDestinationTypeMicrosoftTeams),
+						},
+						false,
+					),
+				},
+			},
+
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+
+			"checked": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"healthy_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"unhealthy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"workspace_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"destination_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTFENotificationHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	var workspaceIDs []string
+	id := d.Get("workspace_id").(string)
+	if id != "" {
+		workspaceIDs = []string{id}
+	} else {
+		id = d.Get("organization").(string)
+
+		log.Printf("[DEBUG] List workspaces of organization: %s", id)
+		options := &tfe.WorkspaceListOptions{}
+		options.PageSize = 100
+		for {
+			wl, err := tfeClient.Workspaces.List(ctx, id, options)
+			if err != nil {
+				if err == tfe.ErrResourceNotFound {
+					return fmt.Errorf("could not find organization %s", id)
+				}
+				return fmt.Errorf("Error retrieving workspaces of organization %s: %w", id, err)
+			}
+
+			for _, ws := range wl.Items {
+				workspaceIDs = append(workspaceIDs, ws.ID)
+			}
+
+			// Exit the loop when we've seen all pages.
+			if wl.CurrentPage >= wl.TotalPages {
+				break
+			}
+
+			// Update the page number to get the next page.
+			options.PageNumber = wl.NextPage
+		}
+	}
+
+	destinationTypes := make(map[string]bool)
+	for _, t := range d.Get("destination_types").(*schema.Set).List() {
+		destinationTypes[t.(string)] = true
+	}
+
+	// Only enabled notification configurations can be verified.
+	var configs []*tfe.NotificationConfiguration
+	for _, workspaceID := range workspaceIDs {
+		log.Printf("[DEBUG] List notification configurations of workspace: %s", workspaceID)
+		l, err := listWorkspaceNotificationConfigurations(ctx, tfeClient, workspaceID)
+		if err != nil {
+			return err
+		}
+
+		for _, nc := range l {
+			if !nc.Enabled {
+				continue
+			}
+			if len(destinationTypes) > 0 && !destinationTypes[string(nc.DestinationType)] {
+				continue
+			}
+			if nc.Subscribable == nil {
+				nc.Subscribable = &tfe.Workspace{ID: workspaceID}
+			}
+			configs = append(configs, nc)
+		}
+	}
+
+	log.Printf("[DEBUG] Verify %d notification configurations", len(configs))
+	results := verifyNotificationConfigurations(ctx, tfeClient, configs, d.Get("concurrency").(int))
+
+	healthyIDs := []interface{}{}
+	unhealthy := []interface{}{}
+	for _, r := range results {
+		if r.Healthy {
+			healthyIDs = append(healthyIDs, r.Config.ID)
+			continue
+		}
+
+		unhealthy = append(unhealthy, map[string]interface{}{
+			"id":               r.Config.ID,
+			"name":             r.Config.Name,
+			"workspace_id":     r.Config.Subscribable.ID,
+			"destination_type": string(r.Config.DestinationType),
+			"code":             r.Code,
+			"error":            r.Error,
+		})
+	}
+
+	d.SetId(id)
+	d.Set("checked", len(results))
+	d.Set("healthy_ids", healthyIDs)
+	d.Set("unhealthy", unhealthy)
+
+	return nil
+}
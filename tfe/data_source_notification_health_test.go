@@ -0,0 +1,71 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFENotificationHealthDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFENotificationHealthDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_notification_health.workspace", "id",
+						"tfe_workspace.foobar", "id"),
+					// Disabled notification configurations are not verified.
+					resource.TestCheckResourceAttr(
+						"data.tfe_notification_health.workspace", "checked", "0"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_notification_health.workspace", "unhealthy.#", "0"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_notification_health.organization", "id",
+						fmt.Sprintf("tst-terraform-%d", rInt)),
+					resource.TestCheckResourceAttr(
+						"data.tfe_notification_health.organization", "checked", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFENotificationHealthDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name             = "notification_basic"
+  destination_type = "generic"
+  url              = "http://example.com"
+  workspace_id     = tfe_workspace.foobar.id
+}
+
+data "tfe_notification_health" "workspace" {
+  workspace_id = tfe_notification_configuration.foobar.workspace_id
+}
+
+data "tfe_notification_health" "organization" {
+  organization      = tfe_organization.foobar.name
+  destination_types = ["slack"]
+  concurrency       = 10
+
+  depends_on = [tfe_notification_configuration.foobar]
+}`, rInt)
+}
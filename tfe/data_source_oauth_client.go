@@ -12,7 +12,7 @@ import (
 
 func dataSourceTFEOAuthClient() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEOAuthClientRead,
+		ReadContext: crudContext(dataSourceTFEOAuthClientRead),
 		Schema: map[string]*schema.Schema{
 			"oauth_client_id": {
 				Type:         schema.TypeString,
@@ -24,14 +24,12 @@ func dataSourceTFEOAuthClient() *schema.Resource {
 				Optional: true,
 			},
 			"name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				RequiredWith: []string{"organization"},
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"service_provider": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				RequiredWith: []string{"organization"},
+				Type:     schema.TypeString,
+				Optional: true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(
 					[]string{
 						string(tfe.ServiceProviderAzureDevOpsServer),
@@ -76,9 +74,9 @@ func dataSourceTFEOAuthClient() *schema.Resource {
 	}
 }
 
-func dataSourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEOAuthClientRead(_ context.Context, d *schema.ResourceData, meta interface{}) error {
 	ctx := context.TODO()
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	var oc *tfe.OAuthClient
 	var err error
@@ -90,7 +88,10 @@ func dataSourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	} else {
 		// search by name or service provider within a specific organization instead
-		organization := d.Get("organization").(string)
+		organization, err := meta.(ConfiguredClient).organizationName(d)
+		if err != nil {
+			return err
+		}
 
 		var name string
 		var serviceProvider tfe.ServiceProviderType
@@ -0,0 +1,183 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTFEOAuthClients() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFEOAuthClientsRead),
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"service_provider": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.ServiceProviderAzureDevOpsServer),
+						string(tfe.ServiceProviderAzureDevOpsServices),
+						string(tfe.ServiceProviderBitbucket),
+						string(tfe.ServiceProviderBitbucketServer),
+						string(tfe.ServiceProviderBitbucketServerLegacy),
+						string(tfe.ServiceProviderGithub),
+						string(tfe.ServiceProviderGithubEE),
+						string(tfe.ServiceProviderGitlab),
+						string(tfe.ServiceProviderGitlabCE),
+						string(tfe.ServiceProviderGitlabEE),
+					},
+					false,
+				),
+			},
+
+			"ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"oauth_clients": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"service_provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"service_provider_display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"api_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"http_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"callback_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"organization_scoped": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"oauth_token_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenOAuthClient returns the OAuth client in the representation of the
+// OAuth clients data source.
+func flattenOAuthClient(oc *tfe.OAuthClient) map[string]interface{} {
+	m := map[string]interface{}{
+		"id":                            oc.ID,
+		"name":                          "",
+		"service_provider":              string(oc.ServiceProvider),
+		"service_provider_display_name": oc.ServiceProviderName,
+		"api_url":                       oc.APIURL,
+		"http_url":                      oc.HTTPURL,
+		"callback_url":                  oc.CallbackURL,
+		"created_at":                    oc.CreatedAt.Format(time.RFC3339),
+		"organization_scoped":           oc.OrganizationScoped,
+		"oauth_token_id":                "",
+	}
+
+	if oc.Name != nil {
+		m["name"] = *oc.Name
+	}
+	// OAuth clients have a single OAuth token once they are connected.
+	if len(oc.OAuthTokens) > 0 {
+		m["oauth_token_id"] = oc.OAuthTokens[0].ID
+	}
+
+	return m
+}
+
+func dataSourceTFEOAuthClientsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
+	serviceProvider := d.Get("service_provider").(string)
+
+	ids := make(map[string]string)
+	var oauthClients []interface{}
+
+	log.Printf("[DEBUG] List OAuth clients of organization: %s", organization)
+	options := &tfe.OAuthClientListOptions{}
+	for {
+		l, err := tfeClient.OAuthClients.List(ctx, organization, options)
+		if err != nil {
+			return fmt.Errorf("Error retrieving OAuth clients of organization %s: %w", organization, err)
+		}
+
+		for _, oc := range l.Items {
+			if serviceProvider != "" && string(oc.ServiceProvider) != serviceProvider {
+				continue
+			}
+
+			if oc.Name != nil && *oc.Name != "" {
+				ids[*oc.Name] = oc.ID
+			}
+			oauthClients = append(oauthClients, flattenOAuthClient(oc))
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", organization, serviceProvider))
+	d.Set("ids", ids)
+	d.Set("oauth_clients", oauthClients)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package tfe
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestDataSourceTFEOAuthClientsRead(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/hashicorp/oauth-clients":
+			fmt.Fprint(w, `{"data":[
+				{"id":"oc-1","type":"oauth-clients","attributes":{"name":"github-main","service-provider":"github","organization-scoped":true},
+				 "relationships":{"oauth-tokens":{"data":[{"id":"ot-1","type":"oauth-tokens"}]}}},
+				{"id":"oc-2","type":"oauth-clients","attributes":{"name":"gitlab","service-provider":"gitlab_hosted"}},
+				{"id":"oc-3","type":"oauth-clients","attributes":{"name":null,"service-provider":"github"}}
+			],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	d := dataSourceTFEOAuthClients().TestResourceData()
+	d.Set("organization", "hashicorp")
+	d.Set("service_provider", "github")
+
+	if err := dataSourceTFEOAuthClientsRead(ctx, d, ConfiguredClient{Client: client}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"ids.github-main":                     "oc-1",
+		"oauth_clients.#":                     2,
+		"oauth_clients.0.name":                "github-main",
+		"oauth_clients.0.oauth_token_id":      "ot-1",
+		"oauth_clients.0.organization_scoped": true,
+		"oauth_clients.1.id":                  "oc-3",
+		"oauth_clients.1.name":                "",
+		"oauth_clients.1.oauth_token_id":      "",
+	}
+	for key, want := range expected {
+		if got := d.Get(key); got != want {
+			t.Errorf("expected %s to be %v, got %v", key, want, got)
+		}
+	}
+
+	// Unnamed OAuth clients can not be looked up by name.
+	if ids := d.Get("ids").(map[string]interface{}); len(ids) != 1 {
+		t.Errorf("expected only the named OAuth client in ids, got %v", ids)
+	}
+}
@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -10,7 +11,7 @@ import (
 
 func dataSourceTFEOrganization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEOrganizationRead,
+		ReadContext: crudContext(dataSourceTFEOrganizationRead),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -18,6 +19,12 @@ func dataSourceTFEOrganization() *schema.Resource {
 				Required: true,
 			},
 
+			"include_entitlements": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
+
 			"external_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -38,6 +45,11 @@ func dataSourceTFEOrganization() *schema.Resource {
 				Computed: true,
 			},
 
+			"default_terraform_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"email": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -62,12 +74,25 @@ func dataSourceTFEOrganization() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+
+			"allow_force_delete_workspaces": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"entitlements": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: organizationEntitlementsSchema(),
+				},
+			},
 		},
 	}
 }
 
-func dataSourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceTFEOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	name := d.Get("name").(string)
 	log.Printf("[DEBUG] Read configuration for Organization: %s", name)
@@ -95,6 +120,28 @@ func dataSourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("two_factor_conformant", org.TwoFactorConformant)
 	d.Set("send_passing_statuses_for_untriggered_speculative_plans", org.SendPassingStatusesForUntriggeredSpeculativePlans)
 	d.Set("assessments_enforced", org.AssessmentsEnforced)
+	d.Set("allow_force_delete_workspaces", org.AllowForceDeleteWorkspaces)
+
+	defaultTerraformVersion, supported, err := readOrganizationDefaultTerraformVersion(ctx, tfeClient, org.Name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving default Terraform version of organization: %w", err)
+	}
+	if supported {
+		d.Set("default_terraform_version", defaultTerraformVersion)
+	}
+
+	// The entitlements are read with another request, which fails without
+	// access to them, so they are only read when asked for.
+	var entitlements []interface{}
+	if d.Get("include_entitlements").(bool) {
+		log.Printf("[DEBUG] Read entitlements of organization: %s", org.Name)
+		e, err := fetchOrganizationEntitlements(ctx, tfeClient, org.Name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving entitlements of organization %s: %w", org.Name, err)
+		}
+		entitlements = append(entitlements, flattenOrganizationEntitlements(e))
+	}
+	d.Set("entitlements", entitlements)
 
 	return nil
 }
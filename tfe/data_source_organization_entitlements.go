@@ -0,0 +1,150 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// organizationEntitlements mirrors the entitlement set of an organization. It
+// covers more of the feature flags than tfe.Entitlements, so that tier
+// dependent features can be detected before trying to use them.
+type organizationEntitlements struct {
+	ID                    string `jsonapi:"primary,entitlement-sets"`
+	Agents                bool   `jsonapi:"attr,agents"`
+	AuditLogging          bool   `jsonapi:"attr,audit-logging"`
+	CostEstimation        bool   `jsonapi:"attr,cost-estimation"`
+	GlobalRunTasks        bool   `jsonapi:"attr,global-run-tasks"`
+	ModuleTestsGeneration bool   `jsonapi:"attr,module-tests-generation"`
+	Operations            bool   `jsonapi:"attr,operations"`
+	PolicyEnforcement     bool   `jsonapi:"attr,policy-enforcement"`
+	PrivateModuleRegistry bool   `jsonapi:"attr,private-module-registry"`
+	PrivatePolicyAgents   bool   `jsonapi:"attr,private-policy-agents"`
+	PrivateVCS            bool   `jsonapi:"attr,private-vcs"`
+	RunTasks              bool   `jsonapi:"attr,run-tasks"`
+	SelfServeBilling      bool   `jsonapi:"attr,self-serve-billing"`
+	Sentinel              bool   `jsonapi:"attr,sentinel"`
+	SSO                   bool   `jsonapi:"attr,sso"`
+	StateStorage          bool   `jsonapi:"attr,state-storage"`
+	Teams                 bool   `jsonapi:"attr,teams"`
+	UsageReporting        bool   `jsonapi:"attr,usage-reporting"`
+	VCSIntegrations       bool   `jsonapi:"attr,vcs-integrations"`
+}
+
+func dataSourceTFEOrganizationEntitlements() *schema.Resource {
+	s := organizationEntitlementsSchema()
+	s["organization"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFEOrganizationEntitlementsRead),
+		Schema:      s,
+	}
+}
+
+// organizationEntitlementsSchema returns the computed attributes of an
+// entitlement set, which are shared by the tfe_organization_entitlements,
+// tfe_organization and tfe_organizations data sources.
+func organizationEntitlementsSchema() map[string]*schema.Schema {
+	entitlements := []string{
+		"agents",
+		"audit_logging",
+		"cost_estimation",
+		"global_run_tasks",
+		"module_tests_generation",
+		"operations",
+		"policy_enforcement",
+		"private_module_registry",
+		"private_policy_agents",
+		"private_vcs",
+		"run_tasks",
+		"self_serve_billing",
+		"sentinel",
+		"sso",
+		"state_storage",
+		"teams",
+		"usage_reporting",
+		"vcs_integrations",
+	}
+
+	s := map[string]*schema.Schema{}
+	for _, entitlement := range entitlements {
+		s[entitlement] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		}
+	}
+
+	return s
+}
+
+// flattenOrganizationEntitlements returns the attributes described by
+// organizationEntitlementsSchema.
+func flattenOrganizationEntitlements(entitlements *organizationEntitlements) map[string]interface{} {
+	return map[string]interface{}{
+		"agents":                  entitlements.Agents,
+		"audit_logging":           entitlements.AuditLogging,
+		"cost_estimation":         entitlements.CostEstimation,
+		"global_run_tasks":        entitlements.GlobalRunTasks,
+		"module_tests_generation": entitlements.ModuleTestsGeneration,
+		"operations":              entitlements.Operations,
+		"policy_enforcement":      entitlements.PolicyEnforcement,
+		"private_module_registry": entitlements.PrivateModuleRegistry,
+		"private_policy_agents":   entitlements.PrivatePolicyAgents,
+		"private_vcs":             entitlements.PrivateVCS,
+		"run_tasks":               entitlements.RunTasks,
+		"self_serve_billing":      entitlements.SelfServeBilling,
+		"sentinel":                entitlements.Sentinel,
+		"sso":                     entitlements.SSO,
+		"state_storage":           entitlements.StateStorage,
+		"teams":                   entitlements.Teams,
+		"usage_reporting":         entitlements.UsageReporting,
+		"vcs_integrations":        entitlements.VCSIntegrations,
+	}
+}
+
+func dataSourceTFEOrganizationEntitlementsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Read entitlements of organization: %s", organization)
+	entitlements, err := fetchOrganizationEntitlements(ctx, tfeClient, organization)
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			return fmt.Errorf("could not read entitlements of organization %s", organization)
+		}
+		return fmt.Errorf("Error retrieving organization entitlements: %w", err)
+	}
+
+	d.SetId(entitlements.ID)
+	for k, v := range flattenOrganizationEntitlements(entitlements) {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func fetchOrganizationEntitlements(ctx context.Context, client *tfe.Client, organization string) (*organizationEntitlements, error) {
+	u := fmt.Sprintf("organizations/%s/entitlement-set", url.QueryEscape(organization))
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	entitlements := &organizationEntitlements{}
+	if err := req.Do(ctx, entitlements); err != nil {
+		return nil, err
+	}
+
+	return entitlements, nil
+}
@@ -0,0 +1,83 @@
+package tfe
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestFetchOrganizationEntitlements(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/my-org/entitlement-set":
+			fmt.Fprint(w, `{"data":{"id":"org-123","type":"entitlement-sets","attributes":{"agents":true,"sso":true,"teams":false}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	entitlements, err := fetchOrganizationEntitlements(ctx, client, "my-org")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	m := flattenOrganizationEntitlements(entitlements)
+	if len(m) != len(organizationEntitlementsSchema()) {
+		t.Fatalf("expected all entitlements to be flattened, got %v", m)
+	}
+	for k, v := range map[string]bool{"agents": true, "sso": true, "teams": false, "audit_logging": false} {
+		if m[k] != v {
+			t.Fatalf("expected %s to be %t, got %v", k, v, m[k])
+		}
+	}
+
+	if _, err := fetchOrganizationEntitlements(ctx, client, "unknown"); !isErrResourceNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestAccTFEOrganizationEntitlementsDataSource_basic(t *testing.T) {
+	skipIfEnterprise(t)
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEOrganizationEntitlementsDataSourceConfig(org.Name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_organization_entitlements.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_entitlements.foo", "organization", org.Name),
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_entitlements.foo", "state_storage", "true"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_entitlements.foo", "teams", "true"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_entitlements.foo", "sentinel", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEOrganizationEntitlementsDataSourceConfig(organization string) string {
+	return fmt.Sprintf(`
+data "tfe_organization_entitlements" "foo" {
+  organization = "%s"
+}`, organization)
+}
@@ -1,18 +1,37 @@
 package tfe
 
 import (
-	"github.com/hashicorp/go-tfe"
+	"context"
+	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceTFEOrganizationMembers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEOrganizationMembersRead,
+		ReadContext: crudContext(dataSourceTFEOrganizationMembersRead),
 
 		Schema: map[string]*schema.Schema{
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+			},
+
+			"team_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.OrganizationMembershipActive),
+						string(tfe.OrganizationMembershipInvited),
+					},
+					false,
+				),
 			},
 
 			"members": {
@@ -54,12 +73,20 @@ func dataSourceTFEOrganizationMembers() *schema.Resource {
 	}
 }
 
-func dataSourceTFEOrganizationMembersRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceTFEOrganizationMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	organizationName, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
-	organizationName := d.Get("organization").(string)
+	filter := organizationMembersFilter{
+		TeamID: d.Get("team_id").(string),
+		Status: tfe.OrganizationMembershipStatus(d.Get("status").(string)),
+	}
 
-	members, membersWaiting, err := fetchOrganizationMembers(tfeClient, organizationName)
+	members, membersWaiting, err := fetchOrganizationMembers(ctx, tfeClient, organizationName, filter)
 	if err != nil {
 		return err
 	}
@@ -59,3 +59,67 @@ data "tfe_organization_members" "all_members" {
   organization = "%s"
 }`, orgName)
 }
+
+func TestAccTFEOrganizationMembersDataSource_filters(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	options := tfe.OrganizationMembershipCreateOptions{
+		Email: tfe.String("invited_user@company.com"),
+	}
+	membership := createOrganizationMembership(t, tfeClient, org.Name, options)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEOrganizationMembersDataSourceConfig_filters(org.Name, membership.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Only the invited member was added to the team.
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_members.team", "members.#", "0"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_members.team", "members_waiting.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_members.team", "members_waiting.0.organization_membership_id", membership.ID),
+
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_members.active", "members.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_members.active", "members_waiting.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEOrganizationMembersDataSourceConfig_filters(orgName, membershipID string) string {
+	return fmt.Sprintf(`
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = "%s"
+}
+
+resource "tfe_team_organization_member" "foobar" {
+  team_id                    = tfe_team.foobar.id
+  organization_membership_id = "%s"
+}
+
+data "tfe_organization_members" "team" {
+  organization = "%[1]s"
+  team_id      = tfe_team.foobar.id
+
+  depends_on = [tfe_team_organization_member.foobar]
+}
+
+data "tfe_organization_members" "active" {
+  organization = "%[1]s"
+  status       = "active"
+}`, orgName, membershipID)
+}
@@ -4,13 +4,12 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEOrganizationMembership() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEOrganizationMembershipRead,
+		ReadContext: crudContext(dataSourceTFEOrganizationMembershipRead),
 
 		Schema: map[string]*schema.Schema{
 			"email": {
@@ -26,7 +25,7 @@ func dataSourceTFEOrganizationMembership() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 
 			"user_id": {
@@ -37,13 +36,16 @@ func dataSourceTFEOrganizationMembership() *schema.Resource {
 	}
 }
 
-func dataSourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceTFEOrganizationMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the user email and organization.
 	email := d.Get("email").(string)
 	username := d.Get("username").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	orgMember, err := fetchOrganizationMemberByNameOrEmail(context.Background(), tfeClient, organization, username, email)
 	if err != nil {
@@ -51,5 +53,5 @@ func dataSourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interf
 	}
 
 	d.SetId(orgMember.ID)
-	return resourceTFEOrganizationMembershipRead(d, meta)
+	return resourceTFEOrganizationMembershipRead(ctx, d, meta)
 }
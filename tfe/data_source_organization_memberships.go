@@ -0,0 +1,138 @@
+package tfe
+
+import (
+	"context"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTFEOrganizationMemberships() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFEOrganizationMembershipsRead),
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.OrganizationMembershipActive),
+						string(tfe.OrganizationMembershipInvited),
+					},
+					false,
+				),
+			},
+
+			"emails": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"memberships": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"team_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTFEOrganizationMembershipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
+	status := tfe.OrganizationMembershipStatus(d.Get("status").(string))
+
+	var emails []string
+	for _, email := range d.Get("emails").(*schema.Set).List() {
+		emails = append(emails, email.(string))
+	}
+
+	log.Printf("[DEBUG] List organization memberships of: %s", organization)
+	memberships, err := listOrganizationMemberships(ctx, tfeClient, organization, status, emails)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(memberships))
+	result := make([]interface{}, 0, len(memberships))
+	for _, membership := range memberships {
+		userID, username := "", ""
+		if membership.User != nil {
+			userID = membership.User.ID
+			username = membership.User.Username
+		}
+
+		teamIDs := make([]string, 0, len(membership.Teams))
+		for _, team := range membership.Teams {
+			teamIDs = append(teamIDs, team.ID)
+		}
+
+		ids = append(ids, membership.ID)
+		result = append(result, map[string]interface{}{
+			"id":       membership.ID,
+			"email":    membership.Email,
+			"status":   string(membership.Status),
+			"user_id":  userID,
+			"username": username,
+			"team_ids": teamIDs,
+		})
+	}
+
+	d.SetId(organization)
+	d.Set("ids", ids)
+	d.Set("memberships", result)
+
+	return nil
+}
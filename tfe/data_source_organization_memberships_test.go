@@ -0,0 +1,103 @@
+package tfe
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFEOrganizationMembershipsDataSource_basic(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	options := tfe.OrganizationMembershipCreateOptions{
+		Email: tfe.String("invited_user@company.com"),
+	}
+	createOrganizationMembership(t, tfeClient, org.Name, options)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEOrganizationMembershipsDataSourceConfig(org.Name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_memberships.invited", "memberships.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_memberships.invited", "memberships.0.email", "invited_user@company.com"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_memberships.invited", "memberships.0.status", "invited"),
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_organization_memberships.invited", "memberships.0.user_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceTFEOrganizationMembershipsRead(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	client := server.Client
+	MockOrganizationMemberships(t, client, "hashicorp", []*tfe.OrganizationMembership{
+		{
+			ID:     "ou-1",
+			Email:  "active@company.com",
+			Status: tfe.OrganizationMembershipActive,
+			User:   &tfe.User{ID: "user-1", Username: "active"},
+			Teams:  []*tfe.Team{{ID: "team-1"}, {ID: "team-2"}},
+		},
+		{
+			ID:     "ou-2",
+			Email:  "invited@company.com",
+			Status: tfe.OrganizationMembershipInvited,
+			User:   &tfe.User{ID: "user-2"},
+		},
+	})
+
+	d := dataSourceTFEOrganizationMemberships().TestResourceData()
+	d.Set("organization", "hashicorp")
+
+	if err := dataSourceTFEOrganizationMembershipsRead(ctx, d, ConfiguredClient{Client: client}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := fmt.Sprint(d.Get("ids")); got != "[ou-1 ou-2]" {
+		t.Fatalf("expected ids [ou-1 ou-2], got %s", got)
+	}
+
+	expected := map[string]string{
+		"memberships.0.email":      "active@company.com",
+		"memberships.0.status":     "active",
+		"memberships.0.user_id":    "user-1",
+		"memberships.0.username":   "active",
+		"memberships.0.team_ids.1": "team-2",
+		"memberships.1.status":     "invited",
+		"memberships.1.user_id":    "user-2",
+	}
+	for key, want := range expected {
+		if got := d.Get(key).(string); got != want {
+			t.Errorf("expected %s to be %q, got %q", key, want, got)
+		}
+	}
+}
+
+func testAccTFEOrganizationMembershipsDataSourceConfig(organization string) string {
+	return fmt.Sprintf(`
+data "tfe_organization_memberships" "invited" {
+  organization = "%s"
+  status       = "invited"
+}`, organization)
+}
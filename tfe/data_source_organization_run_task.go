@@ -1,13 +1,14 @@
 package tfe
 
 import (
-	"github.com/hashicorp/go-tfe"
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceTFEOrganizationRunTask() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEOrganizationRunTaskRead,
+		ReadContext: crudContext(dataSourceTFEOrganizationRunTaskRead),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -17,7 +18,7 @@ func dataSourceTFEOrganizationRunTask() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 
 			"url": {
@@ -43,12 +44,15 @@ func dataSourceTFEOrganizationRunTask() *schema.Resource {
 	}
 }
 
-func dataSourceTFEOrganizationRunTaskRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceTFEOrganizationRunTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
-	task, err := fetchOrganizationRunTask(name, organization, tfeClient)
+	task, err := fetchOrganizationRunTask(ctx, name, organization, tfeClient)
 	if err != nil {
 		return err
 	}
@@ -30,6 +30,9 @@ func TestAccTFEOrganizationDataSource_basic(t *testing.T) {
 					// check data attrs
 					resource.TestCheckResourceAttr("data.tfe_organization.foo", "name", orgName),
 					resource.TestCheckResourceAttr("data.tfe_organization.foo", "email", "admin@company.com"),
+					resource.TestCheckResourceAttr("data.tfe_organization.foo", "allow_force_delete_workspaces", "false"),
+					resource.TestCheckResourceAttr("data.tfe_organization.foo", "entitlements.#", "1"),
+					resource.TestCheckResourceAttr("data.tfe_organization.foo", "entitlements.0.state_storage", "true"),
 				),
 			},
 		},
@@ -70,7 +73,8 @@ resource "tfe_organization" "foo" {
 }
 
 data "tfe_organization" "foo" {
-  name  = tfe_organization.foo.name
+  name                 = tfe_organization.foo.name
+  include_entitlements = true
 	depends_on = [tfe_organization.foo]
 }`, rInt)
 }
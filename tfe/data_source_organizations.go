@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -10,7 +11,7 @@ import (
 
 func dataSourceTFEOrganizations() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEOrganizationList,
+		ReadContext: crudContext(dataSourceTFEOrganizationList),
 
 		Schema: map[string]*schema.Schema{
 			"names": {
@@ -29,21 +30,42 @@ func dataSourceTFEOrganizations() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+
+			"include_entitlements": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
+
+			"entitlements": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: func() map[string]*schema.Schema {
+						s := organizationEntitlementsSchema()
+						s["name"] = &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						}
+						return s
+					}(),
+				},
+			},
 		},
 	}
 }
 
-func dataSourceTFEOrganizationList(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceTFEOrganizationList(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	var names []string
 	var ids map[string]string
 	var err error
 
 	if isAdmin(d) {
-		names, ids, err = adminOrgsPopulateFields(tfeClient)
+		names, ids, err = adminOrgsPopulateFields(ctx, tfeClient)
 	} else {
-		names, ids, err = orgsPopulateFields(tfeClient)
+		names, ids, err = orgsPopulateFields(ctx, tfeClient)
 	}
 
 	if err != nil {
@@ -55,10 +77,28 @@ func dataSourceTFEOrganizationList(d *schema.ResourceData, meta interface{}) err
 	d.Set("names", names)
 	d.Set("ids", ids)
 
+	// The entitlements are read with a request per organization, so they are
+	// only read when asked for.
+	var entitlements []interface{}
+	if d.Get("include_entitlements").(bool) {
+		for _, name := range names {
+			log.Printf("[DEBUG] Read entitlements of organization: %s", name)
+			e, err := fetchOrganizationEntitlements(ctx, tfeClient, name)
+			if err != nil {
+				return fmt.Errorf("Error retrieving entitlements of organization %s: %w", name, err)
+			}
+
+			m := flattenOrganizationEntitlements(e)
+			m["name"] = name
+			entitlements = append(entitlements, m)
+		}
+	}
+	d.Set("entitlements", entitlements)
+
 	return nil
 }
 
-func adminOrgsPopulateFields(client *tfe.Client) ([]string, map[string]string, error) {
+func adminOrgsPopulateFields(ctx context.Context, client *tfe.Client) ([]string, map[string]string, error) {
 	names := []string{}
 	ids := map[string]string{}
 	log.Printf("[DEBUG] Listing all organizations (admin)")
@@ -90,7 +130,7 @@ func adminOrgsPopulateFields(client *tfe.Client) ([]string, map[string]string, e
 	return names, ids, nil
 }
 
-func orgsPopulateFields(client *tfe.Client) ([]string, map[string]string, error) {
+func orgsPopulateFields(ctx context.Context, client *tfe.Client) ([]string, map[string]string, error) {
 	names := []string{}
 	ids := map[string]string{}
 	log.Printf("[DEBUG] Listing all organizations (non-admin)")
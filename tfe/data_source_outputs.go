@@ -15,7 +15,8 @@ import (
 )
 
 type dataSourceOutputs struct {
-	tfeClient *tfe.Client
+	tfeClient    *tfe.Client
+	organization string
 }
 
 var stderr *os.File
@@ -26,9 +27,10 @@ func init() {
 	stderr = os.Stderr
 }
 
-func newDataSourceOutputs(client *tfe.Client) tfprotov5.DataSourceServer {
+func newDataSourceOutputs(client *tfe.Client, organization string) tfprotov5.DataSourceServer {
 	return dataSourceOutputs{
-		tfeClient: client,
+		tfeClient:    client,
+		organization: organization,
 	}
 }
 
@@ -136,13 +138,20 @@ func (d dataSourceOutputs) readConfigValues(req *tfprotov5.ReadDataSourceRequest
 		return orgName, wsName, fmt.Errorf("Error assigning configuration attributes to map: %w", err)
 	}
 
-	if valMap["organization"].IsNull() || valMap["workspace"].IsNull() {
-		return orgName, wsName, fmt.Errorf("Organization and Workspace cannot be nil: %w", err)
+	if valMap["workspace"].IsNull() {
+		return orgName, wsName, fmt.Errorf("Workspace cannot be nil")
 	}
 
-	err = valMap["organization"].As(&orgName)
-	if err != nil {
-		return orgName, wsName, fmt.Errorf("Error assigning 'organization' value to string: %w", err)
+	if valMap["organization"].IsNull() {
+		if d.organization == "" {
+			return orgName, wsName, errMissingOrganization
+		}
+		orgName = d.organization
+	} else {
+		err = valMap["organization"].As(&orgName)
+		if err != nil {
+			return orgName, wsName, fmt.Errorf("Error assigning 'organization' value to string: %w", err)
+		}
 	}
 
 	err = valMap["workspace"].As(&wsName)
@@ -11,10 +11,54 @@ import (
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
 )
 
+func TestParseStateOutput(t *testing.T) {
+	sd := &stateData{
+		outputs: map[string]*outputData{
+			"public": {
+				Value:     cty.StringVal("foo"),
+				Sensitive: cty.False,
+			},
+			"secret": {
+				Value:     cty.StringVal("bar"),
+				Sensitive: cty.True,
+			},
+			"list": {
+				Value:     cty.TupleVal([]cty.Value{cty.NumberIntVal(1)}),
+				Sensitive: cty.False,
+			},
+		},
+	}
+
+	values, types, nonsensitiveValues, nonsensitiveTypes, err := parseStateOutput(sd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(values) != 3 || len(types) != 3 {
+		t.Fatalf("expected all 3 outputs in values, got %v", values)
+	}
+	if len(nonsensitiveValues) != 2 || len(nonsensitiveTypes) != 2 {
+		t.Fatalf("expected 2 outputs in nonsensitive_values, got %v", nonsensitiveValues)
+	}
+	if _, ok := nonsensitiveValues["secret"]; ok {
+		t.Fatal("expected sensitive outputs to be excluded from nonsensitive_values")
+	}
+
+	if !nonsensitiveTypes["public"].Is(tftypes.String) {
+		t.Fatalf("expected public to be a string, got %s", nonsensitiveTypes["public"])
+	}
+	var public string
+	if err := nonsensitiveValues["public"].As(&public); err != nil || public != "foo" {
+		t.Fatalf("expected public to be foo, got %q (%v)", public, err)
+	}
+}
+
 func TestAccTFEOutputs(t *testing.T) {
 	skipIfUnitTest(t)
 
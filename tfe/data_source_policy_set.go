@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -10,7 +11,7 @@ import (
 
 func dataSourceTFEPolicySet() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEPolicySetRead,
+		ReadContext: crudContext(dataSourceTFEPolicySetRead),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -20,7 +21,7 @@ func dataSourceTFEPolicySet() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 
 			"description": {
@@ -45,6 +46,12 @@ func dataSourceTFEPolicySet() *schema.Resource {
 				Optional:    true,
 			},
 
+			"agent_enabled": {
+				Description: "Whether the policies of the policy set are evaluated by agents",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
 			"policies_path": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -56,6 +63,44 @@ func dataSourceTFEPolicySet() *schema.Resource {
 				Computed: true,
 			},
 
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"kind": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"enforce_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"vcs_repo": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -89,17 +134,38 @@ func dataSourceTFEPolicySet() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Computed: true,
 			},
+
+			"project_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			"workspace_exclusions": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
 		},
 	}
 }
 
-func dataSourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceTFEPolicySetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
-	listOptions := tfe.PolicySetListOptions{}
+	listOptions := tfe.PolicySetListOptions{
+		Include: []tfe.PolicySetIncludeOpt{
+			tfe.PolicySetPolicies,
+			tfe.PolicySetProjects,
+			tfe.PolicySetWorkspaceExclusions,
+		},
+	}
 
 	for {
 		policySetList, err := tfeClient.PolicySets.List(ctx, organization, &listOptions)
@@ -131,6 +197,12 @@ func dataSourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error
 					d.Set("overridable", policySet.Overridable)
 				}
 
+				agentEnabled, _, err := readPolicySetAgentEnabled(ctx, tfeClient, policySet.ID)
+				if err != nil {
+					return fmt.Errorf("Error reading agent setting of policy set %s: %w", name, err)
+				}
+				d.Set("agent_enabled", agentEnabled)
+
 				var vcsRepo []interface{}
 				if policySet.VCSRepo != nil {
 					vcsRepo = append(vcsRepo, map[string]interface{}{
@@ -143,10 +215,13 @@ func dataSourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error
 				d.Set("vcs_repo", vcsRepo)
 
 				var policyIDs []interface{}
+				var policies []interface{}
 				for _, policy := range policySet.Policies {
 					policyIDs = append(policyIDs, policy.ID)
+					policies = append(policies, flattenPolicySetPolicy(policy))
 				}
 				d.Set("policy_ids", policyIDs)
+				d.Set("policies", policies)
 
 				var workspaceIDs []interface{}
 				if !policySet.Global {
@@ -156,6 +231,20 @@ func dataSourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error
 				}
 				d.Set("workspace_ids", workspaceIDs)
 
+				var projectIDs []interface{}
+				if !policySet.Global {
+					for _, project := range policySet.Projects {
+						projectIDs = append(projectIDs, project.ID)
+					}
+				}
+				d.Set("project_ids", projectIDs)
+
+				var excludedWorkspaceIDs []interface{}
+				for _, workspace := range policySet.WorkspaceExclusions {
+					excludedWorkspaceIDs = append(excludedWorkspaceIDs, workspace.ID)
+				}
+				d.Set("workspace_exclusions", excludedWorkspaceIDs)
+
 				d.SetId(policySet.ID)
 
 				return nil
@@ -171,3 +260,24 @@ func dataSourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error
 	}
 	return fmt.Errorf("Could not find policy set %s/%s", organization, name)
 }
+
+// flattenPolicySetPolicy converts a policy of a policy set to its data source
+// representation. A policy has a single enforcement, so only the first one is
+// used.
+func flattenPolicySetPolicy(policy *tfe.Policy) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":           policy.ID,
+		"name":         policy.Name,
+		"description":  policy.Description,
+		"kind":         string(policy.Kind),
+		"enforce_mode": "",
+		"path":         "",
+	}
+
+	if len(policy.Enforce) > 0 {
+		result["enforce_mode"] = string(policy.Enforce[0].Mode)
+		result["path"] = policy.Enforce[0].Path
+	}
+
+	return result
+}
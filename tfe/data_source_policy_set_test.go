@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -39,6 +40,18 @@ func TestAccTFEPolicySetDataSource_basic(t *testing.T) {
 						"data.tfe_policy_set.bar", "organization", org.Name),
 					resource.TestCheckResourceAttr(
 						"data.tfe_policy_set.bar", "policy_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_set.bar", "policies.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_policy_set.bar", "policies.0.id", "tfe_sentinel_policy.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_set.bar", "policies.0.name", "policy-foo"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_set.bar", "policies.0.kind", "sentinel"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_set.bar", "policies.0.enforce_mode", "soft-mandatory"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_set.bar", "policies.0.path", "policy-foo.sentinel"),
 					resource.TestCheckResourceAttr(
 						"data.tfe_policy_set.bar", "workspace_ids.#", "1"),
 					resource.TestCheckResourceAttr(
@@ -166,6 +179,33 @@ func TestAccTFEPolicySetDataSource_notFound(t *testing.T) {
 	)
 }
 
+func TestFlattenPolicySetPolicy(t *testing.T) {
+	policy := &tfe.Policy{
+		ID:   "pol-123",
+		Name: "restrict-instance-types",
+		Kind: tfe.Sentinel,
+		Enforce: []*tfe.Enforcement{
+			{
+				Path: "restrict-instance-types.sentinel",
+				Mode: tfe.EnforcementHard,
+			},
+		},
+	}
+
+	result := flattenPolicySetPolicy(policy)
+	if result["enforce_mode"] != "hard-mandatory" {
+		t.Fatalf("expected enforce_mode hard-mandatory, got %v", result["enforce_mode"])
+	}
+	if result["path"] != "restrict-instance-types.sentinel" {
+		t.Fatalf("expected path restrict-instance-types.sentinel, got %v", result["path"])
+	}
+
+	result = flattenPolicySetPolicy(&tfe.Policy{ID: "pol-456", Kind: tfe.OPA})
+	if result["enforce_mode"] != "" || result["path"] != "" {
+		t.Fatalf("expected no enforcement, got %v", result)
+	}
+}
+
 func testAccTFEPolicySetDataSourceConfig_basic(organization string, rInt int) string {
 	return fmt.Sprintf(`
 locals {
@@ -0,0 +1,208 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTFEPolicySets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFEPolicySetsRead),
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"search": {
+				Description: "A partial policy set name to filter the policy sets by",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"kind": {
+				Description: "The policy-as-code framework to filter the policy sets by. Valid values are sentinel and opa",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.OPA),
+						string(tfe.Sentinel),
+					},
+					false,
+				),
+			},
+
+			"ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"policy_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"kind": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"global": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"overridable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"policy_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"workspace_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"project_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"workspace_exclusions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenPolicySetScope returns the policy set in the representation of the
+// policy sets data source.
+func flattenPolicySetScope(policySet *tfe.PolicySet) map[string]interface{} {
+	policyIDs := []string{}
+	for _, policy := range policySet.Policies {
+		policyIDs = append(policyIDs, policy.ID)
+	}
+
+	// Global policy sets apply to all workspaces, so the workspaces and
+	// projects they are attached to are not relevant.
+	workspaceIDs := []string{}
+	projectIDs := []string{}
+	if !policySet.Global {
+		for _, workspace := range policySet.Workspaces {
+			workspaceIDs = append(workspaceIDs, workspace.ID)
+		}
+		for _, project := range policySet.Projects {
+			projectIDs = append(projectIDs, project.ID)
+		}
+	}
+
+	excludedWorkspaceIDs := []string{}
+	for _, workspace := range policySet.WorkspaceExclusions {
+		excludedWorkspaceIDs = append(excludedWorkspaceIDs, workspace.ID)
+	}
+
+	overridable := false
+	if policySet.Overridable != nil {
+		overridable = *policySet.Overridable
+	}
+
+	return map[string]interface{}{
+		"id":                   policySet.ID,
+		"name":                 policySet.Name,
+		"description":          policySet.Description,
+		"kind":                 string(policySet.Kind),
+		"global":               policySet.Global,
+		"overridable":          overridable,
+		"policy_ids":           policyIDs,
+		"workspace_ids":        workspaceIDs,
+		"project_ids":          projectIDs,
+		"workspace_exclusions": excludedWorkspaceIDs,
+	}
+}
+
+func dataSourceTFEPolicySetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
+	search := d.Get("search").(string)
+	kind := d.Get("kind").(string)
+
+	log.Printf("[DEBUG] List policy sets of organization: %s", organization)
+	list, err := listAllPages(func(pageNumber int) ([]*tfe.PolicySet, *tfe.Pagination, error) {
+		options := &tfe.PolicySetListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: listPageSize},
+			Search:      search,
+			Kind:        tfe.PolicyKind(kind),
+			Include: []tfe.PolicySetIncludeOpt{
+				tfe.PolicySetPolicies,
+				tfe.PolicySetProjects,
+				tfe.PolicySetWorkspaceExclusions,
+			},
+		}
+
+		psl, err := tfeClient.PolicySets.List(ctx, organization, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		return psl.Items, psl.Pagination, nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving policy sets of organization %s: %w", organization, err)
+	}
+
+	ids := make(map[string]string)
+	var policySets []interface{}
+	for _, policySet := range list {
+		// Older servers ignore the kind filter.
+		if kind != "" && string(policySet.Kind) != kind {
+			continue
+		}
+
+		ids[policySet.Name] = policySet.ID
+		policySets = append(policySets, flattenPolicySetScope(policySet))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", organization, kind, search))
+	d.Set("ids", ids)
+	d.Set("policy_sets", policySets)
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFEPolicySetsDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEPolicySetsDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_sets.all", "policy_sets.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_policy_sets.all", "ids.tst-policy-set", "tfe_policy_set.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_policy_sets.all", "policy_sets.0.kind", "sentinel"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_policy_sets.all", "policy_sets.0.workspace_ids.0", "tfe_workspace.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceTFEPolicySetsRead(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/hashicorp/policy-sets":
+			if r.URL.Query().Get("filter[kind]") != "opa" {
+				t.Errorf("expected the kind filter to be sent, got %q", r.URL.RawQuery)
+			}
+			// The second policy set is returned to verify the kind is also
+			// filtered by the provider.
+			fmt.Fprint(w, `{"data":[
+				{"id":"polset-1","type":"policy-sets","attributes":{"name":"global","kind":"opa","global":true,"overridable":true},
+				 "relationships":{
+					"policies":{"data":[{"id":"pol-1","type":"policies"}]},
+					"workspaces":{"data":[{"id":"ws-1","type":"workspaces"}]},
+					"workspace-exclusions":{"data":[{"id":"ws-2","type":"workspaces"}]}}},
+				{"id":"polset-2","type":"policy-sets","attributes":{"name":"legacy","kind":"sentinel"}},
+				{"id":"polset-3","type":"policy-sets","attributes":{"name":"scoped","kind":"opa","description":"Scoped"},
+				 "relationships":{
+					"workspaces":{"data":[{"id":"ws-3","type":"workspaces"}]},
+					"projects":{"data":[{"id":"prj-1","type":"projects"}]}}}
+			],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	d := dataSourceTFEPolicySets().TestResourceData()
+	d.Set("organization", "hashicorp")
+	d.Set("kind", "opa")
+
+	if err := dataSourceTFEPolicySetsRead(ctx, d, ConfiguredClient{Client: client}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"ids.global":                           "polset-1",
+		"ids.scoped":                           "polset-3",
+		"policy_sets.0.policy_ids.0":           "pol-1",
+		"policy_sets.0.workspace_exclusions.0": "ws-2",
+		"policy_sets.1.description":            "Scoped",
+		"policy_sets.1.workspace_ids.0":        "ws-3",
+		"policy_sets.1.project_ids.0":          "prj-1",
+	}
+	for key, want := range expected {
+		if got := d.Get(key).(string); got != want {
+			t.Errorf("expected %s to be %q, got %q", key, want, got)
+		}
+	}
+
+	if got := len(d.Get("policy_sets").([]interface{})); got != 2 {
+		t.Errorf("expected 2 policy sets, got %d", got)
+	}
+	if got := len(d.Get("policy_sets.0.workspace_ids").([]interface{})); got != 0 {
+		t.Errorf("expected no workspaces for a global policy set, got %d", got)
+	}
+	if !d.Get("policy_sets.0.overridable").(bool) {
+		t.Error("expected the global policy set to be overridable")
+	}
+}
+
+func testAccTFEPolicySetsDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-foo"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_sentinel_policy" "foo" {
+  name         = "policy-foo"
+  policy       = "main = rule { true }"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_policy_set" "foobar" {
+  name          = "tst-policy-set"
+  description   = "Policy Set"
+  organization  = tfe_organization.foobar.id
+  policy_ids    = [tfe_sentinel_policy.foo.id]
+  workspace_ids = [tfe_workspace.foobar.id]
+}
+
+data "tfe_policy_sets" "all" {
+  organization = tfe_organization.foobar.name
+  depends_on   = [tfe_policy_set.foobar]
+}`, rInt)
+}
@@ -0,0 +1,222 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTFERegistryModule() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFERegistryModuleRead),
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"module_provider": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"registry_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{"private", "public"},
+					true,
+				),
+			},
+
+			"no_code": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version_statuses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: registryModuleVersionStatusSchema(),
+				},
+			},
+
+			"vcs_repo": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: registryModuleVCSRepoSchema(),
+				},
+			},
+		},
+	}
+}
+
+func registryModuleVersionStatusSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"error": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func registryModuleVCSRepoSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"identifier": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"display_identifier": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"branch": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"ingress_submodules": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+
+		"oauth_token_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"repository_http_url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"service_provider": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceTFERegistryModuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
+
+	rmID := tfe.RegistryModuleID{
+		Organization: organization,
+		Name:         d.Get("name").(string),
+		Provider:     d.Get("module_provider").(string),
+		Namespace:    d.Get("namespace").(string),
+		RegistryName: tfe.RegistryName(d.Get("registry_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Read registry module %s/%s in organization %s", rmID.Name, rmID.Provider, rmID.Organization)
+	registryModule, err := tfeClient.RegistryModules.Read(ctx, rmID)
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			return fmt.Errorf("could not find registry module %s/%s in organization %s", rmID.Name, rmID.Provider, rmID.Organization)
+		}
+		return fmt.Errorf("Error retrieving registry module: %w", err)
+	}
+
+	d.SetId(registryModule.ID)
+	for key, value := range flattenRegistryModule(registryModule) {
+		d.Set(key, value)
+	}
+
+	return nil
+}
+
+// flattenRegistryModule converts a registry module into the attribute map
+// shared by the tfe_registry_module and tfe_registry_modules data sources.
+func flattenRegistryModule(registryModule *tfe.RegistryModule) map[string]interface{} {
+	var versionStatuses []interface{}
+	for _, vs := range registryModule.VersionStatuses {
+		versionStatuses = append(versionStatuses, map[string]interface{}{
+			"version": vs.Version,
+			"status":  string(vs.Status),
+			"error":   vs.Error,
+		})
+	}
+
+	var vcsRepo []interface{}
+	if registryModule.VCSRepo != nil {
+		vcsRepo = append(vcsRepo, map[string]interface{}{
+			"identifier":          registryModule.VCSRepo.Identifier,
+			"display_identifier":  registryModule.VCSRepo.DisplayIdentifier,
+			"branch":              registryModule.VCSRepo.Branch,
+			"ingress_submodules":  registryModule.VCSRepo.IngressSubmodules,
+			"oauth_token_id":      registryModule.VCSRepo.OAuthTokenID,
+			"repository_http_url": registryModule.VCSRepo.RepositoryHTTPURL,
+			"service_provider":    registryModule.VCSRepo.ServiceProvider,
+		})
+	}
+
+	result := map[string]interface{}{
+		"name":             registryModule.Name,
+		"module_provider":  registryModule.Provider,
+		"namespace":        registryModule.Namespace,
+		"registry_name":    string(registryModule.RegistryName),
+		"no_code":          registryModule.NoCode,
+		"status":           string(registryModule.Status),
+		"created_at":       registryModule.CreatedAt,
+		"updated_at":       registryModule.UpdatedAt,
+		"version_statuses": versionStatuses,
+		"vcs_repo":         vcsRepo,
+	}
+
+	if registryModule.Organization != nil {
+		result["organization"] = registryModule.Organization.Name
+	}
+
+	return result
+}
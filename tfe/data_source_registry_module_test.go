@@ -0,0 +1,63 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFERegistryModuleDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	orgName := fmt.Sprintf("tst-terraform-%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERegistryModuleDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_registry_module.foobar", "id", "tfe_registry_module.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_registry_module.foobar", "organization", orgName),
+					resource.TestCheckResourceAttr(
+						"data.tfe_registry_module.foobar", "name", "test_module"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_registry_module.foobar", "module_provider", "my_provider"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_registry_module.foobar", "namespace", orgName),
+					resource.TestCheckResourceAttr(
+						"data.tfe_registry_module.foobar", "registry_name", "private"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_registry_module.foobar", "vcs_repo.#", "0"),
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_registry_module.foobar", "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFERegistryModuleDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_registry_module" "foobar" {
+  organization    = tfe_organization.foobar.id
+  module_provider = "my_provider"
+  name            = "test_module"
+}
+
+data "tfe_registry_module" "foobar" {
+  organization    = tfe_organization.foobar.id
+  name            = tfe_registry_module.foobar.name
+  module_provider = tfe_registry_module.foobar.module_provider
+}`, rInt)
+}
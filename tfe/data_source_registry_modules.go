@@ -0,0 +1,147 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFERegistryModules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFERegistryModulesRead),
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			"modules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"organization": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"module_provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"registry_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"no_code": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"version_statuses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: registryModuleVersionStatusSchema(),
+							},
+						},
+
+						"vcs_repo": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: registryModuleVCSRepoSchema(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTFERegistryModulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	var modules []interface{}
+
+	options := &tfe.RegistryModuleListOptions{}
+	for {
+		rml, err := tfeClient.RegistryModules.List(ctx, organization, options)
+		if err != nil {
+			return fmt.Errorf("Error retrieving registry modules: %w", err)
+		}
+
+		for _, rm := range rml.Items {
+			module := flattenRegistryModule(rm)
+			module["id"] = rm.ID
+			if _, ok := module["organization"]; !ok {
+				module["organization"] = organization
+			}
+
+			ids = append(ids, rm.ID)
+			modules = append(modules, module)
+		}
+
+		// Exit the loop when we've seen all pages.
+		if rml.CurrentPage >= rml.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = rml.NextPage
+	}
+
+	d.SetId(organization)
+	d.Set("ids", ids)
+	d.Set("modules", modules)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFERegistryModulesDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	orgName := fmt.Sprintf("tst-terraform-%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERegistryModulesDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.tfe_registry_modules.all", "id", orgName),
+					resource.TestCheckResourceAttr(
+						"data.tfe_registry_modules.all", "ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_registry_modules.all", "modules.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_registry_modules.all", "modules.0.organization", orgName),
+					resource.TestCheckResourceAttr(
+						"data.tfe_registry_modules.all", "modules.0.registry_name", "private"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFERegistryModulesDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_registry_module" "foo" {
+  organization    = tfe_organization.foobar.id
+  module_provider = "my_provider"
+  name            = "foo"
+}
+
+resource "tfe_registry_module" "bar" {
+  organization    = tfe_organization.foobar.id
+  module_provider = "my_provider"
+  name            = "bar"
+}
+
+data "tfe_registry_modules" "all" {
+  organization = tfe_organization.foobar.id
+
+  depends_on = [
+    tfe_registry_module.foo,
+    tfe_registry_module.bar,
+  ]
+}`, rInt)
+}
@@ -0,0 +1,198 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	providerVersion "github.com/hashicorp/terraform-provider-tfe/version"
+	"github.com/hashicorp/terraform-svchost/disco"
+)
+
+func dataSourceTFERegistryProviderMirror() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFERegistryProviderMirrorRead),
+
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  defaultRegistryHostname,
+			},
+
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"protocols": {
+							Type:     schema.TypeList,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+
+						"platforms": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"os": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"arch": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"all_versions": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			"mirrored_versions": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			"missing_versions": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			"missing_platforms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"os": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"arch": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTFERegistryProviderMirrorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	hostname := d.Get("hostname").(string)
+	namespace := d.Get("namespace").(string)
+	name := d.Get("name").(string)
+
+	services := disco.New()
+	services.SetUserAgent(fmt.Sprintf("terraform-provider-tfe/%s", providerVersion.ProviderVersion))
+	services.Transport = NewLoggingTransport("Registry Discovery", http.DefaultTransport)
+
+	publicVersions, err := fetchPublicRegistryProviderVersions(services, hostname, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	// Only compare against the private registry when an organization was given.
+	mirrored := map[string]map[string]bool{}
+	organization := d.Get("organization").(string)
+	if organization != "" {
+		mirrored, err = fetchPrivateRegistryProviderVersions(ctx, tfeClient, organization, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	var versions []interface{}
+	allVersions := []string{}
+	mirroredVersions := []string{}
+	missingVersions := []string{}
+	missingPlatforms := []interface{}{}
+	for _, v := range publicVersions {
+		var platforms []interface{}
+		for _, p := range v.Platforms {
+			platforms = append(platforms, map[string]interface{}{
+				"os":   p.OS,
+				"arch": p.Arch,
+			})
+		}
+
+		versions = append(versions, map[string]interface{}{
+			"version":   v.Version,
+			"protocols": v.Protocols,
+			"platforms": platforms,
+		})
+
+		allVersions = append(allVersions, v.Version)
+
+		// A version is only mirrored when all of its platforms are.
+		mirroredPlatforms, ok := mirrored[v.Version]
+		missing := missingRegistryProviderPlatforms(v, mirroredPlatforms)
+		if ok && len(missing) == 0 {
+			mirroredVersions = append(mirroredVersions, v.Version)
+		} else {
+			missingVersions = append(missingVersions, v.Version)
+		}
+		for _, p := range missing {
+			missingPlatforms = append(missingPlatforms, map[string]interface{}{
+				"version": v.Version,
+				"os":      p.OS,
+				"arch":    p.Arch,
+			})
+		}
+	}
+
+	id := fmt.Sprintf("%s/%s/%s", hostname, namespace, name)
+	if organization != "" {
+		id = fmt.Sprintf("%s/%s", organization, id)
+	}
+	d.SetId(id)
+
+	d.Set("versions", versions)
+	d.Set("all_versions", allVersions)
+	d.Set("mirrored_versions", mirroredVersions)
+	d.Set("missing_versions", missingVersions)
+	d.Set("missing_platforms", missingPlatforms)
+
+	return nil
+}
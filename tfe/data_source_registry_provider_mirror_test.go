@@ -0,0 +1,49 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFERegistryProviderMirrorDataSource_basic(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERegistryProviderMirrorDataSourceConfig(org.Name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.tfe_registry_provider_mirror.null", "id", fmt.Sprintf("%s/registry.terraform.io/hashicorp/null", org.Name)),
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_registry_provider_mirror.null", "versions.0.version"),
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_registry_provider_mirror.null", "versions.0.platforms.0.os"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_registry_provider_mirror.null", "mirrored_versions.#", "0"),
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_registry_provider_mirror.null", "missing_platforms.0.os"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFERegistryProviderMirrorDataSourceConfig(organization string) string {
+	return fmt.Sprintf(`
+data "tfe_registry_provider_mirror" "null" {
+  namespace    = "hashicorp"
+  name         = "null"
+  organization = "%s"
+}`, organization)
+}
@@ -0,0 +1,116 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFERun() *schema.Resource {
+	s := runDataSourceSchema()
+	s["run_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFERunRead),
+		Schema:      s,
+	}
+}
+
+// runDataSourceSchema returns the computed attributes of a run, which are
+// shared by the tfe_run and tfe_runs data sources.
+func runDataSourceSchema() map[string]*schema.Schema {
+	computed := func(t schema.ValueType) *schema.Schema {
+		return &schema.Schema{
+			Type:     t,
+			Computed: true,
+		}
+	}
+
+	return map[string]*schema.Schema{
+		"workspace_id":      computed(schema.TypeString),
+		"status":            computed(schema.TypeString),
+		"source":            computed(schema.TypeString),
+		"message":           computed(schema.TypeString),
+		"is_destroy":        computed(schema.TypeBool),
+		"plan_only":         computed(schema.TypeBool),
+		"has_changes":       computed(schema.TypeBool),
+		"terraform_version": computed(schema.TypeString),
+		"created_at":        computed(schema.TypeString),
+		"created_by":        computed(schema.TypeString),
+		"plan_id":           computed(schema.TypeString),
+		"apply_id":          computed(schema.TypeString),
+		"html_url":          computed(schema.TypeString),
+	}
+}
+
+// runDataSourceIncludes are the relations needed to flatten a run.
+var runDataSourceIncludes = []tfe.RunIncludeOpt{tfe.RunCreatedBy, tfe.RunWorkspace}
+
+// flattenRun returns the attributes of a run described by
+// runDataSourceSchema.
+func flattenRun(config ConfiguredClient, run *tfe.Run) map[string]interface{} {
+	m := map[string]interface{}{
+		"status":            string(run.Status),
+		"source":            string(run.Source),
+		"message":           run.Message,
+		"is_destroy":        run.IsDestroy,
+		"plan_only":         run.PlanOnly,
+		"has_changes":       run.HasChanges,
+		"terraform_version": run.TerraformVersion,
+		"created_at":        run.CreatedAt.Format(time.RFC3339),
+		"workspace_id":      "",
+		"created_by":        "",
+		"plan_id":           "",
+		"apply_id":          "",
+		"html_url":          runHTMLURL(config, run),
+	}
+
+	if run.Workspace != nil {
+		m["workspace_id"] = run.Workspace.ID
+	}
+	// Runs which are queued by Terraform Cloud itself, like health
+	// assessments, have no user.
+	if run.CreatedBy != nil {
+		m["created_by"] = run.CreatedBy.Username
+	}
+	if run.Plan != nil {
+		m["plan_id"] = run.Plan.ID
+	}
+	if run.Apply != nil {
+		m["apply_id"] = run.Apply.ID
+	}
+
+	return m
+}
+
+func dataSourceTFERunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// Get the run ID.
+	runID := d.Get("run_id").(string)
+
+	log.Printf("[DEBUG] Read run: %s", runID)
+	run, err := tfeClient.Runs.ReadWithOptions(ctx, runID, &tfe.RunReadOptions{
+		Include: runDataSourceIncludes,
+	})
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return fmt.Errorf("could not find run %s", runID)
+		}
+		return fmt.Errorf("Error retrieving run %s: %w", runID, err)
+	}
+
+	d.SetId(run.ID)
+	for k, v := range flattenRun(meta.(ConfiguredClient), run) {
+		d.Set(k, v)
+	}
+
+	return nil
+}
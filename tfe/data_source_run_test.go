@@ -0,0 +1,130 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFlattenRun(t *testing.T) {
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	run := &tfe.Run{
+		ID:        "run-123",
+		Status:    tfe.RunApplied,
+		Source:    tfe.RunSourceAPI,
+		Message:   "Queued manually",
+		CreatedAt: createdAt,
+		Workspace: &tfe.Workspace{
+			ID:           "ws-123",
+			Name:         "my-workspace",
+			Organization: &tfe.Organization{Name: "my-org"},
+		},
+		CreatedBy: &tfe.User{ID: "user-123", Username: "admin"},
+		Plan:      &tfe.Plan{ID: "plan-123"},
+		Apply:     &tfe.Apply{ID: "apply-123"},
+	}
+
+	config := ConfiguredClient{Hostname: "app.terraform.io"}
+
+	m := flattenRun(config, run)
+	expected := map[string]interface{}{
+		"status":       "applied",
+		"source":       "tfe-api",
+		"message":      "Queued manually",
+		"created_at":   "2023-01-02T03:04:05Z",
+		"workspace_id": "ws-123",
+		"created_by":   "admin",
+		"plan_id":      "plan-123",
+		"apply_id":     "apply-123",
+		"html_url":     "https://app.terraform.io/app/my-org/workspaces/my-workspace/runs/run-123",
+	}
+	for k, v := range expected {
+		if m[k] != v {
+			t.Fatalf("expected %s to be %v, got %v", k, v, m[k])
+		}
+	}
+
+	// Runs queued by Terraform Cloud have no user.
+	run.CreatedBy = nil
+	if m := flattenRun(config, run); m["created_by"] != "" {
+		t.Fatalf("expected an empty created_by, got %v", m["created_by"])
+	}
+
+	// The URL is unknown when the workspace is not included.
+	run.Workspace = &tfe.Workspace{ID: "ws-123"}
+	if m := flattenRun(config, run); m["html_url"] != "" {
+		t.Fatalf("expected an empty html_url, got %v", m["html_url"])
+	}
+}
+
+func TestAccTFERunDataSource_basic(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERunDataSourceConfig(org.Name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_run.foobar", "id", "tfe_run.foobar", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_run.foobar", "workspace_id", "tfe_workspace.foobar", "id"),
+					resource.TestCheckResourceAttr("data.tfe_run.foobar", "source", "tfe-api"),
+					resource.TestCheckResourceAttrSet("data.tfe_run.foobar", "plan_id"),
+					resource.TestCheckResourceAttrSet("data.tfe_run.foobar", "created_at"),
+					resource.TestCheckResourceAttr("data.tfe_runs.foobar", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_runs.foobar", "runs.0.id", "tfe_run.foobar", "id"),
+					resource.TestCheckResourceAttr("data.tfe_runs.foobar", "runs.0.status", "applied"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFERunDataSourceConfig(organization string) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = "%s"
+}
+
+resource "tfe_api_driven_run" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  source_path  = "test-fixtures/config-version"
+  apply        = true
+}
+
+resource "tfe_run" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  apply        = true
+
+  triggers = {
+    configuration = tfe_api_driven_run.foobar.configuration_version_id
+  }
+}
+
+data "tfe_run" "foobar" {
+  run_id = tfe_run.foobar.id
+}
+
+data "tfe_runs" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  statuses     = ["applied"]
+  limit        = 1
+
+  depends_on = [tfe_run.foobar]
+}`, organization)
+}
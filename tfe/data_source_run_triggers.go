@@ -0,0 +1,105 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTFERunTriggers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFERunTriggersRead),
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"type": {
+				Description: "Whether to list the inbound or outbound run triggers of the workspace",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     string(tfe.RunTriggerInbound),
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.RunTriggerInbound),
+						string(tfe.RunTriggerOutbound),
+					},
+					false,
+				),
+			},
+
+			"run_triggers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"workspace_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"workspace_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"sourceable_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"sourceable_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTFERunTriggersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+	filter := d.Get("type").(string)
+
+	log.Printf("[DEBUG] List %s run triggers of workspace: %s", filter, workspaceID)
+	runTriggers, err := listRunTriggers(ctx, tfeClient, workspaceID, tfe.RunTriggerFilterOp(filter))
+	if err != nil {
+		return fmt.Errorf("Error retrieving %s run triggers of workspace %s: %w", filter, workspaceID, err)
+	}
+
+	result := make([]interface{}, 0, len(runTriggers))
+	for _, runTrigger := range runTriggers {
+		rt := map[string]interface{}{
+			"id":              runTrigger.ID,
+			"workspace_name":  runTrigger.WorkspaceName,
+			"sourceable_name": runTrigger.SourceableName,
+		}
+		if runTrigger.Workspace != nil {
+			rt["workspace_id"] = runTrigger.Workspace.ID
+		}
+		if runTrigger.Sourceable != nil {
+			rt["sourceable_id"] = runTrigger.Sourceable.ID
+		}
+		result = append(result, rt)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspaceID, filter))
+	d.Set("run_triggers", result)
+
+	return nil
+}
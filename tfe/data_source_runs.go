@@ -0,0 +1,135 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTFERuns() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFERunsRead),
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"statuses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"sources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"runs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: func() map[string]*schema.Schema {
+						s := runDataSourceSchema()
+						s["id"] = &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						}
+						return s
+					}(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTFERunsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// Get the workspace ID and the maximum number of runs.
+	workspaceID := d.Get("workspace_id").(string)
+	limit := d.Get("limit").(int)
+
+	options := &tfe.RunListOptions{
+		Status:  joinStringList(d.Get("statuses").([]interface{})),
+		Source:  joinStringList(d.Get("sources").([]interface{})),
+		Include: runDataSourceIncludes,
+	}
+
+	// Avoid fetching more runs than needed, runs are listed from newest to
+	// oldest so the first pages hold the most recent runs.
+	options.PageSize = limit
+	if options.PageSize > 100 {
+		options.PageSize = 100
+	}
+
+	var ids []interface{}
+	var runs []interface{}
+
+	log.Printf("[DEBUG] List runs of workspace: %s", workspaceID)
+	for len(runs) < limit {
+		l, err := tfeClient.Runs.List(ctx, workspaceID, options)
+		if err != nil {
+			if err == tfe.ErrResourceNotFound {
+				return fmt.Errorf("could not find workspace %s", workspaceID)
+			}
+			return fmt.Errorf("Error retrieving runs of workspace %s: %w", workspaceID, err)
+		}
+
+		for _, run := range l.Items {
+			if len(runs) >= limit {
+				break
+			}
+
+			r := flattenRun(meta.(ConfiguredClient), run)
+			r["id"] = run.ID
+			ids = append(ids, run.ID)
+			runs = append(runs, r)
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	d.SetId(workspaceID)
+	d.Set("ids", ids)
+	d.Set("runs", runs)
+
+	return nil
+}
+
+// joinStringList joins a list of strings with commas, as expected by the
+// filters of the API.
+func joinStringList(l []interface{}) string {
+	values := make([]string, 0, len(l))
+	for _, v := range l {
+		values = append(values, v.(string))
+	}
+	return strings.Join(values, ",")
+}
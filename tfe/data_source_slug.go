@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceTFESlug() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFESlugRead,
+		ReadContext: crudContext(dataSourceTFESlugRead),
 
 		Schema: map[string]*schema.Schema{
 			"source_path": {
@@ -25,7 +26,7 @@ func dataSourceTFESlug() *schema.Resource {
 	}
 }
 
-func dataSourceTFESlugRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFESlugRead(_ context.Context, d *schema.ResourceData, meta interface{}) error {
 	sourcePath := d.Get("source_path").(string)
 
 	log.Printf("[DEBUG] Hashing the source path files: %s", sourcePath)
@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 
 	tfe "github.com/hashicorp/go-tfe"
@@ -9,7 +10,7 @@ import (
 
 func dataSourceTFESSHKey() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFESSHKeyRead,
+		ReadContext: crudContext(dataSourceTFESSHKeyRead),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -19,18 +20,21 @@ func dataSourceTFESSHKey() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 		},
 	}
 }
 
-func dataSourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceTFESSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	// Create an options struct.
 	options := &tfe.SSHKeyListOptions{}
@@ -0,0 +1,76 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFESSHKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFESSHKeysRead),
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceTFESSHKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	ids := make(map[string]string)
+
+	options := &tfe.SSHKeyListOptions{}
+
+	log.Printf("[DEBUG] List SSH keys of organization: %s", organization)
+	for {
+		l, err := tfeClient.SSHKeys.List(ctx, organization, options)
+		if err != nil {
+			return fmt.Errorf("Error retrieving SSH keys of organization %s: %w", organization, err)
+		}
+
+		for _, k := range l.Items {
+			names = append(names, k.Name)
+			ids[k.Name] = k.ID
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	d.SetId(organization)
+	d.Set("names", names)
+	d.Set("ids", ids)
+
+	return nil
+}
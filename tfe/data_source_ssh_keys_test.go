@@ -0,0 +1,94 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFESSHKeysDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFESSHKeysDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.tfe_ssh_keys.all", "names.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_ssh_keys.all", "names.0", "ssh-key-test"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_ssh_keys.all", "ids.ssh-key-test", "tfe_ssh_key.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceTFESSHKeysRead(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/hashicorp/ssh-keys":
+			if r.URL.Query().Get("page[number]") == "2" {
+				fmt.Fprint(w, `{"data":[
+					{"id":"sshkey-2","type":"ssh-keys","attributes":{"name":"modules"}}
+				],"meta":{"pagination":{"current-page":2,"total-pages":2}}}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":[
+				{"id":"sshkey-1","type":"ssh-keys","attributes":{"name":"deploy"}}
+			],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	d := dataSourceTFESSHKeys().TestResourceData()
+	d.Set("organization", "hashicorp")
+
+	if err := dataSourceTFESSHKeysRead(ctx, d, ConfiguredClient{Client: client}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"names.0":     "deploy",
+		"names.1":     "modules",
+		"ids.deploy":  "sshkey-1",
+		"ids.modules": "sshkey-2",
+	}
+	for key, want := range expected {
+		if got := d.Get(key).(string); got != want {
+			t.Errorf("expected %s to be %q, got %q", key, want, got)
+		}
+	}
+}
+
+func testAccTFESSHKeysDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_ssh_key" "foobar" {
+  name           = "ssh-key-test"
+  organization   = tfe_organization.foobar.id
+  key_wo         = "SSH-KEY-CONTENT"
+  key_wo_version = 1
+}
+
+data "tfe_ssh_keys" "all" {
+  organization = tfe_organization.foobar.name
+  depends_on   = [tfe_ssh_key.foobar]
+}`, rInt)
+}
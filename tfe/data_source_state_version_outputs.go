@@ -0,0 +1,166 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFEStateVersionOutputs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFEStateVersionOutputsRead),
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"workspace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"state_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"values": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+
+			"nonsensitive_values": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceTFEStateVersionOutputsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// Get the organization and workspace name.
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
+	name := d.Get("workspace").(string)
+
+	log.Printf("[DEBUG] Read configuration of workspace: %s/%s", organization, name)
+	ws, err := tfeClient.Workspaces.Read(ctx, organization, name)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return fmt.Errorf("could not find workspace %s/%s", organization, name)
+		}
+		return fmt.Errorf("Error retrieving workspace %s/%s: %w", organization, name, err)
+	}
+
+	log.Printf("[DEBUG] Read current state version of workspace: %s", ws.ID)
+	sv, err := tfeClient.StateVersions.ReadCurrent(ctx, ws.ID)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return fmt.Errorf("workspace %s/%s has no state", organization, name)
+		}
+		return fmt.Errorf("Error retrieving current state version of workspace %s/%s: %w", organization, name, err)
+	}
+
+	outputs, err := listStateVersionOutputs(ctx, tfeClient, sv.ID)
+	if err != nil {
+		return err
+	}
+
+	// Only keep the requested outputs, if any.
+	if v, ok := d.GetOk("names"); ok {
+		selected := make(map[string]*tfe.StateVersionOutput)
+		var missing []string
+		for _, n := range v.(*schema.Set).List() {
+			output, ok := outputs[n.(string)]
+			if !ok {
+				missing = append(missing, n.(string))
+				continue
+			}
+			selected[n.(string)] = output
+		}
+
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			return fmt.Errorf("workspace %s/%s has no outputs named %s", organization, name, strings.Join(missing, ", "))
+		}
+		outputs = selected
+	}
+
+	values := make(map[string]interface{})
+	nonsensitiveValues := make(map[string]interface{})
+	for n, output := range outputs {
+		// The values of sensitive outputs are only returned when the outputs
+		// are read one by one.
+		if output.Sensitive {
+			log.Printf("[DEBUG] Read sensitive state version output: %s", output.ID)
+			output, err = tfeClient.StateVersionOutputs.Read(ctx, output.ID)
+			if err != nil {
+				return fmt.Errorf("Error retrieving sensitive output %s of workspace %s/%s: %w", n, organization, name, err)
+			}
+		}
+
+		value, err := flattenOutputValue(output)
+		if err != nil {
+			return err
+		}
+
+		values[n] = value
+		if !output.Sensitive {
+			nonsensitiveValues[n] = value
+		}
+	}
+
+	d.SetId(sv.ID)
+	d.Set("state_version_id", sv.ID)
+	d.Set("values", values)
+	d.Set("nonsensitive_values", nonsensitiveValues)
+
+	return nil
+}
+
+// listStateVersionOutputs returns the outputs of a state version by name.
+func listStateVersionOutputs(ctx context.Context, client *tfe.Client, stateVersionID string) (map[string]*tfe.StateVersionOutput, error) {
+	outputs := make(map[string]*tfe.StateVersionOutput)
+
+	options := &tfe.StateVersionOutputsListOptions{}
+	for {
+		l, err := client.StateVersions.ListOutputs(ctx, stateVersionID, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving outputs of state version %s: %w", stateVersionID, err)
+		}
+
+		for _, output := range l.Items {
+			outputs[output.Name] = output
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return outputs, nil
+}
@@ -0,0 +1,65 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEStateVersionOutputsDataSource_basic(t *testing.T) {
+	skipIfUnitTest(t)
+
+	client, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatalf("error getting client %v", err)
+	}
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	fileName := "test-fixtures/state-versions/terraform.tfstate"
+	orgName, wsName, orgCleanup := createStateVersion(t, client, rInt, fileName)
+	t.Cleanup(orgCleanup)
+
+	waitForOutputs(t, client, orgName, wsName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEStateVersionOutputsDataSourceConfig(orgName, wsName, `["test_output_string", "test_output_object"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_state_version_outputs.foobar", "state_version_id"),
+					// These outputs rely on the values in test-fixtures/state-versions/terraform.tfstate
+					resource.TestCheckResourceAttr(
+						"data.tfe_state_version_outputs.foobar", "values.%", "2"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_state_version_outputs.foobar", "values.test_output_string", "9023256633839603543"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_state_version_outputs.foobar", "values.test_output_object", `{"foo":"bar"}`),
+					resource.TestCheckResourceAttr(
+						"data.tfe_state_version_outputs.foobar", "nonsensitive_values.%", "1"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_state_version_outputs.foobar", "nonsensitive_values.test_output_object", `{"foo":"bar"}`),
+				),
+			},
+			{
+				Config:      testAccTFEStateVersionOutputsDataSourceConfig(orgName, wsName, `["missing"]`),
+				ExpectError: regexp.MustCompile(`has no outputs named missing`),
+			},
+		},
+	})
+}
+
+func testAccTFEStateVersionOutputsDataSourceConfig(organization, workspace, names string) string {
+	return fmt.Sprintf(`
+data "tfe_state_version_outputs" "foobar" {
+  organization = "%s"
+  workspace    = "%s"
+  names        = %s
+}`, organization, workspace, names)
+}
@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 
 	tfe "github.com/hashicorp/go-tfe"
@@ -9,7 +10,7 @@ import (
 
 func dataSourceTFETeam() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFETeamRead,
+		ReadContext: crudContext(dataSourceTFETeamRead),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -19,7 +20,7 @@ func dataSourceTFETeam() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"sso_team_id": {
 				Type:     schema.TypeString,
@@ -29,12 +30,15 @@ func dataSourceTFETeam() *schema.Resource {
 	}
 }
 
-func dataSourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceTFETeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	tl, err := tfeClient.Teams.List(ctx, organization, &tfe.TeamListOptions{
 		Names: []string{name},
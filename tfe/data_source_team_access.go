@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 
 	tfe "github.com/hashicorp/go-tfe"
@@ -9,7 +10,7 @@ import (
 
 func dataSourceTFETeamAccess() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFETeamAccessRead,
+		ReadContext: crudContext(dataSourceTFETeamAccessRead),
 
 		Schema: map[string]*schema.Schema{
 			"access": {
@@ -51,6 +52,11 @@ func dataSourceTFETeamAccess() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+
+						"policy_overrides": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -68,8 +74,8 @@ func dataSourceTFETeamAccess() *schema.Resource {
 	}
 }
 
-func dataSourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceTFETeamAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the team ID.
 	teamID := d.Get("team_id").(string)
@@ -96,7 +102,7 @@ func dataSourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error
 		for _, ta := range l.Items {
 			if ta.Team.ID == teamID {
 				d.SetId(ta.ID)
-				return resourceTFETeamAccessRead(d, meta)
+				return resourceTFETeamAccessRead(ctx, d, meta)
 			}
 		}
 
@@ -0,0 +1,119 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFETeams() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFETeamsRead),
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"teams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"sso_team_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"visibility": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// listTeams returns all teams of an organization.
+func listTeams(ctx context.Context, client *tfe.Client, organization string) ([]*tfe.Team, error) {
+	teams, err := listAllPages(func(pageNumber int) ([]*tfe.Team, *tfe.Pagination, error) {
+		options := &tfe.TeamListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: listPageSize},
+		}
+
+		tl, err := client.Teams.List(ctx, organization, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		return tl.Items, tl.Pagination, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving teams of organization %s: %w", organization, err)
+	}
+
+	return teams, nil
+}
+
+func dataSourceTFETeamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] List teams of organization: %s", organization)
+	teams, err := listTeams(ctx, tfeClient, organization)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(teams))
+	ids := make(map[string]string, len(teams))
+	result := make([]interface{}, 0, len(teams))
+	for _, team := range teams {
+		names = append(names, team.Name)
+		ids[team.Name] = team.ID
+		result = append(result, map[string]interface{}{
+			"id":          team.ID,
+			"name":        team.Name,
+			"sso_team_id": team.SSOTeamID,
+			"visibility":  team.Visibility,
+		})
+	}
+
+	d.SetId(organization)
+	d.Set("names", names)
+	d.Set("ids", ids)
+	d.Set("teams", result)
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFETeamsDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamsDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The owners team is created with the organization.
+					resource.TestCheckResourceAttr(
+						"data.tfe_teams.all", "teams.#", "2"),
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_teams.all", "ids.owners"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_teams.all", "ids.team-test", "tfe_team.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceTFETeamsRead(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/hashicorp/teams":
+			if r.URL.Query().Get("page[number]") == "2" {
+				fmt.Fprint(w, `{"data":[
+					{"id":"team-2","type":"teams","attributes":{"name":"platform","visibility":"organization","sso-team-id":"sso-2"}}
+				],"meta":{"pagination":{"current-page":2,"total-pages":2}}}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":[
+				{"id":"team-1","type":"teams","attributes":{"name":"owners","visibility":"secret"}}
+			],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	d := dataSourceTFETeams().TestResourceData()
+	d.Set("organization", "hashicorp")
+
+	if err := dataSourceTFETeamsRead(ctx, d, ConfiguredClient{Client: client}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"names.0":             "owners",
+		"names.1":             "platform",
+		"ids.platform":        "team-2",
+		"teams.0.visibility":  "secret",
+		"teams.1.id":          "team-2",
+		"teams.1.sso_team_id": "sso-2",
+	}
+	for key, want := range expected {
+		if got := d.Get(key).(string); got != want {
+			t.Errorf("expected %s to be %q, got %q", key, want, got)
+		}
+	}
+}
+
+func testAccTFETeamsDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = tfe_organization.foobar.id
+}
+
+data "tfe_teams" "all" {
+  organization = tfe_organization.foobar.name
+  depends_on   = [tfe_team.foobar]
+}`, rInt)
+}
@@ -0,0 +1,110 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFETerraformVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFETerraformVersionsRead),
+
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Description: "A partial version number to filter the Terraform versions by",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"latest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"beta": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"official": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"deprecated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTFETerraformVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	search := d.Get("search").(string)
+
+	log.Printf("[DEBUG] List Terraform versions matching: %s", search)
+	versions, err := listTerraformVersions(ctx, tfeClient, search)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Terraform versions: %w", err)
+	}
+
+	ids := make(map[string]string, len(versions))
+	result := make([]interface{}, 0, len(versions))
+	for _, v := range versions {
+		ids[v.Version] = v.ID
+		result = append(result, map[string]interface{}{
+			"id":         v.ID,
+			"version":    v.Version,
+			"enabled":    v.Enabled,
+			"beta":       v.Beta,
+			"official":   v.Official,
+			"deprecated": v.Deprecated,
+		})
+	}
+
+	latest := ""
+	if v := latestTerraformVersion(versions, nil); v != nil {
+		latest = v.Version
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", tfeClient.BaseURL().Host, search))
+	d.Set("ids", ids)
+	d.Set("latest", latest)
+	d.Set("versions", result)
+
+	return nil
+}
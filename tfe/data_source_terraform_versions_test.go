@@ -0,0 +1,104 @@
+package tfe
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFETerraformVersionsDataSource_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	sha := genSha(t, "secret", "data")
+	version := genSafeRandomTerraformVersion()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETerraformVersionsDataSourceConfig(version, sha),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.tfe_terraform_versions.foobar", "versions.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_terraform_versions.foobar", "versions.0.version", version),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_terraform_versions.foobar", fmt.Sprintf("ids.%s", version), "tfe_terraform_version.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceTFETerraformVersionsRead(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/admin/terraform-versions":
+			if r.URL.Query().Get("page[number]") == "2" {
+				fmt.Fprint(w, `{"data":[
+					{"id":"tool-3","type":"terraform-versions","attributes":{"version":"1.8.0-beta1","enabled":true,"beta":true}},
+					{"id":"tool-4","type":"terraform-versions","attributes":{"version":"1.7.6","enabled":false}},
+					{"id":"tool-5","type":"terraform-versions","attributes":{"version":"1.7.5-custom","enabled":true,"deprecated":true}}
+				],"meta":{"pagination":{"current-page":2,"total-pages":2}}}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":[
+				{"id":"tool-1","type":"terraform-versions","attributes":{"version":"1.7.4","enabled":true,"official":true}},
+				{"id":"tool-2","type":"terraform-versions","attributes":{"version":"1.10.0","enabled":true,"official":true}}
+			],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	d := dataSourceTFETerraformVersions().TestResourceData()
+
+	if err := dataSourceTFETerraformVersionsRead(ctx, d, ConfiguredClient{Client: client}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 1.10.0 sorts after 1.7.4 as a semantic version, and the disabled, beta
+	// and deprecated versions are never the latest.
+	expected := map[string]string{
+		"latest":             "1.10.0",
+		"versions.2.version": "1.8.0-beta1",
+	}
+	for key, want := range expected {
+		if got := d.Get(key).(string); got != want {
+			t.Errorf("expected %s to be %q, got %q", key, want, got)
+		}
+	}
+
+	// Version numbers contain dots, so the IDs can not be read by key.
+	ids := d.Get("ids").(map[string]interface{})
+	if got := ids["1.8.0-beta1"]; got != "tool-3" {
+		t.Errorf("expected the ID of 1.8.0-beta1 to be tool-3, got %v", got)
+	}
+
+	if got := len(d.Get("versions").([]interface{})); got != 5 {
+		t.Errorf("expected 5 versions, got %d", got)
+	}
+	if !d.Get("versions.2.beta").(bool) {
+		t.Error("expected versions.2 to be a beta")
+	}
+}
+
+func testAccTFETerraformVersionsDataSourceConfig(version, sha string) string {
+	return fmt.Sprintf(`
+resource "tfe_terraform_version" "foobar" {
+  version = "%s"
+  url     = "https://www.hashicorp.com"
+  sha     = "%s"
+}
+
+data "tfe_terraform_versions" "foobar" {
+  search     = tfe_terraform_version.foobar.version
+  depends_on = [tfe_terraform_version.foobar]
+}`, version, sha)
+}
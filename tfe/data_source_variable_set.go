@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 
 	tfe "github.com/hashicorp/go-tfe"
@@ -9,7 +10,7 @@ import (
 
 func dataSourceTFEVariableSet() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEVariableSetRead,
+		ReadContext: crudContext(dataSourceTFEVariableSetRead),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -19,7 +20,7 @@ func dataSourceTFEVariableSet() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 
 			"description": {
@@ -49,67 +50,67 @@ func dataSourceTFEVariableSet() *schema.Resource {
 	}
 }
 
-func dataSourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceTFEVariableSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
-	// Create an options struct.
-	options := tfe.VariableSetListOptions{}
+	// Variable Set relations, vars and workspaces, are omitted from the querying until
+	// we find the desired variable set.
+	variableSets, err := listAllPages(func(pageNumber int) ([]*tfe.VariableSet, *tfe.Pagination, error) {
+		options := &tfe.VariableSetListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: listPageSize},
+		}
 
-	for {
-		// Variable Set relations, vars and workspaces, are omitted from the querying until
-		// we find the desired variable set.
-		l, err := tfeClient.VariableSets.List(ctx, organization, &options)
+		l, err := tfeClient.VariableSets.List(ctx, organization, options)
 		if err != nil {
-			if err == tfe.ErrResourceNotFound {
-				return fmt.Errorf("could not find variable set%s/%s", organization, name)
-			}
-			return fmt.Errorf("Error retrieving variable set: %w", err)
+			return nil, nil, err
+		}
+		return l.Items, l.Pagination, nil
+	})
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return fmt.Errorf("could not find variable set%s/%s", organization, name)
 		}
+		return fmt.Errorf("Error retrieving variable set: %w", err)
+	}
+
+	for _, vs := range variableSets {
+		if vs.Name == name {
+			d.Set("name", vs.Name)
+			d.Set("description", vs.Description)
+			d.Set("global", vs.Global)
 
-		for _, vs := range l.Items {
-			if vs.Name == name {
-				d.Set("name", vs.Name)
-				d.Set("description", vs.Description)
-				d.Set("global", vs.Global)
-
-				// Only now include vars and workspaces to cut down on request load.
-				readOptions := tfe.VariableSetReadOptions{
-					Include: &[]tfe.VariableSetIncludeOpt{tfe.VariableSetWorkspaces, tfe.VariableSetVars},
-				}
-
-				vs, err = tfeClient.VariableSets.Read(ctx, vs.ID, &readOptions)
-				if err != nil {
-					return fmt.Errorf("Error retrieving variable set relations: %w", err)
-				}
-
-				var workspaces []interface{}
-				for _, workspace := range vs.Workspaces {
-					workspaces = append(workspaces, workspace.ID)
-				}
-				d.Set("workspace_ids", workspaces)
-
-				var variables []interface{}
-				for _, variable := range vs.Variables {
-					variables = append(variables, variable.ID)
-				}
-				d.Set("variable_ids", variables)
-
-				d.SetId(vs.ID)
-				return nil
+			// Only now include vars and workspaces to cut down on request load.
+			readOptions := tfe.VariableSetReadOptions{
+				Include: &[]tfe.VariableSetIncludeOpt{tfe.VariableSetWorkspaces, tfe.VariableSetVars},
 			}
-		}
 
-		// Exit the loop when we've seen all pages.
-		if l.CurrentPage >= l.TotalPages {
-			break
-		}
+			vs, err = tfeClient.VariableSets.Read(ctx, vs.ID, &readOptions)
+			if err != nil {
+				return fmt.Errorf("Error retrieving variable set relations: %w", err)
+			}
+
+			var workspaces []interface{}
+			for _, workspace := range vs.Workspaces {
+				workspaces = append(workspaces, workspace.ID)
+			}
+			d.Set("workspace_ids", workspaces)
+
+			var variables []interface{}
+			for _, variable := range vs.Variables {
+				variables = append(variables, variable.ID)
+			}
+			d.Set("variable_ids", variables)
 
-		// Update the page number to get the next page.
-		options.PageNumber = l.NextPage
+			d.SetId(vs.ID)
+			return nil
+		}
 	}
 
 	return fmt.Errorf("Could not find variable set %s/%s", organization, name)
@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -37,7 +38,7 @@ func dataSourceTFEWorkspaceVariables() *schema.Resource {
 		},
 	}
 	return &schema.Resource{
-		Read: dataSourceVariableRead,
+		ReadContext: crudContext(dataSourceVariableRead),
 
 		Schema: map[string]*schema.Schema{
 			"env": {
@@ -75,14 +76,14 @@ func dataSourceTFEWorkspaceVariables() *schema.Resource {
 	}
 }
 
-func dataSourceVariableRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// Switch to variable set variable logic
 	_, variableSetIdProvided := d.GetOk("variable_set_id")
 	if variableSetIdProvided {
-		return dataSourceVariableSetVariableRead(d, meta)
+		return dataSourceVariableSetVariableRead(ctx, d, meta)
 	}
 
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the name and organization.
 	workspaceID := d.Get("workspace_id").(string)
@@ -135,8 +136,8 @@ func dataSourceVariableRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func dataSourceVariableSetVariableRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceVariableSetVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the id.
 	variableSetId := d.Get("variable_set_id").(string)
@@ -0,0 +1,159 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The default audiences of the workload identity tokens used for dynamic
+// provider credentials. GCP has no default, as its audience is the name of
+// the workload identity pool provider.
+var workloadIdentityDefaultAudiences = map[string]string{
+	"aws":   "aws.workload.identity",
+	"azure": "api://AzureADTokenExchange",
+	"vault": "vault.workload.identity",
+}
+
+func dataSourceTFEWorkloadIdentityClaims() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFEWorkloadIdentityClaimsRead),
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"workspace": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "*",
+			},
+
+			"run_phase": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "*",
+				ValidateFunc: validation.StringInSlice([]string{"plan", "apply", "*"}, false),
+			},
+
+			"target": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "aws",
+				ValidateFunc: validation.StringInSlice([]string{"aws", "azure", "gcp", "vault"}, false),
+			},
+
+			"audience": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// workloadIdentitySubject returns the sub claim of the workload identity
+// tokens of runs in the given organization, project and workspace. Any of the
+// names and the run phase may be a * wildcard.
+func workloadIdentitySubject(organization, project, workspace, runPhase string) string {
+	return fmt.Sprintf("organization:%s:project:%s:workspace:%s:run_phase:%s", organization, project, workspace, runPhase)
+}
+
+// resolveWorkloadIdentityProject returns the name of the project of the
+// workspace, and checks that it matches the configured project if any.
+func resolveWorkloadIdentityProject(ctx context.Context, client *tfe.Client, organization, project, workspace string) (string, error) {
+	if workspace == "*" {
+		if project == "" {
+			return "*", nil
+		}
+		return project, nil
+	}
+
+	log.Printf("[DEBUG] Read workspace %s of organization: %s", workspace, organization)
+	ws, err := client.Workspaces.Read(ctx, organization, workspace)
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			return "", fmt.Errorf("could not find workspace %s/%s", organization, workspace)
+		}
+		return "", fmt.Errorf("Error reading workspace %s/%s: %w", organization, workspace, err)
+	}
+
+	if ws.Project == nil {
+		if project == "" {
+			return "", fmt.Errorf("could not determine the project of workspace %s/%s, set project explicitly", organization, workspace)
+		}
+		return project, nil
+	}
+
+	p, err := client.Projects.Read(ctx, ws.Project.ID)
+	if err != nil {
+		return "", fmt.Errorf("Error reading project %s of workspace %s/%s: %w", ws.Project.ID, organization, workspace, err)
+	}
+
+	if project != "" && project != "*" && project != p.Name {
+		return "", fmt.Errorf("workspace %s/%s belongs to project %s, not %s", organization, workspace, p.Name, project)
+	}
+
+	// A wildcard project is kept, so the claim matches the workspace in any
+	// project.
+	if project == "*" {
+		return project, nil
+	}
+	return p.Name, nil
+}
+
+func dataSourceTFEWorkloadIdentityClaimsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(ConfiguredClient)
+
+	organization, err := config.organizationName(d)
+	if err != nil {
+		return err
+	}
+	workspace := d.Get("workspace").(string)
+	target := d.Get("target").(string)
+
+	project, err := resolveWorkloadIdentityProject(ctx, config.Client, organization, d.Get("project").(string), workspace)
+	if err != nil {
+		return err
+	}
+
+	audience := d.Get("audience").(string)
+	if audience == "" {
+		audience = workloadIdentityDefaultAudiences[target]
+	}
+	if audience == "" {
+		return fmt.Errorf("audience must be set for target %s", target)
+	}
+
+	subject := workloadIdentitySubject(organization, project, workspace, d.Get("run_phase").(string))
+
+	d.SetId(subject)
+	d.Set("project", project)
+	d.Set("audience", audience)
+	d.Set("subject", subject)
+	d.Set("issuer", fmt.Sprintf("https://%s", config.Hostname))
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package tfe
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestDataSourceTFEWorkloadIdentityClaimsRead(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/hashicorp/workspaces/networking":
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"networking"},"relationships":{"project":{"data":{"id":"prj-123","type":"projects"}}}}}`)
+		case "/api/v2/projects/prj-123":
+			fmt.Fprint(w, `{"data":{"id":"prj-123","type":"projects","attributes":{"name":"platform"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+	meta := ConfiguredClient{Client: client, Hostname: "app.terraform.io"}
+
+	cases := map[string]struct {
+		config   map[string]interface{}
+		subject  string
+		audience string
+		err      string
+	}{
+		"organization wide": {
+			config:   map[string]interface{}{"organization": "hashicorp"},
+			subject:  "organization:hashicorp:project:*:workspace:*:run_phase:*",
+			audience: "aws.workload.identity",
+		},
+		"project of workspace": {
+			config: map[string]interface{}{
+				"organization": "hashicorp",
+				"workspace":    "networking",
+				"run_phase":    "apply",
+				"target":       "azure",
+			},
+			subject:  "organization:hashicorp:project:platform:workspace:networking:run_phase:apply",
+			audience: "api://AzureADTokenExchange",
+		},
+		"wildcard project": {
+			config: map[string]interface{}{
+				"organization": "hashicorp",
+				"project":      "*",
+				"workspace":    "networking",
+				"target":       "gcp",
+				"audience":     "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/tfc/providers/tfc",
+			},
+			subject:  "organization:hashicorp:project:*:workspace:networking:run_phase:*",
+			audience: "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/tfc/providers/tfc",
+		},
+		"wrong project": {
+			config: map[string]interface{}{
+				"organization": "hashicorp",
+				"project":      "other",
+				"workspace":    "networking",
+			},
+			err: "belongs to project platform",
+		},
+		"missing workspace": {
+			config: map[string]interface{}{
+				"organization": "hashicorp",
+				"workspace":    "missing",
+			},
+			err: "could not find workspace",
+		},
+		"gcp without audience": {
+			config: map[string]interface{}{
+				"organization": "hashicorp",
+				"target":       "gcp",
+			},
+			err: "audience must be set",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// The schema defaults are not applied to test resource data.
+			d := dataSourceTFEWorkloadIdentityClaims().TestResourceData()
+			d.Set("workspace", "*")
+			d.Set("run_phase", "*")
+			d.Set("target", "aws")
+			for k, v := range tc.config {
+				d.Set(k, v)
+			}
+
+			err := dataSourceTFEWorkloadIdentityClaimsRead(ctx, d, meta)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error containing %q, got: %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := d.Get("subject").(string); got != tc.subject {
+				t.Fatalf("expected subject %q, got %q", tc.subject, got)
+			}
+			if got := d.Get("audience").(string); got != tc.audience {
+				t.Fatalf("expected audience %q, got %q", tc.audience, got)
+			}
+			if got := d.Get("issuer").(string); got != "https://app.terraform.io" {
+				t.Fatalf("expected issuer https://app.terraform.io, got %q", got)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -10,7 +11,7 @@ import (
 
 func dataSourceTFEWorkspace() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEWorkspaceRead,
+		ReadContext: crudContext(dataSourceTFEWorkspaceRead),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -20,7 +21,7 @@ func dataSourceTFEWorkspace() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 
 			"description": {
@@ -38,6 +39,11 @@ func dataSourceTFEWorkspace() *schema.Resource {
 				Computed: true,
 			},
 
+			"auto_apply_run_trigger": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"file_triggers_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -59,6 +65,11 @@ func dataSourceTFEWorkspace() *schema.Resource {
 				Computed: true,
 			},
 
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"operations": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -174,12 +185,15 @@ func dataSourceTFEWorkspace() *schema.Resource {
 	}
 }
 
-func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceTFEWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Read configuration of workspace: %s", name)
 	workspace, err := tfeClient.Workspaces.Read(ctx, organization, name)
@@ -192,9 +206,11 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 	// Update the config.
 	d.Set("allow_destroy_plan", workspace.AllowDestroyPlan)
 	d.Set("auto_apply", workspace.AutoApply)
+	d.Set("auto_apply_run_trigger", workspace.AutoApplyRunTrigger)
 	d.Set("description", workspace.Description)
 	d.Set("assessments_enabled", workspace.AssessmentsEnabled)
 	d.Set("file_triggers_enabled", workspace.FileTriggersEnabled)
+	d.Set("html_url", workspaceHTMLURL(meta.(ConfiguredClient), workspace))
 	d.Set("operations", workspace.Operations)
 	d.Set("policy_check_failures", workspace.PolicyCheckFailures)
 
@@ -221,7 +237,7 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 			return err
 		}
 	} else {
-		legacyGlobalState, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(workspace.ID, tfeClient)
+		legacyGlobalState, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(ctx, workspace.ID, tfeClient)
 
 		if err != nil {
 			return fmt.Errorf(
@@ -0,0 +1,213 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFEWorkspaceAssociations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFEWorkspaceAssociationsRead),
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"variables": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"import_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"notification_configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"import_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"team_accesses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"team_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"access": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"import_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"run_tasks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"task_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"task_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"enforcement_level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"import_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTFEWorkspaceAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+
+	log.Printf("[DEBUG] Read workspace: %s", workspaceID)
+	ws, err := tfeClient.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return fmt.Errorf("could not find workspace %s", workspaceID)
+		}
+		return fmt.Errorf("Error retrieving workspace %s: %w", workspaceID, err)
+	}
+
+	log.Printf("[DEBUG] List objects attached to workspace: %s", workspaceID)
+	associations, err := fetchWorkspaceAssociations(ctx, tfeClient, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	prefix := fmt.Sprintf("%s/%s/", ws.Organization.Name, ws.Name)
+
+	variables := make([]interface{}, 0, len(associations.Variables))
+	for _, v := range associations.Variables {
+		variables = append(variables, map[string]interface{}{
+			"id":        v.ID,
+			"key":       v.Key,
+			"category":  string(v.Category),
+			"import_id": prefix + v.ID,
+		})
+	}
+
+	notificationConfigurations := make([]interface{}, 0, len(associations.NotificationConfigurations))
+	for _, nc := range associations.NotificationConfigurations {
+		notificationConfigurations = append(notificationConfigurations, map[string]interface{}{
+			"id":        nc.ID,
+			"name":      nc.Name,
+			"import_id": nc.ID,
+		})
+	}
+
+	teamAccesses := make([]interface{}, 0, len(associations.TeamAccesses))
+	for _, ta := range associations.TeamAccesses {
+		teamID := ""
+		if ta.Team != nil {
+			teamID = ta.Team.ID
+		}
+		teamAccesses = append(teamAccesses, map[string]interface{}{
+			"id":        ta.ID,
+			"team_id":   teamID,
+			"access":    string(ta.Access),
+			"import_id": prefix + ta.ID,
+		})
+	}
+
+	runTasks := make([]interface{}, 0, len(associations.RunTasks))
+	for _, wrt := range associations.RunTasks {
+		if wrt.RunTask == nil {
+			continue
+		}
+		runTasks = append(runTasks, map[string]interface{}{
+			"id":                wrt.ID,
+			"task_id":           wrt.RunTask.ID,
+			"task_name":         wrt.RunTask.Name,
+			"enforcement_level": string(wrt.EnforcementLevel),
+			"import_id":         prefix + wrt.RunTask.Name,
+		})
+	}
+
+	d.SetId(workspaceID)
+	d.Set("variables", variables)
+	d.Set("notification_configurations", notificationConfigurations)
+	d.Set("team_accesses", teamAccesses)
+	d.Set("run_tasks", runTasks)
+
+	return nil
+}
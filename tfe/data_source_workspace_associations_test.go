@@ -0,0 +1,84 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEWorkspaceAssociationsDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	orgName := fmt.Sprintf("tst-terraform-%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceAssociationsDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_associations.foobar", "id",
+						"tfe_workspace.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_associations.foobar", "variables.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_associations.foobar", "variables.0.key", "region"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_associations.foobar", "variables.0.id",
+						"tfe_variable.foobar", "id"),
+					resource.TestMatchResourceAttr(
+						"data.tfe_workspace_associations.foobar", "variables.0.import_id",
+						regexp.MustCompile(fmt.Sprintf("^%s/workspace-test/var-", orgName))),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_associations.foobar", "notification_configurations.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_associations.foobar", "notification_configurations.0.import_id",
+						"tfe_notification_configuration.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_associations.foobar", "run_tasks.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEWorkspaceAssociationsDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_variable" "foobar" {
+  key          = "region"
+  value        = "us-east-1"
+  category     = "terraform"
+  workspace_id = tfe_workspace.foobar.id
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name             = "notification_basic"
+  destination_type = "generic"
+  url              = "http://example.com"
+  workspace_id     = tfe_workspace.foobar.id
+}
+
+data "tfe_workspace_associations" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+
+  depends_on = [
+    tfe_variable.foobar,
+    tfe_notification_configuration.foobar,
+  ]
+}`, rInt)
+}
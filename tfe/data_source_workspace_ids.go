@@ -1,8 +1,12 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
+	"net/url"
+	"sort"
 	"strings"
+	"sync"
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,14 +14,14 @@ import (
 
 func dataSourceTFEWorkspaceIDs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEWorkspaceIDsRead,
+		ReadContext: crudContext(dataSourceTFEWorkspaceIDsRead),
 
 		Schema: map[string]*schema.Schema{
 			"names": {
 				Type:         schema.TypeList,
 				Elem:         &schema.Schema{Type: schema.TypeString},
 				Optional:     true,
-				AtLeastOneOf: []string{"names", "tag_names"},
+				AtLeastOneOf: []string{"names", "tag_names", "project_id", "tag_filters"},
 			},
 
 			"tag_names": {
@@ -32,9 +36,35 @@ func dataSourceTFEWorkspaceIDs() *schema.Resource {
 				Optional: true,
 			},
 
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tag_filters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"include": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"exclude": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 
 			"ids": {
@@ -82,11 +112,152 @@ func includedByName(names map[string]bool, workspaceName string) bool {
 	return false
 }
 
-func dataSourceTFEWorkspaceIDsRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+// workspaceNameSearch returns the server-side search options for the names,
+// which narrow down the listed workspaces. Only a single name can be searched
+// for, so several names are matched client-side only. The names are always
+// matched client-side as well, for servers which ignore the search.
+func workspaceNameSearch(names map[string]bool, options *tfe.WorkspaceListOptions) {
+	if len(names) != 1 {
+		return
+	}
+
+	for name := range names {
+		switch {
+		case name == "*" || name == "**":
+			// Matches all workspaces.
+		case strings.HasPrefix(name, "*") || strings.HasSuffix(name, "*"):
+			options.WildcardName = name
+		case name != "":
+			options.Search = name
+		}
+	}
+}
+
+// workspaceTagFilters returns the query parameters which only list the
+// workspaces with the given key/value tags. An empty value matches any value
+// of the key.
+func workspaceTagFilters(tags map[string]interface{}) map[string][]string {
+	var keys []string
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	params := make(map[string][]string)
+	for i, key := range keys {
+		params[fmt.Sprintf("filter[tagged][%d][key]", i)] = []string{key}
+		if value := tags[key].(string); value != "" {
+			params[fmt.Sprintf("filter[tagged][%d][value]", i)] = []string{value}
+		}
+	}
+
+	return params
+}
+
+// listWorkspacesPage lists a page of the workspaces of an organization.
+// go-tfe does not support filtering by key/value tags, so the request is
+// built here when tag filters are given.
+func listWorkspacesPage(ctx context.Context, client *tfe.Client, organization string, options *tfe.WorkspaceListOptions, params map[string][]string) (*tfe.WorkspaceList, error) {
+	if len(params) == 0 {
+		return client.Workspaces.List(ctx, organization, options)
+	}
+
+	u := fmt.Sprintf("organizations/%s/workspaces", url.QueryEscape(organization))
+	req, err := client.NewRequestWithAdditionalQueryParams("GET", u, options, params)
+	if err != nil {
+		return nil, err
+	}
+
+	wl := &tfe.WorkspaceList{}
+	if err := req.Do(ctx, wl); err != nil {
+		return nil, err
+	}
+
+	return wl, nil
+}
+
+// listWorkspacesConcurrently lists all workspaces of an organization matching
+// the options, requesting the pages concurrently. The workspaces are returned
+// in the order of the pages.
+func listWorkspacesConcurrently(ctx context.Context, client *tfe.Client, organization string, options *tfe.WorkspaceListOptions, params map[string][]string) ([]*tfe.Workspace, error) {
+	return listAllPages(func(pageNumber int) ([]*tfe.Workspace, *tfe.Pagination, error) {
+		// Each request needs its own options, as they hold the page number.
+		pageOptions := *options
+		pageOptions.PageSize = listPageSize
+		pageOptions.PageNumber = pageNumber
+
+		wl, err := listWorkspacesPage(ctx, client, organization, &pageOptions, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		return wl.Items, wl.Pagination, nil
+	})
+}
+
+// hasExcludedTagBinding reports whether the workspace has one of the excluded
+// key/value tags, including tags inherited from its project. An empty value
+// matches any value of the key.
+func hasExcludedTagBinding(ctx context.Context, client *tfe.Client, workspaceID string, exclude map[string]interface{}) (bool, error) {
+	bindings, err := fetchEffectiveTagBindings(ctx, client, workspaceID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, binding := range bindings.Data {
+		value, ok := exclude[binding.Attributes.Key]
+		if ok && (value.(string) == "" || value.(string) == binding.Attributes.Value) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// withoutExcludedTagBindings returns the workspaces which have none of the
+// excluded key/value tags. Key/value tags cannot be excluded server-side, so
+// the tags of each workspace are read, with at most listPageConcurrency
+// requests at a time. The order of the workspaces is kept, and the error of
+// the first failed workspace is returned, if any.
+func withoutExcludedTagBindings(ctx context.Context, client *tfe.Client, workspaces []*tfe.Workspace, exclude map[string]interface{}) ([]*tfe.Workspace, error) {
+	excluded := make([]bool, len(workspaces))
+	errs := make([]error, len(workspaces))
+	sem := make(chan struct{}, listPageConcurrency)
+	var wg sync.WaitGroup
+
+	for i, w := range workspaces {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, w *tfe.Workspace) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			excluded[i], errs[i] = hasExcludedTagBinding(ctx, client, w.ID, exclude)
+		}(i, w)
+	}
+	wg.Wait()
+
+	var result []*tfe.Workspace
+	for i, w := range workspaces {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("Error retrieving tag bindings of workspace %s: %w", w.ID, errs[i])
+		}
+		if !excluded[i] {
+			result = append(result, w)
+		}
+	}
+
+	return result, nil
+}
+
+func dataSourceTFEWorkspaceIDsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the organization.
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	// Create a map with all the names we are looking for.
 	var id string
@@ -106,6 +277,21 @@ func dataSourceTFEWorkspaceIDsRead(d *schema.ResourceData, meta interface{}) err
 	ids := make(map[string]string, len(names))
 
 	options := &tfe.WorkspaceListOptions{}
+	workspaceNameSearch(names, options)
+
+	if projectID, ok := d.GetOk("project_id"); ok {
+		id += projectID.(string)
+		options.ProjectID = projectID.(string)
+	}
+
+	var includeTags, excludeTagBindings map[string]interface{}
+	if filters := d.Get("tag_filters").([]interface{}); len(filters) > 0 && filters[0] != nil {
+		filter := filters[0].(map[string]interface{})
+		includeTags = filter["include"].(map[string]interface{})
+		excludeTagBindings = filter["exclude"].(map[string]interface{})
+		id += fmt.Sprintf("%v%v", includeTags, excludeTagBindings)
+	}
+	params := workspaceTagFilters(includeTags)
 
 	excludeTagLookupMap := make(map[string]bool)
 	var excludeTagBuf strings.Builder
@@ -137,36 +323,40 @@ func dataSourceTFEWorkspaceIDsRead(d *schema.ResourceData, meta interface{}) err
 		options.Tags = tagSearch
 	}
 
-	hasOnlyTags := len(tagSearchParts) > 0 && len(names) == 0
+	// Without names, all workspaces matching the other filters are included.
+	hasOnlyTags := len(names) == 0
 
-	for {
-		wl, err := tfeClient.Workspaces.List(ctx, organization, options)
-		if err != nil {
-			return fmt.Errorf("Error retrieving workspaces: %w", err)
-		}
+	workspaces, err := listWorkspacesConcurrently(ctx, tfeClient, organization, options, params)
+	if err != nil {
+		return fmt.Errorf("Error retrieving workspaces: %w", err)
+	}
 
-		for _, w := range wl.Items {
-			// fallback for tfe instances that don't yet support exclude-tags
-			hasExcludedTag := false
-			for _, tag := range w.TagNames {
-				if _, ok := excludeTagLookupMap[tag]; ok {
-					hasExcludedTag = true
-					break
-				}
-			}
-			if (hasOnlyTags || includedByName(names, w.Name)) && !hasExcludedTag {
-				fullNames[w.Name] = organization + "/" + w.Name
-				ids[w.Name] = w.ID
+	var matches []*tfe.Workspace
+	for _, w := range workspaces {
+		// fallback for tfe instances that don't yet support exclude-tags
+		hasExcludedTag := false
+		for _, tag := range w.TagNames {
+			if _, ok := excludeTagLookupMap[tag]; ok {
+				hasExcludedTag = true
+				break
 			}
 		}
+		if !(hasOnlyTags || includedByName(names, w.Name)) || hasExcludedTag {
+			continue
+		}
+		matches = append(matches, w)
+	}
 
-		// Exit the loop when we've seen all pages.
-		if wl.CurrentPage >= wl.TotalPages {
-			break
+	if len(excludeTagBindings) > 0 {
+		matches, err = withoutExcludedTagBindings(ctx, tfeClient, matches, excludeTagBindings)
+		if err != nil {
+			return err
 		}
+	}
 
-		// Update the page number to get the next page.
-		options.PageNumber = wl.NextPage
+	for _, w := range matches {
+		fullNames[w.Name] = organization + "/" + w.Name
+		ids[w.Name] = w.ID
 	}
 
 	d.Set("ids", ids)
@@ -3,11 +3,17 @@ package tfe
 import (
 	"fmt"
 	"math/rand"
+	"net/http"
 	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
 )
 
 func TestAccTFEWorkspaceIDsDataSource_basic(t *testing.T) {
@@ -391,7 +397,7 @@ func TestAccTFEWorkspaceIDsDataSource_empty(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccTFEWorkspaceIDsDataSourceConfig_empty(rInt),
-				ExpectError: regexp.MustCompile("one of `names,tag_names` must be specified"),
+				ExpectError: regexp.MustCompile("one of `names,project_id,tag_filters,tag_names` must be specified"),
 			},
 		},
 	})
@@ -476,6 +482,183 @@ func TestAccTFEWorkspaceIDsDataSource_excludeTags(t *testing.T) {
 	})
 }
 
+func TestAccTFEWorkspaceIDsDataSource_projectAndTagFilters(t *testing.T) {
+	skipUnlessBeta(t)
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceIDsDataSourceConfig_projectAndTagFilters(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_ids.project", "ids.%", "2"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_ids.prod", "ids.%", "1"),
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_workspace_ids.prod", fmt.Sprintf("ids.workspace-foo-%d", rInt)),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_ids.not_prod", "ids.%", "1"),
+					resource.TestCheckResourceAttrSet(
+						"data.tfe_workspace_ids.not_prod", fmt.Sprintf("ids.workspace-bar-%d", rInt)),
+				),
+			},
+		},
+	})
+}
+
+func TestWorkspaceNameSearch(t *testing.T) {
+	cases := map[string]struct {
+		names        []string
+		search       string
+		wildcardName string
+	}{
+		"exact name": {
+			names:  []string{"app-prod"},
+			search: "app-prod",
+		},
+		"prefix wildcard": {
+			names:        []string{"app-*"},
+			wildcardName: "app-*",
+		},
+		"all": {
+			names: []string{"*"},
+		},
+		"several names": {
+			names: []string{"app-prod", "app-dev"},
+		},
+	}
+
+	for name, tc := range cases {
+		names := make(map[string]bool)
+		for _, n := range tc.names {
+			names[n] = true
+		}
+
+		options := &tfe.WorkspaceListOptions{}
+		workspaceNameSearch(names, options)
+
+		if options.Search != tc.search || options.WildcardName != tc.wildcardName {
+			t.Fatalf("%s: expected search %q and wildcard name %q, got %q and %q",
+				name, tc.search, tc.wildcardName, options.Search, options.WildcardName)
+		}
+	}
+}
+
+func TestWorkspaceTagFilters(t *testing.T) {
+	params := workspaceTagFilters(map[string]interface{}{
+		"env":   "prod",
+		"owner": "",
+	})
+
+	expected := map[string][]string{
+		"filter[tagged][0][key]":   {"env"},
+		"filter[tagged][0][value]": {"prod"},
+		"filter[tagged][1][key]":   {"owner"},
+	}
+	if fmt.Sprint(params) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v, got %v", expected, params)
+	}
+}
+
+func TestListWorkspacesConcurrently(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/organizations/hashicorp/workspaces" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		query := r.URL.Query()
+		if query.Get("page[size]") != "100" || query.Get("search[tags]") != "prod" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		page, _ := strconv.Atoi(query.Get("page[number]"))
+		fmt.Fprintf(w, `{"data":[{"id":"ws-%d","type":"workspaces","attributes":{"name":"workspace-%d"}}],
+			"meta":{"pagination":{"current-page":%d,"total-pages":5}}}`, page, page, page)
+	}))
+
+	client := server.Client
+
+	workspaces, err := listWorkspacesConcurrently(ctx, client, "hashicorp", &tfe.WorkspaceListOptions{Tags: "prod"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var ids []string
+	for _, w := range workspaces {
+		ids = append(ids, w.ID)
+	}
+
+	// The workspaces are returned in the order of the pages.
+	expected := []string{"ws-1", "ws-2", "ws-3", "ws-4", "ws-5"}
+	if fmt.Sprint(ids) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v, got %v", expected, ids)
+	}
+}
+
+func TestWithoutExcludedTagBindings(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		var id int
+		if _, err := fmt.Sscanf(r.URL.Path, "/api/v2/workspaces/ws-%d/effective-tag-bindings", &id); err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// Every third workspace is in production.
+		env := "dev"
+		if id%3 == 0 {
+			env = "prod"
+		}
+		fmt.Fprintf(w, `{"data":[{"id":"tb-%d","type":"effective-tag-bindings","attributes":{"key":"env","value":%q}}]}`, id, env)
+	}))
+
+	var workspaces []*tfe.Workspace
+	for i := 1; i <= 12; i++ {
+		workspaces = append(workspaces, &tfe.Workspace{ID: fmt.Sprintf("ws-%d", i)})
+	}
+
+	result, err := withoutExcludedTagBindings(ctx, server.Client, workspaces, map[string]interface{}{"env": "prod"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var ids []string
+	for _, w := range result {
+		ids = append(ids, w.ID)
+	}
+	expected := []string{"ws-1", "ws-2", "ws-4", "ws-5", "ws-7", "ws-8", "ws-10", "ws-11"}
+	if fmt.Sprint(ids) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v, got %v", expected, ids)
+	}
+
+	if max := atomic.LoadInt32(&maxInFlight); max < 2 || max > listPageConcurrency {
+		t.Fatalf("expected between 2 and %d concurrent requests, got %d", listPageConcurrency, max)
+	}
+
+	// A failed workspace fails the whole read.
+	workspaces = append(workspaces, &tfe.Workspace{ID: "ws-missing"})
+	if _, err := withoutExcludedTagBindings(ctx, server.Client, workspaces, map[string]interface{}{"env": "prod"}); err == nil || !strings.Contains(err.Error(), "ws-missing") {
+		t.Fatalf("expected an error for ws-missing, got %v", err)
+	}
+}
+
 func TestAccTFEWorkspaceIDsDataSource_sameTagInTagNamesAndExcludeTags(t *testing.T) {
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
 	orgName := fmt.Sprintf("tst-terraform-%d", rInt)
@@ -741,3 +924,62 @@ data "tfe_workspace_ids" "good" {
   ]
 }`, rInt, rInt, rInt, rInt)
 }
+
+func testAccTFEWorkspaceIDsDataSourceConfig_projectAndTagFilters(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "foobar" {
+  name         = "projecttest"
+  organization = tfe_organization.foobar.name
+}
+
+resource "tfe_workspace" "foo" {
+  name         = "workspace-foo-%d"
+  organization = tfe_organization.foobar.name
+  project_id   = tfe_project.foobar.id
+  tags         = { env = "prod" }
+}
+
+resource "tfe_workspace" "bar" {
+  name         = "workspace-bar-%d"
+  organization = tfe_organization.foobar.name
+  project_id   = tfe_project.foobar.id
+  tags         = { env = "dev" }
+}
+
+resource "tfe_workspace" "dummy" {
+  name         = "workspace-dummy-%d"
+  organization = tfe_organization.foobar.name
+}
+
+data "tfe_workspace_ids" "project" {
+  organization = tfe_organization.foobar.name
+  project_id   = tfe_project.foobar.id
+  depends_on   = [tfe_workspace.foo, tfe_workspace.bar, tfe_workspace.dummy]
+}
+
+data "tfe_workspace_ids" "prod" {
+  organization = tfe_organization.foobar.name
+
+  tag_filters {
+    include = { env = "prod" }
+  }
+
+  depends_on = [tfe_workspace.foo, tfe_workspace.bar, tfe_workspace.dummy]
+}
+
+data "tfe_workspace_ids" "not_prod" {
+  organization = tfe_organization.foobar.name
+  project_id   = tfe_project.foobar.id
+
+  tag_filters {
+    exclude = { env = "prod" }
+  }
+
+  depends_on = [tfe_workspace.foo, tfe_workspace.bar, tfe_workspace.dummy]
+}`, rInt, rInt, rInt, rInt)
+}
@@ -0,0 +1,100 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFEWorkspaceRemoteStateConsumers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFEWorkspaceRemoteStateConsumersRead),
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"global_remote_state": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"consumer_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"consumers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTFEWorkspaceRemoteStateConsumersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// Get the workspace ID.
+	workspaceID := d.Get("workspace_id").(string)
+
+	log.Printf("[DEBUG] Read workspace: %s", workspaceID)
+	workspace, err := tfeClient.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving workspace %s: %w", workspaceID, err)
+	}
+
+	var consumerIDs []interface{}
+	var consumers []interface{}
+
+	options := &tfe.RemoteStateConsumersListOptions{ListOptions: tfe.ListOptions{PageSize: 100}}
+	for {
+		log.Printf("[DEBUG] Read remote state consumers of workspace: %s", workspaceID)
+		wl, err := tfeClient.Workspaces.ListRemoteStateConsumers(ctx, workspaceID, options)
+		if err != nil {
+			return fmt.Errorf("Error retrieving remote state consumers of workspace %s: %w", workspaceID, err)
+		}
+
+		for _, w := range wl.Items {
+			consumerIDs = append(consumerIDs, w.ID)
+			consumers = append(consumers, map[string]interface{}{
+				"id":   w.ID,
+				"name": w.Name,
+			})
+		}
+
+		// Exit the loop when we've seen all pages.
+		if wl.CurrentPage >= wl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = wl.NextPage
+	}
+
+	d.SetId(workspaceID)
+	d.Set("global_remote_state", workspace.GlobalRemoteState)
+	d.Set("consumer_ids", consumerIDs)
+	d.Set("consumers", consumers)
+
+	return nil
+}
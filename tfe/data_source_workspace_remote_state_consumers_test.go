@@ -0,0 +1,116 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFEWorkspaceRemoteStateConsumersDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceRemoteStateConsumersDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_remote_state_consumers.foobar", "id", "tfe_workspace.producer", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_remote_state_consumers.foobar", "global_remote_state", "false"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_remote_state_consumers.foobar", "consumer_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.tfe_workspace_remote_state_consumers.foobar", "consumer_ids.*", "tfe_workspace.consumer", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_remote_state_consumers.foobar", "consumers.0.name", "workspace-consumer"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceTFEWorkspaceRemoteStateConsumersRead(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-producer":
+			fmt.Fprint(w, `{"data":{"id":"ws-producer","type":"workspaces","attributes":{"name":"producer","global-remote-state":false}}}`)
+		case "/api/v2/workspaces/ws-producer/relationships/remote-state-consumers":
+			if r.URL.Query().Get("page[number]") == "2" {
+				fmt.Fprint(w, `{"data":[
+					{"id":"ws-b","type":"workspaces","attributes":{"name":"consumer-b"}}
+				],"meta":{"pagination":{"current-page":2,"total-pages":2}}}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":[
+				{"id":"ws-a","type":"workspaces","attributes":{"name":"consumer-a"}}
+			],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	d := dataSourceTFEWorkspaceRemoteStateConsumers().TestResourceData()
+	d.Set("workspace_id", "ws-producer")
+
+	if err := dataSourceTFEWorkspaceRemoteStateConsumersRead(ctx, d, ConfiguredClient{Client: client}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if d.Id() != "ws-producer" {
+		t.Fatalf("expected the workspace ID as ID, got %q", d.Id())
+	}
+	if d.Get("global_remote_state").(bool) {
+		t.Fatal("expected global_remote_state to be false")
+	}
+	if got := d.Get("consumer_ids.#").(int); got != 2 {
+		t.Fatalf("expected 2 consumer IDs, got %d", got)
+	}
+	if got := d.Get("consumers.1.name").(string); got != "consumer-b" {
+		t.Fatalf("expected the consumers of all pages, got %q as second consumer", got)
+	}
+
+	d = dataSourceTFEWorkspaceRemoteStateConsumers().TestResourceData()
+	d.Set("workspace_id", "ws-missing")
+	if err := dataSourceTFEWorkspaceRemoteStateConsumersRead(ctx, d, ConfiguredClient{Client: client}); err == nil {
+		t.Fatal("expected an error for a missing workspace")
+	}
+}
+
+func testAccTFEWorkspaceRemoteStateConsumersDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "producer" {
+  name                = "workspace-producer"
+  organization        = tfe_organization.foobar.id
+  global_remote_state = false
+}
+
+resource "tfe_workspace" "consumer" {
+  name         = "workspace-consumer"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace_remote_state_consumers" "foobar" {
+  workspace_id = tfe_workspace.producer.id
+  consumer_ids = [tfe_workspace.consumer.id]
+}
+
+data "tfe_workspace_remote_state_consumers" "foobar" {
+  workspace_id = tfe_workspace.producer.id
+  depends_on   = [tfe_workspace_remote_state_consumers.foobar]
+}`, rInt)
+}
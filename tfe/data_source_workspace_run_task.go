@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/go-tfe"
@@ -9,7 +10,7 @@ import (
 
 func dataSourceTFEWorkspaceRunTask() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceTFEWorkspaceRunTaskRead,
+		ReadContext: crudContext(dataSourceTFEWorkspaceRunTaskRead),
 
 		Schema: map[string]*schema.Schema{
 			"workspace_id": {
@@ -39,8 +40,8 @@ func dataSourceTFEWorkspaceRunTask() *schema.Resource {
 	}
 }
 
-func dataSourceTFEWorkspaceRunTaskRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func dataSourceTFEWorkspaceRunTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	workspaceID := d.Get("workspace_id").(string)
 	taskID := d.Get("task_id").(string)
@@ -0,0 +1,128 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"path"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTFEWorkspaceTags() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crudContext(dataSourceTFEWorkspaceTagsRead),
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"inherited": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"inherited_from": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"values": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// effectiveTagBindings mirrors the effective tag bindings of a workspace,
+// which are not exposed by go-tfe. The links of a binding point to the
+// project it is inherited from, if any.
+type effectiveTagBindings struct {
+	Data []struct {
+		ID         string `json:"id"`
+		Attributes struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"attributes"`
+		Links struct {
+			InheritedFrom string `json:"inherited-from"`
+		} `json:"links"`
+	} `json:"data"`
+}
+
+func fetchEffectiveTagBindings(ctx context.Context, client *tfe.Client, workspaceID string) (*effectiveTagBindings, error) {
+	u := fmt.Sprintf("workspaces/%s/effective-tag-bindings", url.QueryEscape(workspaceID))
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := &effectiveTagBindings{}
+	if err := req.DoJSON(ctx, bindings); err != nil {
+		return nil, err
+	}
+
+	return bindings, nil
+}
+
+func dataSourceTFEWorkspaceTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// Get the workspace ID.
+	workspaceID := d.Get("workspace_id").(string)
+
+	log.Printf("[DEBUG] Read tag bindings of workspace: %s", workspaceID)
+	bindings, err := fetchEffectiveTagBindings(ctx, tfeClient, workspaceID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving tag bindings of workspace %s: %w", workspaceID, err)
+	}
+
+	var tags []interface{}
+	values := make(map[string]interface{})
+	for _, binding := range bindings.Data {
+		// The inherited-from link is a path to the project, like
+		// /api/v2/projects/prj-123, so keep the ID only.
+		inheritedFrom := ""
+		if binding.Links.InheritedFrom != "" {
+			inheritedFrom = path.Base(binding.Links.InheritedFrom)
+		}
+
+		tags = append(tags, map[string]interface{}{
+			"key":            binding.Attributes.Key,
+			"value":          binding.Attributes.Value,
+			"inherited":      inheritedFrom != "",
+			"inherited_from": inheritedFrom,
+		})
+		values[binding.Attributes.Key] = binding.Attributes.Value
+	}
+
+	d.SetId(workspaceID)
+	d.Set("tags", tags)
+	d.Set("values", values)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFEWorkspaceTagsDataSource_basic(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceTagsDataSourceConfig(org.Name, rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_tags.foobar", "id", "tfe_workspace.foobar", "id"),
+					// A new workspace has no key/value tags.
+					resource.TestCheckResourceAttr("data.tfe_workspace_tags.foobar", "tags.#", "0"),
+					resource.TestCheckResourceAttr("data.tfe_workspace_tags.foobar", "values.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestFetchEffectiveTagBindings(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-tagged/effective-tag-bindings":
+			fmt.Fprint(w, `{"data":[
+  {"id":"tb-direct","type":"effective-tag-bindings","attributes":{"key":"env","value":"prod"}},
+  {"id":"tb-inherited","type":"effective-tag-bindings","attributes":{"key":"team","value":"platform"},"links":{"inherited-from":"/api/v2/projects/prj-123"}}
+]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	bindings, err := fetchEffectiveTagBindings(ctx, client, "ws-tagged")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(bindings.Data) != 2 {
+		t.Fatalf("expected 2 tag bindings, got %d", len(bindings.Data))
+	}
+	if binding := bindings.Data[0]; binding.Attributes.Key != "env" || binding.Attributes.Value != "prod" || binding.Links.InheritedFrom != "" {
+		t.Fatalf("unexpected direct tag binding: %+v", binding)
+	}
+	if binding := bindings.Data[1]; binding.Links.InheritedFrom != "/api/v2/projects/prj-123" {
+		t.Fatalf("unexpected inherited tag binding: %+v", binding)
+	}
+
+	if _, err := fetchEffectiveTagBindings(ctx, client, "ws-missing"); err == nil {
+		t.Fatal("expected an error for a missing workspace")
+	}
+}
+
+func testAccTFEWorkspaceTagsDataSourceConfig(organization string, rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test-%d"
+  organization = "%s"
+}
+
+data "tfe_workspace_tags" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+}`, rInt, organization)
+}
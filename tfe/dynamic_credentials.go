@@ -0,0 +1,230 @@
+package tfe
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenTypeJWT           = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+// exchangedTokens caches the tokens exchanged for OIDC tokens. All muxed
+// providers are configured with the same configuration, and exchange the
+// OIDC token only once this way.
+var (
+	exchangedTokensMu sync.Mutex
+	exchangedTokens   = map[exchangedTokenKey]string{}
+)
+
+// exchangedTokenKey holds everything the exchange of an OIDC token depends
+// on, including the environment variables the OIDC token is read from.
+type exchangedTokenKey struct {
+	hostname                  string
+	creds                     dynamicCredentials
+	workloadIdentityToken     string
+	githubActionsRequestURL   string
+	githubActionsRequestToken string
+}
+
+var errMissingOIDCToken = errors.New("No OIDC token to exchange could be found. Please set oidc_token or oidc_token_file in the provider configuration, " +
+	"or run the provider with a Terraform Cloud workload identity token (TFC_WORKLOAD_IDENTITY_TOKEN) or in GitHub Actions with the id-token: write permission.")
+
+// dynamicCredentials configures the exchange of an OIDC token, e.g. a
+// workload identity token of Terraform Cloud or an ID token of GitHub
+// Actions, for a Terraform Cloud/Enterprise API token when the provider is
+// configured, so no static API token has to be stored.
+type dynamicCredentials struct {
+	// ExchangeURL is the OAuth 2.0 token exchange (RFC 8693) endpoint which
+	// issues API tokens for OIDC tokens.
+	ExchangeURL string
+
+	// OIDCToken and OIDCTokenFile hold the OIDC token to exchange.
+	OIDCToken     string
+	OIDCTokenFile string
+
+	// Audience is the audience of the ID token requested from GitHub Actions.
+	Audience string
+}
+
+// enabled reports whether dynamic credentials are configured, either in the
+// provider configuration or with the TFE_TOKEN_EXCHANGE_URL environment
+// variable.
+func (c dynamicCredentials) enabled() bool {
+	return c.exchangeURL() != ""
+}
+
+func (c dynamicCredentials) exchangeURL() string {
+	if c.ExchangeURL != "" {
+		return c.ExchangeURL
+	}
+	return os.Getenv("TFE_TOKEN_EXCHANGE_URL")
+}
+
+// oidcToken returns the OIDC token to exchange. In order, it is read from the
+// provider configuration, from the workload identity token Terraform Cloud
+// sets in runs, or it is requested from GitHub Actions.
+func (c dynamicCredentials) oidcToken(client *http.Client) (string, error) {
+	if c.OIDCToken != "" {
+		return c.OIDCToken, nil
+	}
+
+	if c.OIDCTokenFile != "" {
+		content, err := os.ReadFile(c.OIDCTokenFile)
+		if err != nil {
+			return "", fmt.Errorf("Error reading OIDC token file %s: %w", c.OIDCTokenFile, err)
+		}
+		return strings.TrimSpace(string(content)), nil
+	}
+
+	if token := os.Getenv("TFC_WORKLOAD_IDENTITY_TOKEN"); token != "" {
+		log.Printf("[DEBUG] Using the Terraform Cloud workload identity token from TFC_WORKLOAD_IDENTITY_TOKEN")
+		return token, nil
+	}
+
+	if os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL") != "" {
+		log.Printf("[DEBUG] Requesting an ID token from GitHub Actions")
+		return c.githubActionsToken(client)
+	}
+
+	return "", errMissingOIDCToken
+}
+
+// githubActionsToken requests an ID token for the workflow job from GitHub
+// Actions, which is only possible when the job has the id-token: write
+// permission.
+func (c dynamicCredentials) githubActionsToken(client *http.Client) (string, error) {
+	u, err := url.Parse(os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL"))
+	if err != nil {
+		return "", fmt.Errorf("Error parsing ACTIONS_ID_TOKEN_REQUEST_URL: %w", err)
+	}
+	if c.Audience != "" {
+		q := u.Query()
+		q.Set("audience", c.Audience)
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "bearer "+os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN"))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("Error requesting an ID token from GitHub Actions: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Error requesting an ID token from GitHub Actions: %s", resp.Status)
+	}
+
+	var body struct {
+		Value string `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("Error decoding the ID token of GitHub Actions: %w", err)
+	}
+
+	return body.Value, nil
+}
+
+// exchangeToken exchanges the OIDC token for an API token of the given
+// Terraform Cloud/Enterprise hostname, following OAuth 2.0 Token Exchange.
+func (c dynamicCredentials) exchangeToken(hostname string) (string, error) {
+	// The tokens are part of the requests and responses, so these are not
+	// logged by the logging transport.
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	subjectToken, err := c.oidcToken(client)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type":         {tokenExchangeGrantType},
+		"subject_token":      {subjectToken},
+		"subject_token_type": {tokenTypeJWT},
+		"audience":           {hostname},
+	}
+
+	log.Printf("[DEBUG] Exchange OIDC token for a token of %s at %s", hostname, c.exchangeURL())
+	resp, err := client.PostForm(c.exchangeURL(), form)
+	if err != nil {
+		return "", fmt.Errorf("Error exchanging OIDC token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("Error reading token exchange response: %w", err)
+	}
+
+	var body struct {
+		AccessToken      string `json:"access_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.Unmarshal(content, &body); err != nil {
+		return "", fmt.Errorf("Error decoding token exchange response (%s): %w", resp.Status, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if body.Error != "" {
+			return "", fmt.Errorf("Error exchanging OIDC token: %s: %s", body.Error, body.ErrorDescription)
+		}
+		return "", fmt.Errorf("Error exchanging OIDC token: %s", resp.Status)
+	}
+	if body.AccessToken == "" {
+		return "", fmt.Errorf("Error exchanging OIDC token: the response contains no access_token")
+	}
+
+	return body.AccessToken, nil
+}
+
+// resolveDynamicToken returns the configured token, or exchanges an OIDC
+// token for one when dynamic credentials are configured. When neither is the
+// case, getClient falls back to TFE_TOKEN and the CLI configuration.
+func resolveDynamicToken(token, hostname string, creds dynamicCredentials) (string, error) {
+	if token != "" || !creds.enabled() {
+		return token, nil
+	}
+
+	hostname = resolveHostname(hostname)
+	key := exchangedTokenKey{
+		hostname:                  hostname,
+		creds:                     creds,
+		workloadIdentityToken:     os.Getenv("TFC_WORKLOAD_IDENTITY_TOKEN"),
+		githubActionsRequestURL:   os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL"),
+		githubActionsRequestToken: os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN"),
+	}
+	key.creds.ExchangeURL = creds.exchangeURL()
+
+	// The lock is held during the exchange, so providers configured at the
+	// same time wait for the token of the first one.
+	exchangedTokensMu.Lock()
+	defer exchangedTokensMu.Unlock()
+
+	if token, ok := exchangedTokens[key]; ok {
+		log.Printf("[DEBUG] Reuse the token exchanged for %s", hostname)
+		return token, nil
+	}
+
+	token, err := creds.exchangeToken(hostname)
+	if err != nil {
+		return "", err
+	}
+	exchangedTokens[key] = token
+	return token, nil
+}
@@ -0,0 +1,150 @@
+package tfe
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestDynamicCredentials_exchangeToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/github":
+			if r.Header.Get("Authorization") != "bearer request-token" {
+				t.Errorf("expected the request token to be sent, got %q", r.Header.Get("Authorization"))
+			}
+			fmt.Fprintf(w, `{"value":"github-%s"}`, r.URL.Query().Get("audience"))
+		case "/exchange":
+			if err := r.ParseForm(); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if r.PostForm.Get("grant_type") != tokenExchangeGrantType {
+				t.Errorf("expected a token exchange grant, got %q", r.PostForm.Get("grant_type"))
+			}
+			if r.PostForm.Get("audience") != "tfe.example.com" {
+				t.Errorf("expected the hostname as audience, got %q", r.PostForm.Get("audience"))
+			}
+			subject := r.PostForm.Get("subject_token")
+			if subject == "rejected" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error":"invalid_grant","error_description":"untrusted issuer"}`)
+				return
+			}
+			fmt.Fprintf(w, `{"access_token":"tfe-for-%s","token_type":"Bearer"}`, subject)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		creds dynamicCredentials
+		env   map[string]string
+		token string
+		err   string
+	}{
+		"configured token": {
+			creds: dynamicCredentials{OIDCToken: "configured"},
+			token: "tfe-for-configured",
+		},
+		"token file": {
+			creds: dynamicCredentials{OIDCTokenFile: tokenFile},
+			token: "tfe-for-from-file",
+		},
+		"workload identity token": {
+			env:   map[string]string{"TFC_WORKLOAD_IDENTITY_TOKEN": "workload"},
+			token: "tfe-for-workload",
+		},
+		"github actions": {
+			creds: dynamicCredentials{Audience: "tfe"},
+			env: map[string]string{
+				"ACTIONS_ID_TOKEN_REQUEST_URL":   server.URL + "/github?api-version=2.0",
+				"ACTIONS_ID_TOKEN_REQUEST_TOKEN": "request-token",
+			},
+			token: "tfe-for-github-tfe",
+		},
+		"rejected token": {
+			creds: dynamicCredentials{OIDCToken: "rejected"},
+			err:   "invalid_grant: untrusted issuer",
+		},
+		"no token": {
+			err: errMissingOIDCToken.Error(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for _, env := range []string{"TFC_WORKLOAD_IDENTITY_TOKEN", "ACTIONS_ID_TOKEN_REQUEST_URL", "ACTIONS_ID_TOKEN_REQUEST_TOKEN"} {
+				t.Setenv(env, tc.env[env])
+			}
+
+			tc.creds.ExchangeURL = server.URL + "/exchange"
+			token, err := resolveDynamicToken("", "tfe.example.com", tc.creds)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error to contain %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if token != tc.token {
+				t.Fatalf("expected token %q, got %q", tc.token, token)
+			}
+		})
+	}
+
+	// A static token is never exchanged.
+	token, err := resolveDynamicToken("static", "tfe.example.com", dynamicCredentials{ExchangeURL: server.URL + "/exchange"})
+	if err != nil || token != "static" {
+		t.Fatalf("expected the static token, got %q, %v", token, err)
+	}
+}
+
+func TestResolveDynamicToken_exchangedOnce(t *testing.T) {
+	var exchanges int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&exchanges, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token":"exchanged","token_type":"Bearer"}`)
+	}))
+	t.Cleanup(server.Close)
+
+	creds := dynamicCredentials{ExchangeURL: server.URL, OIDCToken: "oidc"}
+
+	// The SDK provider, the plugin provider and the framework provider are
+	// all configured with the same configuration.
+	for i := 0; i < 3; i++ {
+		token, err := resolveDynamicToken("", "tfe.example.com", creds)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if token != "exchanged" {
+			t.Fatalf("expected the exchanged token, got %q", token)
+		}
+	}
+	if n := atomic.LoadInt32(&exchanges); n != 1 {
+		t.Fatalf("expected the OIDC token to be exchanged once, got %d exchanges", n)
+	}
+
+	// Another configuration exchanges its own token.
+	creds.OIDCToken = "other"
+	if _, err := resolveDynamicToken("", "tfe.example.com", creds); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := atomic.LoadInt32(&exchanges); n != 2 {
+		t.Fatalf("expected another exchange for another configuration, got %d exchanges", n)
+	}
+}
@@ -0,0 +1,117 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ephemeralAgentToken struct {
+	config ConfiguredClient
+}
+
+type ephemeralAgentTokenModel struct {
+	AgentPoolID types.String `tfsdk:"agent_pool_id"`
+	Description types.String `tfsdk:"description"`
+	ID          types.String `tfsdk:"id"`
+	Token       types.String `tfsdk:"token"`
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure = &ephemeralAgentToken{}
+	_ ephemeral.EphemeralResourceWithClose     = &ephemeralAgentToken{}
+)
+
+func newEphemeralAgentToken() ephemeral.EphemeralResource {
+	return &ephemeralAgentToken{}
+}
+
+func (r *ephemeralAgentToken) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_token"
+}
+
+func (r *ephemeralAgentToken) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates an agent token which is deleted again when Terraform is done with it.",
+		Attributes: map[string]schema.Attribute{
+			"agent_pool_id": schema.StringAttribute{
+				Description: "ID of the agent pool.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the agent token.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the agent token.",
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The generated token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *ephemeralAgentToken) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if config, ok := ephemeralResourceConfiguredClient(req, resp); ok {
+		r.config = config
+	}
+}
+
+func (r *ephemeralAgentToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, span := startOperationSpan(ctx, "tfe_agent_token", "open")
+	defer func() { endOperationSpan(span, resp.Diagnostics.HasError()) }()
+
+	var data ephemeralAgentTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateAgentTokenTTL(r.config.TokenTTL); err != nil {
+		resp.Diagnostics.AddError("Error creating agent token", err.Error())
+		return
+	}
+
+	agentPoolID := data.AgentPoolID.ValueString()
+	options := tfe.AgentTokenCreateOptions{
+		Description: tfe.String(data.Description.ValueString()),
+	}
+
+	log.Printf("[DEBUG] Create new ephemeral agent token for agent pool ID: %s", agentPoolID)
+	agentToken, err := r.config.Client.AgentTokens.Create(ctx, agentPoolID, options)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating agent token", fmt.Sprintf("Error creating agent token for agent pool ID %s: %v", agentPoolID, err))
+		return
+	}
+
+	data.ID = types.StringValue(agentToken.ID)
+	data.Token = types.StringValue(agentToken.Token)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setEphemeralTokenID(ctx, resp, agentToken.ID)...)
+}
+
+func (r *ephemeralAgentToken) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	ctx, span := startOperationSpan(ctx, "tfe_agent_token", "close")
+	defer func() { endOperationSpan(span, resp.Diagnostics.HasError()) }()
+
+	tokenID, diags := ephemeralTokenID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || tokenID == "" {
+		return
+	}
+
+	log.Printf("[DEBUG] Delete ephemeral agent token: %s", tokenID)
+	err := r.config.Client.AgentTokens.Delete(ctx, tokenID)
+	if err != nil && err != tfe.ErrResourceNotFound {
+		resp.Diagnostics.AddError("Error deleting agent token", fmt.Sprintf("Error deleting agent token %s: %v", tokenID, err))
+	}
+}
@@ -0,0 +1,145 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ephemeralTeamToken struct {
+	config ConfiguredClient
+}
+
+type ephemeralTeamTokenModel struct {
+	TeamID      types.String `tfsdk:"team_id"`
+	Description types.String `tfsdk:"description"`
+	ExpiredAt   types.String `tfsdk:"expired_at"`
+	ID          types.String `tfsdk:"id"`
+	Token       types.String `tfsdk:"token"`
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure = &ephemeralTeamToken{}
+	_ ephemeral.EphemeralResourceWithClose     = &ephemeralTeamToken{}
+)
+
+func newEphemeralTeamToken() ephemeral.EphemeralResource {
+	return &ephemeralTeamToken{}
+}
+
+func (r *ephemeralTeamToken) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_token"
+}
+
+func (r *ephemeralTeamToken) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a team token which is deleted again when Terraform is done with it.",
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Description: "ID of the team.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the token.",
+				Optional:    true,
+			},
+			"expired_at": schema.StringAttribute{
+				Description: "The time when the token expires, in RFC3339 format.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the token.",
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The generated token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *ephemeralTeamToken) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if config, ok := ephemeralResourceConfiguredClient(req, resp); ok {
+		r.config = config
+	}
+}
+
+func (r *ephemeralTeamToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, span := startOperationSpan(ctx, "tfe_team_token", "open")
+	defer func() { endOperationSpan(span, resp.Diagnostics.HasError()) }()
+
+	var data ephemeralTeamTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := data.TeamID.ValueString()
+	options := &teamAuthenticationTokenCreateOptions{}
+	if !data.Description.IsNull() {
+		options.Description = tfe.String(data.Description.ValueString())
+	}
+	if !data.ExpiredAt.IsNull() {
+		expiredAt, err := time.Parse(time.RFC3339, data.ExpiredAt.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid expired_at", fmt.Sprintf("Error parsing expired_at %s: %v", data.ExpiredAt.ValueString(), err))
+			return
+		}
+		options.ExpiredAt = &expiredAt
+	}
+
+	expiredAt, err := tokenExpiration(r.config.TokenTTL, options.ExpiredAt)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid expired_at", err.Error())
+		return
+	}
+	options.ExpiredAt = expiredAt
+
+	if err := validateOwnersTeamToken(ctx, r.config, teamID, options.ExpiredAt != nil); err != nil {
+		resp.Diagnostics.AddError("Error creating team token", err.Error())
+		return
+	}
+
+	// The token is created next to the other tokens of the team, so the
+	// single team token used by other configurations is left untouched.
+	log.Printf("[DEBUG] Create new ephemeral token for team: %s", teamID)
+	token, err := createTeamAuthenticationToken(ctx, r.config.Client, teamID, options)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating team token", fmt.Sprintf("Error creating new token for team %s: %v", teamID, err))
+		return
+	}
+
+	data.ID = types.StringValue(token.ID)
+	data.Token = types.StringValue(token.Token)
+	if options.ExpiredAt != nil {
+		data.ExpiredAt = types.StringValue(options.ExpiredAt.Format(time.RFC3339))
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setEphemeralTokenID(ctx, resp, token.ID)...)
+}
+
+func (r *ephemeralTeamToken) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	ctx, span := startOperationSpan(ctx, "tfe_team_token", "close")
+	defer func() { endOperationSpan(span, resp.Diagnostics.HasError()) }()
+
+	tokenID, diags := ephemeralTokenID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || tokenID == "" {
+		return
+	}
+
+	log.Printf("[DEBUG] Delete ephemeral team token: %s", tokenID)
+	err := deleteTeamAuthenticationToken(ctx, r.config.Client, tokenID)
+	if err != nil && !isErrResourceNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting team token", fmt.Sprintf("Error deleting team token %s: %v", tokenID, err))
+	}
+}
@@ -0,0 +1,217 @@
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// frameworkProvider is the part of the provider built with
+// terraform-plugin-framework. It only implements what the other SDKs do not
+// support, which are the ephemeral resources.
+type frameworkProvider struct{}
+
+// frameworkProviderModel holds the provider configuration. The schema must
+// stay identical to the schema of the other muxed providers.
+type frameworkProviderModel struct {
+	Hostname                types.String `tfsdk:"hostname"`
+	Token                   types.String `tfsdk:"token"`
+	SSLSkipVerify           types.Bool   `tfsdk:"ssl_skip_verify"`
+	ReconcileServerDefaults types.Bool   `tfsdk:"reconcile_server_defaults"`
+	AllowOwnersToken        types.Bool   `tfsdk:"allow_owners_token"`
+	WorkspaceNamePattern    types.String `tfsdk:"workspace_name_pattern"`
+	ProjectNamePattern      types.String `tfsdk:"project_name_pattern"`
+	DefaultOrganization     types.String `tfsdk:"default_organization"`
+	TokenExchangeURL        types.String `tfsdk:"token_exchange_url"`
+	OIDCToken               types.String `tfsdk:"oidc_token"`
+	OIDCTokenFile           types.String `tfsdk:"oidc_token_file"`
+	OIDCAudience            types.String `tfsdk:"oidc_audience"`
+	TokenTTL                types.String `tfsdk:"token_ttl"`
+}
+
+var (
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+)
+
+// FrameworkProvider returns the part of the provider built with
+// terraform-plugin-framework, to be served with
+// providerserver.NewProtocol5.
+func FrameworkProvider() provider.Provider {
+	return &frameworkProvider{}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "tfe"
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["hostname"],
+			},
+			"token": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["token"],
+			},
+			"ssl_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["ssl_skip_verify"],
+			},
+			"reconcile_server_defaults": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["reconcile_server_defaults"],
+			},
+			"allow_owners_token": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["allow_owners_token"],
+			},
+			"workspace_name_pattern": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["workspace_name_pattern"],
+			},
+			"project_name_pattern": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["project_name_pattern"],
+			},
+			"default_organization": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["default_organization"],
+			},
+			"token_exchange_url": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["token_exchange_url"],
+			},
+			"oidc_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: descriptions["oidc_token"],
+			},
+			"oidc_token_file": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["oidc_token_file"],
+			},
+			"oidc_audience": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["oidc_audience"],
+			},
+			"token_ttl": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["token_ttl"],
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sslSkipVerify := defaultSSLSkipVerify
+	if !data.SSLSkipVerify.IsNull() {
+		sslSkipVerify = data.SSLSkipVerify.ValueBool()
+	}
+
+	config := providerConfig{
+		hostname:      data.Hostname.ValueString(),
+		token:         data.Token.ValueString(),
+		sslSkipVerify: sslSkipVerify,
+		credentials: dynamicCredentials{
+			ExchangeURL:   data.TokenExchangeURL.ValueString(),
+			OIDCToken:     data.OIDCToken.ValueString(),
+			OIDCTokenFile: data.OIDCTokenFile.ValueString(),
+			Audience:      data.OIDCAudience.ValueString(),
+		},
+		allowOwnersToken:     data.AllowOwnersToken.ValueBool(),
+		workspaceNamePattern: data.WorkspaceNamePattern.ValueString(),
+		projectNamePattern:   data.ProjectNamePattern.ValueString(),
+		defaultOrganization:  data.DefaultOrganization.ValueString(),
+		tokenTTL:             data.TokenTTL.ValueString(),
+	}
+	if !data.ReconcileServerDefaults.IsNull() {
+		reconcile := data.ReconcileServerDefaults.ValueBool()
+		config.reconcileServerDefaults = &reconcile
+	}
+
+	client, err := configureClient(config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error configuring the provider", err.Error())
+		return
+	}
+
+	resp.EphemeralResourceData = client
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newEphemeralAgentToken,
+		newEphemeralTeamToken,
+	}
+}
+
+// ephemeralResourceConfiguredClient returns the configured provider client
+// for an ephemeral resource. The provider data is nil until the provider is
+// configured.
+func ephemeralResourceConfiguredClient(req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) (ConfiguredClient, bool) {
+	if req.ProviderData == nil {
+		return ConfiguredClient{}, false
+	}
+	config, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected ConfiguredClient, got %T. This is a bug in the provider.", req.ProviderData),
+		)
+	}
+	return config, ok
+}
+
+// ephemeralTokenIDKey is the private data key holding the ID of the token
+// to delete when an ephemeral token is closed.
+const ephemeralTokenIDKey = "token_id"
+
+func setEphemeralTokenID(ctx context.Context, resp *ephemeral.OpenResponse, id string) diag.Diagnostics {
+	value, err := json.Marshal(id)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error storing token ID", err.Error())
+		return diags
+	}
+	return resp.Private.SetKey(ctx, ephemeralTokenIDKey, value)
+}
+
+// ephemeralTokenID returns the ID of the token opened by an ephemeral
+// resource, or an empty string when no token was opened.
+func ephemeralTokenID(ctx context.Context, req ephemeral.CloseRequest) (string, diag.Diagnostics) {
+	value, diags := req.Private.GetKey(ctx, ephemeralTokenIDKey)
+	if diags.HasError() || value == nil {
+		return "", diags
+	}
+
+	var id string
+	if err := json.Unmarshal(value, &id); err != nil {
+		diags.AddError("Error reading token ID", err.Error())
+	}
+	return id, diags
+}
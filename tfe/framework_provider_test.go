@@ -0,0 +1,157 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func testMuxedProviderServer(t *testing.T) tfprotov5.ProviderServer {
+	mux, err := tf5muxserver.NewMuxServer(
+		context.Background(),
+		PluginProviderServer,
+		ProviderServer,
+		providerserver.NewProtocol5(FrameworkProvider()),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return mux.ProviderServer()
+}
+
+func testDynamicValue(t *testing.T, block *tfprotov5.SchemaBlock, values map[string]tftypes.Value) *tfprotov5.DynamicValue {
+	objectType := block.ValueType().(tftypes.Object)
+
+	attributes := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attributes[name] = v
+			continue
+		}
+		attributes[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	value, err := tfprotov5.NewDynamicValue(objectType, tftypes.NewValue(objectType, attributes))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return &value
+}
+
+func TestFrameworkProvider_schema(t *testing.T) {
+	resp, err := testMuxedProviderServer(t).GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	for _, name := range []string{"tfe_agent_token", "tfe_team_token"} {
+		if _, ok := resp.EphemeralResourceSchemas[name]; !ok {
+			t.Errorf("expected ephemeral resource %s", name)
+		}
+	}
+}
+
+func TestEphemeralAgentToken(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/v2/agent-pools/apool-123/authentication-tokens":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data":{"id":"at-123","type":"authentication-tokens","attributes":{"description":"ci","token":"secret"}}}`)
+		case "DELETE /api/v2/authentication-tokens/at-123":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	t.Setenv("TFE_API_URL", server.URL)
+	t.Setenv("TFE_TOKEN", "not-a-token")
+
+	var recorder *testhelper.RequestRecorder
+	TransportHook = func(transport http.RoundTripper) http.RoundTripper {
+		recorder = testhelper.NewRequestRecorder(transport)
+		return recorder
+	}
+	t.Cleanup(func() { TransportHook = nil })
+
+	ctx := context.Background()
+	provider := testMuxedProviderServer(t)
+
+	schemas, err := provider.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	configureResp, err := provider.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		Config: testDynamicValue(t, schemas.Provider.Block, map[string]tftypes.Value{
+			"hostname": tftypes.NewValue(tftypes.String, "tfe.invalid"),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range configureResp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	block := schemas.EphemeralResourceSchemas["tfe_agent_token"].Block
+	openResp, err := provider.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "tfe_agent_token",
+		Config: testDynamicValue(t, block, map[string]tftypes.Value{
+			"agent_pool_id": tftypes.NewValue(tftypes.String, "apool-123"),
+			"description":   tftypes.NewValue(tftypes.String, "ci"),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range openResp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	result, err := openResp.Result.Unmarshal(block.ValueType())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := result.As(&attributes); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var token string
+	if err := attributes["token"].As(&token); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != "secret" {
+		t.Fatalf("expected token secret, got %q", token)
+	}
+
+	closeResp, err := provider.CloseEphemeralResource(ctx, &tfprotov5.CloseEphemeralResourceRequest{
+		TypeName: "tfe_agent_token",
+		Private:  openResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range closeResp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	want := []string{
+		"GET /api/v2/ping",
+		"POST /api/v2/agent-pools/apool-123/authentication-tokens",
+		"DELETE /api/v2/authentication-tokens/at-123",
+	}
+	if got := recorder.Requests(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("wrong requests\ngot: %v\nwant: %v", got, want)
+	}
+}
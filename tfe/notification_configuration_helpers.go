@@ -0,0 +1,316 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Notification triggers which are supported by Terraform Cloud, but are
+// rejected by the validation of go-tfe.
+const (
+	notificationTriggerAutoDestroyReminder   = "workspace:auto_destroy_reminder"
+	notificationTriggerAutoDestroyRunResults = "workspace:auto_destroy_run_results"
+	notificationTriggerChangeRequestCreated  = "change_request:created"
+)
+
+// The verification modes of a notification configuration.
+const (
+	notificationVerifyNone  = "none"
+	notificationVerifyWarn  = "warn"
+	notificationVerifyError = "error"
+)
+
+// goTFENotificationTriggers lists the triggers accepted by the validation of
+// go-tfe.
+var goTFENotificationTriggers = []string{
+	string(tfe.NotificationTriggerCreated),
+	string(tfe.NotificationTriggerPlanning),
+	string(tfe.NotificationTriggerNeedsAttention),
+	string(tfe.NotificationTriggerApplying),
+	string(tfe.NotificationTriggerCompleted),
+	string(tfe.NotificationTriggerErrored),
+	string(tfe.NotificationTriggerAssessmentDrifted),
+	string(tfe.NotificationTriggerAssessmentFailed),
+	string(tfe.NotificationTriggerAssessmentCheckFailed),
+}
+
+// notificationTriggers lists the triggers of notification configurations
+// known by the provider. Other triggers are accepted with a warning, so that
+// triggers added to Terraform Cloud can be used before the provider knows
+// about them.
+var notificationTriggers = append(
+	goTFENotificationTriggers,
+	notificationTriggerAutoDestroyReminder,
+	notificationTriggerAutoDestroyRunResults,
+)
+
+// notificationTriggerRegexp matches the format of notification triggers,
+// e.g. run:created.
+var notificationTriggerRegexp = regexp.MustCompile(`^[a-z_]+:[a-z_]+$`)
+
+// teamNotificationTriggers lists the triggers of team notification
+// configurations known by the provider.
+var teamNotificationTriggers = []string{
+	notificationTriggerChangeRequestCreated,
+}
+
+// validateNotificationTrigger accepts the known notification triggers, and
+// warns about unknown triggers which are well-formed.
+func validateNotificationTrigger(v interface{}, path cty.Path) diag.Diagnostics {
+	return validateKnownNotificationTrigger(v.(string), path, notificationTriggers)
+}
+
+// validateTeamNotificationTrigger accepts the known team notification
+// triggers, and warns about unknown triggers which are well-formed.
+func validateTeamNotificationTrigger(v interface{}, path cty.Path) diag.Diagnostics {
+	return validateKnownNotificationTrigger(v.(string), path, teamNotificationTriggers)
+}
+
+func validateKnownNotificationTrigger(trigger string, path cty.Path, known []string) diag.Diagnostics {
+	for _, t := range known {
+		if trigger == t {
+			return nil
+		}
+	}
+
+	if !notificationTriggerRegexp.MatchString(trigger) {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid notification trigger",
+			Detail:        fmt.Sprintf("%q is not a valid notification trigger, expected one of %s or another trigger in the format <category>:<event>.", trigger, strings.Join(known, ", ")),
+			AttributePath: path,
+		}}
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Warning,
+		Summary:       "Unknown notification trigger",
+		Detail:        fmt.Sprintf("%q is not known by this version of the provider and is sent to Terraform Cloud as is. Applying fails if Terraform Cloud does not support it.", trigger),
+		AttributePath: path,
+	}}
+}
+
+// expandNotificationTriggers returns the triggers which can be sent with
+// go-tfe, and whether other triggers have to be set with
+// updateNotificationConfigurationTriggers.
+func expandNotificationTriggers(triggers []interface{}) ([]tfe.NotificationTriggerType, bool) {
+	goTFETriggers := make(map[string]bool, len(goTFENotificationTriggers))
+	for _, t := range goTFENotificationTriggers {
+		goTFETriggers[t] = true
+	}
+
+	var supported []tfe.NotificationTriggerType
+	unsupported := false
+	for _, trigger := range triggers {
+		if !goTFETriggers[trigger.(string)] {
+			unsupported = true
+			continue
+		}
+		supported = append(supported, tfe.NotificationTriggerType(trigger.(string)))
+	}
+
+	return supported, unsupported
+}
+
+// notificationConfigurationTriggersOptions updates the triggers of a
+// notification configuration without the validation of go-tfe.
+type notificationConfigurationTriggersOptions struct {
+	Type     string   `jsonapi:"primary,notification-configurations"`
+	Triggers []string `jsonapi:"attr,triggers"`
+}
+
+// updateNotificationConfigurationTriggers sets all triggers of a notification
+// configuration, including those which go-tfe does not know about.
+func updateNotificationConfigurationTriggers(ctx context.Context, client *tfe.Client, id string, triggers []interface{}) error {
+	options := &notificationConfigurationTriggersOptions{Triggers: []string{}}
+	for _, trigger := range triggers {
+		options.Triggers = append(options.Triggers, trigger.(string))
+	}
+
+	u := fmt.Sprintf("notification-configurations/%s", url.QueryEscape(id))
+	req, err := client.NewRequest("PATCH", u, options)
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
+
+// notificationConfigurationHealth is the result of verifying a notification
+// configuration.
+type notificationConfigurationHealth struct {
+	Config  *tfe.NotificationConfiguration
+	Healthy bool
+	Code    string
+	Error   string
+}
+
+// listWorkspaceNotificationConfigurations returns all notification
+// configurations of a workspace.
+func listWorkspaceNotificationConfigurations(ctx context.Context, client *tfe.Client, workspaceID string) ([]*tfe.NotificationConfiguration, error) {
+	var configs []*tfe.NotificationConfiguration
+
+	options := &tfe.NotificationConfigurationListOptions{}
+	for {
+		l, err := client.NotificationConfigurations.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving notification configurations of workspace %s: %w", workspaceID, err)
+		}
+
+		configs = append(configs, l.Items...)
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return configs, nil
+}
+
+// verifyNotificationConfigurations sends a test notification to the
+// destination of each notification configuration, verifying at most
+// concurrency configurations at a time. The results are sorted by ID.
+func verifyNotificationConfigurations(ctx context.Context, client *tfe.Client, configs []*tfe.NotificationConfiguration, concurrency int) []*notificationConfigurationHealth {
+	results := make([]*notificationConfigurationHealth, len(configs))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, nc := range configs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, nc *tfe.NotificationConfiguration) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[i] = verifyNotificationConfiguration(ctx, client, nc)
+		}(i, nc)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Config.ID < results[j].Config.ID
+	})
+
+	return results
+}
+
+func verifyNotificationConfiguration(ctx context.Context, client *tfe.Client, nc *tfe.NotificationConfiguration) *notificationConfigurationHealth {
+	health := &notificationConfigurationHealth{Config: nc}
+
+	verified, err := client.NotificationConfigurations.Verify(ctx, nc.ID)
+	if err != nil {
+		health.Error = err.Error()
+		return health
+	}
+
+	last := flattenLastDeliveryResponse(verified.DeliveryResponses)
+	if len(last) == 0 {
+		health.Error = "no delivery response"
+		return health
+	}
+
+	response := last[0].(map[string]interface{})
+	health.Code = response["code"].(string)
+	health.Healthy = response["successful"].(bool)
+	if !health.Healthy {
+		health.Error = fmt.Sprintf("destination responded with status %s", health.Code)
+	}
+
+	return health
+}
+
+// verifyNotificationConfigurationDiagnostics sends a test notification to
+// the destination of the notification configuration when verify is set, and
+// reports a failed delivery as an error or a warning. Email destinations and
+// disabled configurations are not verified.
+func verifyNotificationConfigurationDiagnostics(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	mode := d.Get("verify").(string)
+	if mode == notificationVerifyNone || !d.Get("enabled").(bool) ||
+		d.Get("destination_type").(string) == string(tfe.NotificationDestinationTypeEmail) {
+		return nil
+	}
+
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Verify notification configuration: %s", d.Id())
+	health := verifyNotificationConfiguration(ctx, tfeClient, &tfe.NotificationConfiguration{ID: d.Id()})
+	if health.Healthy {
+		return nil
+	}
+
+	severity := diag.Warning
+	if mode == notificationVerifyError {
+		severity = diag.Error
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: severity,
+			Summary:  "Notification configuration verification failed",
+			Detail: fmt.Sprintf(
+				"Sending a test notification for notification configuration %s failed: %s.",
+				d.Id(), health.Error),
+			AttributePath: cty.GetAttrPath("url"),
+		},
+	}
+}
+
+// flattenNotificationConfiguration returns the attributes of a notification
+// configuration which are exposed by the data sources. The token is write
+// only and never returned by the API.
+func flattenNotificationConfiguration(nc *tfe.NotificationConfiguration) map[string]interface{} {
+	emailAddresses := make([]interface{}, 0, len(nc.EmailAddresses))
+	for _, emailAddress := range nc.EmailAddresses {
+		emailAddresses = append(emailAddresses, emailAddress)
+	}
+
+	emailUserIDs := make([]interface{}, 0, len(nc.EmailUsers))
+	for _, emailUser := range nc.EmailUsers {
+		emailUserIDs = append(emailUserIDs, emailUser.ID)
+	}
+
+	triggers := make([]interface{}, 0, len(nc.Triggers))
+	for _, trigger := range nc.Triggers {
+		triggers = append(triggers, trigger)
+	}
+
+	return map[string]interface{}{
+		"id":               nc.ID,
+		"name":             nc.Name,
+		"destination_type": string(nc.DestinationType),
+		"enabled":          nc.Enabled,
+		"url":              nc.URL,
+		"triggers":         triggers,
+		"email_addresses":  emailAddresses,
+		"email_user_ids":   emailUserIDs,
+	}
+}
+
+// findNotificationConfigurationsByName returns the notification
+// configurations with the given name. Names are not unique within a
+// workspace, so more than one configuration can be returned.
+func findNotificationConfigurationsByName(configs []*tfe.NotificationConfiguration, name string) []*tfe.NotificationConfiguration {
+	var found []*tfe.NotificationConfiguration
+	for _, nc := range configs {
+		if nc.Name == name {
+			found = append(found, nc)
+		}
+	}
+	return found
+}
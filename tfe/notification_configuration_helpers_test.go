@@ -0,0 +1,254 @@
+package tfe
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestVerifyNotificationConfigurations(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/notification-configurations/nc-healthy/actions/verify":
+			fmt.Fprint(w, `{"data":{"id":"nc-healthy","type":"notification-configurations","attributes":{"delivery-responses":[{"code":"200","successful":"true","sent-at":"2023-01-01T00:00:00Z"}]}}}`)
+		case "/api/v2/notification-configurations/nc-broken/actions/verify":
+			fmt.Fprint(w, `{"data":{"id":"nc-broken","type":"notification-configurations","attributes":{"delivery-responses":[{"code":"200","successful":"true","sent-at":"2023-01-01T00:00:00Z"},{"code":"404","successful":"false","sent-at":"2023-01-02T00:00:00Z"}]}}}`)
+		case "/api/v2/notification-configurations/nc-silent/actions/verify":
+			fmt.Fprint(w, `{"data":{"id":"nc-silent","type":"notification-configurations","attributes":{"delivery-responses":[]}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	configs := []*tfe.NotificationConfiguration{
+		{ID: "nc-silent"},
+		{ID: "nc-missing"},
+		{ID: "nc-healthy"},
+		{ID: "nc-broken"},
+	}
+
+	results := verifyNotificationConfigurations(ctx, client, configs, 2)
+
+	want := []struct {
+		id      string
+		healthy bool
+		code    string
+	}{
+		{"nc-broken", false, "404"},
+		{"nc-healthy", true, "200"},
+		{"nc-missing", false, ""},
+		{"nc-silent", false, ""},
+	}
+
+	if len(results) != len(want) {
+		t.Fatalf("expected %d results, got %d", len(want), len(results))
+	}
+
+	for i, w := range want {
+		got := results[i]
+		if got.Config.ID != w.id || got.Healthy != w.healthy || got.Code != w.code {
+			t.Fatalf("wrong result %d\ngot: %s healthy=%t code=%q\nwant: %s healthy=%t code=%q", i, got.Config.ID, got.Healthy, got.Code, w.id, w.healthy, w.code)
+		}
+		if !got.Healthy && got.Error == "" {
+			t.Fatalf("expected an error for unhealthy notification configuration %s", got.Config.ID)
+		}
+	}
+}
+
+func TestExpandNotificationTriggers(t *testing.T) {
+	supported, unsupported := expandNotificationTriggers([]interface{}{"run:created", "assessment:check_failure"})
+	if len(supported) != 2 || unsupported {
+		t.Fatalf("expected all triggers to be supported by go-tfe, got %v (unsupported %t)", supported, unsupported)
+	}
+
+	supported, unsupported = expandNotificationTriggers([]interface{}{"run:errored", notificationTriggerAutoDestroyReminder})
+	if len(supported) != 1 || supported[0] != tfe.NotificationTriggerErrored || !unsupported {
+		t.Fatalf("expected the auto-destroy trigger to be set separately, got %v (unsupported %t)", supported, unsupported)
+	}
+}
+
+func TestUpdateNotificationConfigurationTriggers(t *testing.T) {
+	var body string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/notification-configurations/nc-123":
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+			fmt.Fprint(w, `{"data":{"id":"nc-123","type":"notification-configurations","attributes":{}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	err := updateNotificationConfigurationTriggers(ctx, client, "nc-123", []interface{}{"run:errored", notificationTriggerAutoDestroyRunResults})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(body, `"triggers":["run:errored","workspace:auto_destroy_run_results"]`) {
+		t.Fatalf("expected all triggers to be sent, got %s", body)
+	}
+
+	err = updateNotificationConfigurationTriggers(ctx, client, "nc-123", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(body, `"triggers":[]`) {
+		t.Fatalf("expected the triggers to be cleared, got %s", body)
+	}
+}
+
+func TestVerifyNotificationConfigurationDiagnostics(t *testing.T) {
+	verified := map[string]int{}
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/notification-configurations/nc-healthy/actions/verify":
+			verified["nc-healthy"]++
+			fmt.Fprint(w, `{"data":{"id":"nc-healthy","type":"notification-configurations","attributes":{"delivery-responses":[{"code":"200","successful":"true","sent-at":"2023-01-01T00:00:00Z"}]}}}`)
+		case "/api/v2/notification-configurations/nc-broken/actions/verify":
+			verified["nc-broken"]++
+			fmt.Fprint(w, `{"data":{"id":"nc-broken","type":"notification-configurations","attributes":{"delivery-responses":[{"code":"404","successful":"false","sent-at":"2023-01-02T00:00:00Z"}]}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	cases := map[string]struct {
+		id              string
+		verify          string
+		enabled         bool
+		destinationType string
+		severity        *diag.Severity
+		verified        bool
+	}{
+		"healthy": {
+			id:              "nc-healthy",
+			verify:          notificationVerifyError,
+			enabled:         true,
+			destinationType: "slack",
+			verified:        true,
+		},
+		"broken with error": {
+			id:              "nc-broken",
+			verify:          notificationVerifyError,
+			enabled:         true,
+			destinationType: "generic",
+			severity:        diagSeverity(diag.Error),
+			verified:        true,
+		},
+		"broken with warn": {
+			id:              "nc-broken",
+			verify:          notificationVerifyWarn,
+			enabled:         true,
+			destinationType: "generic",
+			severity:        diagSeverity(diag.Warning),
+			verified:        true,
+		},
+		"broken without verify": {
+			id:              "nc-broken",
+			verify:          notificationVerifyNone,
+			enabled:         true,
+			destinationType: "generic",
+		},
+		"broken and disabled": {
+			id:              "nc-broken",
+			verify:          notificationVerifyError,
+			destinationType: "generic",
+		},
+		"email": {
+			id:              "nc-broken",
+			verify:          notificationVerifyError,
+			enabled:         true,
+			destinationType: "email",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			before := verified[tc.id]
+
+			d := resourceTFENotificationConfiguration().TestResourceData()
+			d.SetId(tc.id)
+			d.Set("verify", tc.verify)
+			d.Set("enabled", tc.enabled)
+			d.Set("destination_type", tc.destinationType)
+
+			diags := verifyNotificationConfigurationDiagnostics(ctx, d, ConfiguredClient{Client: client})
+
+			if (verified[tc.id] > before) != tc.verified {
+				t.Fatalf("expected verified to be %t", tc.verified)
+			}
+			if tc.severity == nil {
+				if len(diags) != 0 {
+					t.Fatalf("expected no diagnostics, got: %#v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags[0].Severity != *tc.severity {
+				t.Fatalf("expected a single diagnostic with severity %v, got: %#v", *tc.severity, diags)
+			}
+			if !strings.Contains(diags[0].Detail, "destination responded with status 404") {
+				t.Fatalf("expected the status in the diagnostic, got: %s", diags[0].Detail)
+			}
+		})
+	}
+}
+
+func TestValidateNotificationTrigger(t *testing.T) {
+	tests := map[string]struct {
+		trigger  string
+		severity *diag.Severity
+	}{
+		"known trigger": {
+			trigger: "run:created",
+		},
+		"trigger unknown to go-tfe": {
+			trigger: notificationTriggerAutoDestroyReminder,
+		},
+		"unknown trigger": {
+			trigger:  "change_request:created",
+			severity: diagSeverity(diag.Warning),
+		},
+		"malformed trigger": {
+			trigger:  "run created",
+			severity: diagSeverity(diag.Error),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := validateNotificationTrigger(test.trigger, cty.Path{})
+			if test.severity == nil {
+				if len(diags) != 0 {
+					t.Fatalf("expected no diagnostics, got %#v", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 || diags[0].Severity != *test.severity {
+				t.Fatalf("expected one diagnostic with severity %v, got %#v", *test.severity, diags)
+			}
+		})
+	}
+
+	supported, unsupported := expandNotificationTriggers([]interface{}{"run:created", "change_request:created"})
+	if len(supported) != 1 || !unsupported {
+		t.Fatalf("expected the unknown trigger to be set separately, got %v (unsupported %t)", supported, unsupported)
+	}
+}
+
+func diagSeverity(s diag.Severity) *diag.Severity {
+	return &s
+}
@@ -3,10 +3,41 @@ package tfe
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/hashicorp/go-tfe"
 )
 
+// oauthClientAgentPoolCreateOptions creates an OAuth client whose VCS
+// provider is reached through an agent pool, which is not exposed by
+// tfe.OAuthClientCreateOptions.
+type oauthClientAgentPoolCreateOptions struct {
+	Type               string                   `jsonapi:"primary,oauth-clients"`
+	Name               *string                  `jsonapi:"attr,name"`
+	APIURL             *string                  `jsonapi:"attr,api-url"`
+	HTTPURL            *string                  `jsonapi:"attr,http-url"`
+	Key                *string                  `jsonapi:"attr,key,omitempty"`
+	OAuthToken         *string                  `jsonapi:"attr,oauth-token-string,omitempty"`
+	PrivateKey         *string                  `jsonapi:"attr,private-key,omitempty"`
+	Secret             *string                  `jsonapi:"attr,secret,omitempty"`
+	RSAPublicKey       *string                  `jsonapi:"attr,rsa-public-key,omitempty"`
+	ServiceProvider    *tfe.ServiceProviderType `jsonapi:"attr,service-provider"`
+	OrganizationScoped *bool                    `jsonapi:"attr,organization-scoped,omitempty"`
+	AgentPool          *tfe.AgentPool           `jsonapi:"relation,agent-pool"`
+}
+
+// oauthClientRelationships holds the raw relationships of an OAuth client, so
+// that relations which are not exposed by tfe.OAuthClient can be read.
+type oauthClientRelationships struct {
+	Data struct {
+		Relationships map[string]struct {
+			Data *struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		} `json:"relationships"`
+	} `json:"data"`
+}
+
 func fetchOAuthClientByNameOrServiceProvider(ctx context.Context, tfeClient *tfe.Client, organization, name string, serviceProvider tfe.ServiceProviderType) (*tfe.OAuthClient, error) {
 	// Paginate through all OAuthClients in the organization; if multiple pages
 	// of results are returned by the API, use the options variable to increment
@@ -62,3 +93,52 @@ func fetchOAuthClientByNameOrServiceProvider(ctx context.Context, tfeClient *tfe
 
 	return ocMatches[0], nil
 }
+
+// createOAuthClientWithAgentPool creates an OAuth client with the given
+// options, reaching its VCS provider through an agent pool.
+func createOAuthClientWithAgentPool(ctx context.Context, client *tfe.Client, organization string, options tfe.OAuthClientCreateOptions, agentPoolID string) (*tfe.OAuthClient, error) {
+	u := fmt.Sprintf("organizations/%s/oauth-clients", url.QueryEscape(organization))
+	req, err := client.NewRequest("POST", u, &oauthClientAgentPoolCreateOptions{
+		Name:               options.Name,
+		APIURL:             options.APIURL,
+		HTTPURL:            options.HTTPURL,
+		Key:                options.Key,
+		OAuthToken:         options.OAuthToken,
+		PrivateKey:         options.PrivateKey,
+		Secret:             options.Secret,
+		RSAPublicKey:       options.RSAPublicKey,
+		ServiceProvider:    options.ServiceProvider,
+		OrganizationScoped: options.OrganizationScoped,
+		AgentPool:          &tfe.AgentPool{ID: agentPoolID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	oc := &tfe.OAuthClient{}
+	if err := req.Do(ctx, oc); err != nil {
+		return nil, err
+	}
+
+	return oc, nil
+}
+
+// readOAuthClientAgentPoolID returns the ID of the agent pool an OAuth client
+// reaches its VCS provider through, if any.
+func readOAuthClientAgentPoolID(ctx context.Context, client *tfe.Client, oauthClientID string) (string, error) {
+	u := fmt.Sprintf("oauth-clients/%s", url.QueryEscape(oauthClientID))
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return "", err
+	}
+
+	oc := &oauthClientRelationships{}
+	if err := req.DoJSON(ctx, oc); err != nil {
+		return "", err
+	}
+
+	if agentPool := oc.Data.Relationships["agent-pool"].Data; agentPool != nil {
+		return agentPool.ID, nil
+	}
+	return "", nil
+}
@@ -0,0 +1,59 @@
+package tfe
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestOAuthClientAgentPool(t *testing.T) {
+	var body string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/hashicorp/oauth-clients":
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data":{"id":"oc-123","type":"oauth-clients","attributes":{"service-provider":"github_enterprise"}}}`)
+		case "/api/v2/oauth-clients/oc-123":
+			fmt.Fprint(w, `{"data":{"id":"oc-123","type":"oauth-clients","relationships":{"agent-pool":{"data":{"id":"apool-123","type":"agent-pools"}}}}}`)
+		case "/api/v2/oauth-clients/oc-456":
+			fmt.Fprint(w, `{"data":{"id":"oc-456","type":"oauth-clients","relationships":{"agent-pool":{"data":null}}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	oc, err := createOAuthClientWithAgentPool(ctx, client, "hashicorp", tfe.OAuthClientCreateOptions{
+		APIURL:          tfe.String("https://github.example.com/api/v3"),
+		HTTPURL:         tfe.String("https://github.example.com"),
+		OAuthToken:      tfe.String("not-a-token"),
+		ServiceProvider: tfe.ServiceProvider(tfe.ServiceProviderGithubEE),
+	}, "apool-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if oc.ID != "oc-123" {
+		t.Fatalf("expected OAuth client oc-123, got %s", oc.ID)
+	}
+	if !strings.Contains(body, `"agent-pool":{"data":{"type":"agent-pools","id":"apool-123"}}`) {
+		t.Fatalf("expected the agent pool to be sent, got %s", body)
+	}
+
+	for id, expected := range map[string]string{"oc-123": "apool-123", "oc-456": ""} {
+		agentPoolID, err := readOAuthClientAgentPoolID(ctx, client, id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if agentPoolID != expected {
+			t.Fatalf("expected agent pool %q for %s, got %q", expected, id, agentPoolID)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// organizationDefaultTerraformVersionOptions updates the Terraform version
+// new workspaces of an organization default to, which is not exposed by
+// tfe.OrganizationUpdateOptions.
+type organizationDefaultTerraformVersionOptions struct {
+	Type                    string  `jsonapi:"primary,organizations"`
+	DefaultTerraformVersion *string `jsonapi:"attr,default-terraform-version"`
+}
+
+// organizationSpeculativePlanManagementOptions updates whether speculative
+// plans of pull requests are canceled when newer commits are pushed, which is
+// not exposed by tfe.OrganizationUpdateOptions.
+type organizationSpeculativePlanManagementOptions struct {
+	Type                             string `jsonapi:"primary,organizations"`
+	SpeculativePlanManagementEnabled *bool  `jsonapi:"attr,speculative-plan-management-enabled"`
+}
+
+// organizationAttributes holds the raw attributes of an organization, so that
+// settings which are missing on older releases of Terraform Enterprise can be
+// detected.
+type organizationAttributes struct {
+	Data struct {
+		Attributes map[string]interface{} `json:"attributes"`
+	} `json:"data"`
+}
+
+// readOrganizationDefaultTerraformVersion returns the Terraform version new
+// workspaces of an organization default to, and whether the setting is
+// supported at all.
+func readOrganizationDefaultTerraformVersion(ctx context.Context, client *tfe.Client, name string) (version string, supported bool, err error) {
+	attributes, err := readOrganizationAttributes(ctx, client, name)
+	if err != nil {
+		return "", false, err
+	}
+
+	v, ok := attributes["default-terraform-version"]
+	if !ok {
+		return "", false, nil
+	}
+
+	version, _ = v.(string)
+	return version, true, nil
+}
+
+// readOrganizationSpeculativePlanManagement returns whether speculative plans
+// of an organization are managed, and whether the setting is supported at
+// all.
+func readOrganizationSpeculativePlanManagement(ctx context.Context, client *tfe.Client, name string) (enabled, supported bool, err error) {
+	attributes, err := readOrganizationAttributes(ctx, client, name)
+	if err != nil {
+		return false, false, err
+	}
+
+	v, ok := attributes["speculative-plan-management-enabled"]
+	if !ok {
+		return false, false, nil
+	}
+
+	enabled, _ = v.(bool)
+	return enabled, true, nil
+}
+
+// readOrganizationAttributes returns the raw attributes of an organization.
+func readOrganizationAttributes(ctx context.Context, client *tfe.Client, name string) (map[string]interface{}, error) {
+	u := fmt.Sprintf("organizations/%s", url.QueryEscape(name))
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	org := &organizationAttributes{}
+	if err := req.DoJSON(ctx, org); err != nil {
+		return nil, err
+	}
+
+	return org.Data.Attributes, nil
+}
+
+// updateOrganizationDefaultTerraformVersion sets the Terraform version new
+// workspaces of an organization default to, failing when the setting is not
+// supported.
+func updateOrganizationDefaultTerraformVersion(ctx context.Context, client *tfe.Client, name, version string) error {
+	_, supported, err := readOrganizationDefaultTerraformVersion(ctx, client, name)
+	if err != nil {
+		return fmt.Errorf("Error reading default Terraform version of organization %s: %w", name, err)
+	}
+	if !supported {
+		return fmt.Errorf("default_terraform_version is not supported by this version of Terraform Enterprise")
+	}
+
+	u := fmt.Sprintf("organizations/%s", url.QueryEscape(name))
+	req, err := client.NewRequest("PATCH", u, &organizationDefaultTerraformVersionOptions{
+		DefaultTerraformVersion: tfe.String(version),
+	})
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
+
+// updateOrganizationSpeculativePlanManagement sets whether speculative plans
+// of an organization are managed, failing when the setting is not supported.
+func updateOrganizationSpeculativePlanManagement(ctx context.Context, client *tfe.Client, name string, enabled bool) error {
+	_, supported, err := readOrganizationSpeculativePlanManagement(ctx, client, name)
+	if err != nil {
+		return fmt.Errorf("Error reading speculative plan management of organization %s: %w", name, err)
+	}
+	if !supported {
+		return fmt.Errorf("speculative_plan_management_enabled is not supported by this version of Terraform Enterprise")
+	}
+
+	u := fmt.Sprintf("organizations/%s", url.QueryEscape(name))
+	req, err := client.NewRequest("PATCH", u, &organizationSpeculativePlanManagementOptions{
+		SpeculativePlanManagementEnabled: tfe.Bool(enabled),
+	})
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
+
+// listModuleConsumers returns the names of the organizations which can use
+// the private modules of an organization through the Admin API. go-tfe does
+// not send the page of the list options, so the request is made here.
+func listModuleConsumers(ctx context.Context, client *tfe.Client, organization string) ([]string, error) {
+	var consumers []string
+
+	u := fmt.Sprintf("admin/organizations/%s/relationships/module-consumers", url.QueryEscape(organization))
+	options := &tfe.AdminOrganizationListModuleConsumersOptions{}
+	for {
+		req, err := client.NewRequest("GET", u, options)
+		if err != nil {
+			return nil, err
+		}
+
+		l := &tfe.AdminOrganizationList{}
+		if err := req.Do(ctx, l); err != nil {
+			return nil, err
+		}
+
+		for _, consumer := range l.Items {
+			consumers = append(consumers, consumer.Name)
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.Pagination == nil || l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return consumers, nil
+}
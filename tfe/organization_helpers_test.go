@@ -0,0 +1,158 @@
+package tfe
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestReadOrganizationDefaultTerraformVersion(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/hashicorp":
+			fmt.Fprint(w, `{"data":{"id":"hashicorp","type":"organizations","attributes":{"default-terraform-version":"1.5.7"}}}`)
+		case "/api/v2/organizations/unsupported":
+			fmt.Fprint(w, `{"data":{"id":"unsupported","type":"organizations","attributes":{"email":"admin@company.com"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	tests := map[string]struct {
+		name      string
+		version   string
+		supported bool
+		err       bool
+	}{
+		"default version": {
+			name:      "hashicorp",
+			version:   "1.5.7",
+			supported: true,
+		},
+		"unsupported": {
+			name: "unsupported",
+		},
+		"non existing organization": {
+			name: "not-an-org",
+			err:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			version, supported, err := readOrganizationDefaultTerraformVersion(ctx, client, test.name)
+			if (err != nil) != test.err {
+				t.Fatalf("expected error is %t, got %v", test.err, err)
+			}
+			if version != test.version || supported != test.supported {
+				t.Fatalf("expected version %q (supported %t), got %q (supported %t)", test.version, test.supported, version, supported)
+			}
+		})
+	}
+}
+
+func TestUpdateOrganizationSpeculativePlanManagement(t *testing.T) {
+	var body string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/hashicorp":
+			if r.Method == "PATCH" {
+				b, _ := io.ReadAll(r.Body)
+				body = string(b)
+			}
+			fmt.Fprint(w, `{"data":{"id":"hashicorp","type":"organizations","attributes":{"speculative-plan-management-enabled":true}}}`)
+		case "/api/v2/organizations/unsupported":
+			fmt.Fprint(w, `{"data":{"id":"unsupported","type":"organizations","attributes":{"email":"admin@company.com"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	enabled, supported, err := readOrganizationSpeculativePlanManagement(ctx, client, "hashicorp")
+	if err != nil || !enabled || !supported {
+		t.Fatalf("expected speculative plan management to be enabled and supported, got %t, %t, %v", enabled, supported, err)
+	}
+
+	if err := updateOrganizationSpeculativePlanManagement(ctx, client, "hashicorp", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(body, `"speculative-plan-management-enabled":false`) {
+		t.Fatalf("expected speculative plan management to be disabled, got %s", body)
+	}
+
+	if err := updateOrganizationSpeculativePlanManagement(ctx, client, "unsupported", true); err == nil {
+		t.Fatal("expected an error updating an unsupported setting")
+	}
+}
+
+func TestValidateTerraformVersion(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/admin/terraform-versions":
+			switch r.URL.Query().Get("filter[version]") {
+			case "1.5.7":
+				fmt.Fprint(w, `{"data":[{"id":"tool-1","type":"terraform-versions","attributes":{"version":"1.5.7","enabled":true}}],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+			case "0.11.0":
+				fmt.Fprint(w, `{"data":[{"id":"tool-2","type":"terraform-versions","attributes":{"version":"0.11.0","enabled":false}}],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+			default:
+				fmt.Fprint(w, `{"data":[],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	tests := map[string]struct {
+		version string
+		err     bool
+	}{
+		"enabled version": {
+			version: "1.5.7",
+		},
+		"latest": {
+			version: "latest",
+		},
+		"disabled version": {
+			version: "0.11.0",
+			err:     true,
+		},
+		"unavailable version": {
+			version: "9.9.9",
+			err:     true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateTerraformVersion(ctx, test.version, client)
+			if (err != nil) != test.err {
+				t.Fatalf("expected error is %t, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestValidateTerraformVersion_noAdminToken(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	if err := validateTerraformVersion(ctx, "9.9.9", client); err != nil {
+		t.Fatalf("expected validation to be skipped, got %v", err)
+	}
+}
@@ -4,32 +4,72 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
 )
 
-func fetchOrganizationMembers(client *tfe.Client, orgName string) ([]map[string]string, []map[string]string, error) {
+// organizationMembersFilter restricts the members returned by
+// fetchOrganizationMembers. The zero value returns all members.
+type organizationMembersFilter struct {
+	// TeamID only returns the members of a team.
+	TeamID string
+
+	// Status only returns the members with the given status.
+	Status tfe.OrganizationMembershipStatus
+}
+
+func fetchOrganizationMembers(ctx context.Context, client *tfe.Client, orgName string, filter organizationMembersFilter) ([]map[string]string, []map[string]string, error) {
 	var members []map[string]string
 	var membersWaiting []map[string]string
 
-	options := tfe.OrganizationMembershipListOptions{}
-	for {
-		organizationMembershipList, err := client.OrganizationMemberships.List(ctx, orgName, &options)
-		if err != nil {
-			return nil, nil, fmt.Errorf("Error retrieving organization members: %w", err)
-		}
+	addMembers := func(memberships []*tfe.OrganizationMembership) {
+		for _, orgMembership := range memberships {
+			if filter.Status != "" && orgMembership.Status != filter.Status {
+				continue
+			}
+
+			member := map[string]string{"organization_membership_id": orgMembership.ID}
+			if orgMembership.User != nil {
+				member["user_id"] = orgMembership.User.ID
+			}
 
-		for _, orgMembership := range organizationMembershipList.Items {
 			if orgMembership.Status == tfe.OrganizationMembershipActive {
-				member := map[string]string{"user_id": orgMembership.User.ID, "organization_membership_id": orgMembership.ID}
 				members = append(members, member)
 			} else if orgMembership.Status == tfe.OrganizationMembershipInvited {
-				member := map[string]string{"user_id": orgMembership.User.ID, "organization_membership_id": orgMembership.ID}
 				membersWaiting = append(membersWaiting, member)
 			} else {
 				log.Printf("Organization member with unknown status found: %s", orgMembership.Status)
 			}
 		}
+	}
+
+	// The memberships of a team are read together with the team, which
+	// avoids listing every member of large organizations.
+	if filter.TeamID != "" {
+		memberships, err := client.TeamMembers.ListOrganizationMemberships(ctx, filter.TeamID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error retrieving organization members of team %s: %w", filter.TeamID, err)
+		}
+
+		addMembers(memberships)
+		return members, membersWaiting, nil
+	}
+
+	options := tfe.OrganizationMembershipListOptions{
+		Status: filter.Status,
+	}
+	options.PageSize = 100
+
+	for {
+		organizationMembershipList, err := client.OrganizationMemberships.List(ctx, orgName, &options)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error retrieving organization members: %w", err)
+		}
+
+		addMembers(organizationMembershipList.Items)
+
 		// Exit the loop when we've seen all pages.
 		if organizationMembershipList.CurrentPage >= organizationMembershipList.TotalPages {
 			break
@@ -100,3 +140,88 @@ func fetchOrganizationMemberByNameOrEmail(ctx context.Context, client *tfe.Clien
 
 	return nil, tfe.ErrResourceNotFound
 }
+
+// listOrganizationMemberships lists all memberships of an organization with
+// their user and teams, optionally restricted to a status and emails.
+func listOrganizationMemberships(ctx context.Context, client *tfe.Client, orgName string, status tfe.OrganizationMembershipStatus, emails []string) ([]*tfe.OrganizationMembership, error) {
+	var memberships []*tfe.OrganizationMembership
+
+	options := tfe.OrganizationMembershipListOptions{
+		Include: []tfe.OrgMembershipIncludeOpt{tfe.OrgMembershipUser, tfe.OrgMembershipTeam},
+		Status:  status,
+		Emails:  emails,
+	}
+	options.PageSize = 100
+
+	for {
+		l, err := client.OrganizationMemberships.List(ctx, orgName, &options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving organization memberships of %s: %w", orgName, err)
+		}
+
+		memberships = append(memberships, l.Items...)
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return memberships, nil
+}
+
+// organizationMembershipEmailFilterSize is the number of emails filtered by
+// in a single list request, which keeps the query string short.
+const organizationMembershipEmailFilterSize = 50
+
+// readOrganizationMembershipsByEmail returns the memberships of the given
+// emails by lower case email. Emails without a membership are omitted.
+func readOrganizationMembershipsByEmail(ctx context.Context, client *tfe.Client, orgName string, emails []string) (map[string]*tfe.OrganizationMembership, error) {
+	memberships := make(map[string]*tfe.OrganizationMembership)
+
+	for start := 0; start < len(emails); start += organizationMembershipEmailFilterSize {
+		end := start + organizationMembershipEmailFilterSize
+		if end > len(emails) {
+			end = len(emails)
+		}
+
+		l, err := listOrganizationMemberships(ctx, client, orgName, "", emails[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, membership := range l {
+			memberships[strings.ToLower(membership.Email)] = membership
+		}
+	}
+
+	return memberships, nil
+}
+
+// inviteOrganizationMembers invites the given emails to an organization, in
+// batches of batchSize invitations separated by interval to stay well within
+// the API rate limits. The ID of every membership is added to invited as soon
+// as it is created, so the memberships created before an error are known.
+func inviteOrganizationMembers(ctx context.Context, client *tfe.Client, orgName string, emails []string, batchSize int, interval time.Duration, invited map[string]string) error {
+	for i, email := range emails {
+		if i > 0 && i%batchSize == 0 && interval > 0 {
+			log.Printf("[DEBUG] Invited %d of %d members to organization %s, waiting %s", i, len(emails), orgName, interval)
+			time.Sleep(interval)
+		}
+
+		log.Printf("[DEBUG] Create membership %s for organization: %s", email, orgName)
+		membership, err := client.OrganizationMemberships.Create(ctx, orgName, tfe.OrganizationMembershipCreateOptions{
+			Email: tfe.String(email),
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating membership %s for organization %s: %w", email, orgName, err)
+		}
+
+		invited[email] = membership.ID
+	}
+
+	return nil
+}
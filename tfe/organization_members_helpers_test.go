@@ -74,7 +74,7 @@ func TestFetchOrganizationMembers(t *testing.T) {
 		// Mock the Organization Membership
 		MockOrganizationMemberships(t, client, orgName, test.members)
 		t.Run(name, func(t *testing.T) {
-			receivedMembers, receivedMembersWaiting, err := fetchOrganizationMembers(client, test.org)
+			receivedMembers, receivedMembersWaiting, err := fetchOrganizationMembers(ctx, client, test.org, organizationMembersFilter{})
 
 			if (err != nil) != test.err {
 				t.Fatalf("expected error is %t, got %v", test.err, err)
@@ -86,6 +86,41 @@ func TestFetchOrganizationMembers(t *testing.T) {
 	}
 }
 
+func TestFetchOrganizationMembers_filters(t *testing.T) {
+	orgName := "hashicorp"
+	client := testTfeClient(t, testClientOptions{defaultOrganization: orgName})
+	MockOrganizationMemberships(t, client, orgName, activeAndInvitedOrganizationMemberships(orgName))
+
+	ctrl := gomock.NewController(t)
+	mockTeamMembersAPI := tfemocks.NewMockTeamMembers(ctrl)
+	mockTeamMembersAPI.
+		EXPECT().
+		ListOrganizationMemberships(gomock.Any(), "team-123").
+		Return(activeAndInvitedOrganizationMemberships(orgName)[1:], nil).
+		AnyTimes()
+	client.TeamMembers = mockTeamMembersAPI
+
+	// The status filter is applied by the API, so the invited member listed
+	// by the mock is skipped by the client as well.
+	members, membersWaiting, err := fetchOrganizationMembers(ctx, client, orgName, organizationMembersFilter{
+		Status: tfe.OrganizationMembershipActive,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkIsEqualMembers(t, members, []map[string]string{{"user_id": "user-orgmember-1", "organization_membership_id": "ou-orgmember-1"}})
+	checkIsEqualMembers(t, membersWaiting, nil)
+
+	members, membersWaiting, err = fetchOrganizationMembers(ctx, client, orgName, organizationMembersFilter{
+		TeamID: "team-123",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkIsEqualMembers(t, members, nil)
+	checkIsEqualMembers(t, membersWaiting, []map[string]string{{"user_id": "user-orgmember-2", "organization_membership_id": "ou-orgmember-2"}})
+}
+
 func checkIsEqualMembers(t *testing.T, receivedMembers []map[string]string, expectedMembers []map[string]string) {
 	if expectedMembers != nil && receivedMembers != nil {
 		if len(expectedMembers) != len(receivedMembers) {
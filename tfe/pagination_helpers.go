@@ -0,0 +1,62 @@
+package tfe
+
+import (
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// listPageConcurrency is the number of pages of a list which are requested
+// at the same time. The client rate limits the requests, so this only bounds
+// the number of requests waiting for a response.
+const listPageConcurrency = 4
+
+// listPageSize is the largest page size of the API, which minimizes the
+// number of requests.
+const listPageSize = 100
+
+// listAllPages returns the items of all pages of a list. The first page
+// reports the number of pages, the remaining pages are then requested
+// concurrently by listPage, which returns the items and the pagination of the
+// given page number. The items are returned in the order of the pages, and
+// the error of the first failed page is returned, if any.
+func listAllPages[T any](listPage func(pageNumber int) ([]T, *tfe.Pagination, error)) ([]T, error) {
+	first, pagination, err := listPage(1)
+	if err != nil {
+		return nil, err
+	}
+
+	if pagination == nil || pagination.TotalPages <= 1 {
+		return first, nil
+	}
+
+	pages := make([][]T, pagination.TotalPages)
+	pages[0] = first
+
+	errs := make([]error, pagination.TotalPages)
+	sem := make(chan struct{}, listPageConcurrency)
+	var wg sync.WaitGroup
+
+	for page := 2; page <= pagination.TotalPages; page++ {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(page int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			pages[page-1], _, errs[page-1] = listPage(page)
+		}(page)
+	}
+	wg.Wait()
+
+	var items []T
+	for i, pageItems := range pages {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		items = append(items, pageItems...)
+	}
+
+	return items, nil
+}
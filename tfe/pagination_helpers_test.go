@@ -0,0 +1,71 @@
+package tfe
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func TestListAllPages(t *testing.T) {
+	const totalPages = 10
+
+	var mu sync.Mutex
+	var running, maxRunning int
+	items, err := listAllPages(func(pageNumber int) ([]string, *tfe.Pagination, error) {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		defer func() {
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+
+		return []string{fmt.Sprintf("page-%d-a", pageNumber), fmt.Sprintf("page-%d-b", pageNumber)},
+			&tfe.Pagination{CurrentPage: pageNumber, TotalPages: totalPages}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(items) != 2*totalPages {
+		t.Fatalf("expected %d items, got %d", 2*totalPages, len(items))
+	}
+	for i, item := range items {
+		if want := fmt.Sprintf("page-%d-%s", i/2+1, []string{"a", "b"}[i%2]); item != want {
+			t.Fatalf("expected the items in the order of the pages, got %q at %d instead of %q", item, i, want)
+		}
+	}
+	if maxRunning > listPageConcurrency {
+		t.Fatalf("expected at most %d concurrent pages, got %d", listPageConcurrency, maxRunning)
+	}
+
+	// A list without pagination, or with a single page, is requested once.
+	requests := 0
+	items, err = listAllPages(func(pageNumber int) ([]string, *tfe.Pagination, error) {
+		requests++
+		return []string{"only"}, nil, nil
+	})
+	if err != nil || len(items) != 1 || requests != 1 {
+		t.Fatalf("expected a single request for a single page, got %d requests, %v, %v", requests, items, err)
+	}
+
+	// The error of a failed page is returned.
+	errPage := errors.New("page 3 failed")
+	_, err = listAllPages(func(pageNumber int) ([]string, *tfe.Pagination, error) {
+		if pageNumber == 3 {
+			return nil, nil, errPage
+		}
+		return []string{"item"}, &tfe.Pagination{CurrentPage: pageNumber, TotalPages: 5}, nil
+	})
+	if !errors.Is(err, errPage) {
+		t.Fatalf("expected the error of the failed page, got %v", err)
+	}
+}
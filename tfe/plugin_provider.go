@@ -3,6 +3,7 @@ package tfe
 import (
 	"context"
 	"fmt"
+	"log"
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -15,9 +16,10 @@ type pluginProviderServer struct {
 	resourceSchemas    map[string]*tfprotov5.Schema
 	dataSourceSchemas  map[string]*tfprotov5.Schema
 	tfeClient          *tfe.Client
+	organization       string
 
 	resourceRouter
-	dataSourceRouter map[string]func(*tfe.Client) tfprotov5.DataSourceServer
+	dataSourceRouter map[string]func(*tfe.Client, string) tfprotov5.DataSourceServer
 }
 
 type errUnsupportedDataSource string
@@ -32,10 +34,24 @@ func (e errUnsupportedResource) Error() string {
 	return "unsupported resource: " + string(e)
 }
 
+type errUnsupportedFunction string
+
+func (e errUnsupportedFunction) Error() string {
+	return "unsupported function: " + string(e)
+}
+
+type errUnsupportedEphemeralResource string
+
+func (e errUnsupportedEphemeralResource) Error() string {
+	return "unsupported ephemeral resource: " + string(e)
+}
+
 type providerMeta struct {
 	token         string
 	hostname      string
 	sslSkipVerify bool
+	organization  string
+	dynamicCreds  dynamicCredentials
 }
 
 func (p *pluginProviderServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
@@ -47,6 +63,25 @@ func (p *pluginProviderServer) GetProviderSchema(ctx context.Context, req *tfpro
 	}, nil
 }
 
+func (p *pluginProviderServer) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	resp := &tfprotov5.GetMetadataResponse{
+		ServerCapabilities: &tfprotov5.ServerCapabilities{GetProviderSchemaOptional: true},
+	}
+	for name := range p.resourceSchemas {
+		resp.Resources = append(resp.Resources, tfprotov5.ResourceMetadata{TypeName: name})
+	}
+	for name := range p.dataSourceSchemas {
+		resp.DataSources = append(resp.DataSources, tfprotov5.DataSourceMetadata{TypeName: name})
+	}
+	return resp, nil
+}
+
+func (p *pluginProviderServer) GetResourceIdentitySchemas(ctx context.Context, req *tfprotov5.GetResourceIdentitySchemasRequest) (*tfprotov5.GetResourceIdentitySchemasResponse, error) {
+	return &tfprotov5.GetResourceIdentitySchemasResponse{
+		IdentitySchemas: map[string]*tfprotov5.ResourceIdentitySchema{},
+	}, nil
+}
+
 func (p *pluginProviderServer) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
 	return nil, nil
 }
@@ -65,7 +100,17 @@ func (p *pluginProviderServer) ConfigureProvider(ctx context.Context, req *tfpro
 		return resp, nil
 	}
 
-	client, err := getClient(meta.hostname, meta.token, meta.sslSkipVerify)
+	token, err := resolveDynamicToken(meta.token, meta.hostname, meta.dynamicCreds)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Error exchanging OIDC token",
+			Detail:   fmt.Sprintf("Error exchanging OIDC token: %v", err),
+		})
+		return resp, nil
+	}
+
+	client, err := getClient(meta.hostname, token, meta.sslSkipVerify)
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
 			Severity: tfprotov5.DiagnosticSeverityError,
@@ -76,10 +121,16 @@ func (p *pluginProviderServer) ConfigureProvider(ctx context.Context, req *tfpro
 	}
 
 	p.tfeClient = client
+	p.organization = resolveDefaultOrganization(meta.organization)
 	return resp, nil
 }
 
 func (p *pluginProviderServer) StopProvider(ctx context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	// The process may be killed soon after it is stopped, so the traces of
+	// the API calls made so far are exported right away.
+	if err := FlushTracing(ctx); err != nil {
+		log.Printf("[WARN] Error exporting API call traces: %s", err)
+	}
 	return &tfprotov5.StopProviderResponse{}, nil
 }
 
@@ -88,7 +139,7 @@ func (p *pluginProviderServer) ValidateDataSourceConfig(ctx context.Context, req
 	if !ok {
 		return nil, errUnsupportedDataSource(req.TypeName)
 	}
-	return ds(p.tfeClient).ValidateDataSourceConfig(ctx, req)
+	return ds(p.tfeClient, p.organization).ValidateDataSourceConfig(ctx, req)
 }
 
 func (p *pluginProviderServer) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
@@ -96,7 +147,51 @@ func (p *pluginProviderServer) ReadDataSource(ctx context.Context, req *tfprotov
 	if !ok {
 		return nil, errUnsupportedDataSource(req.TypeName)
 	}
-	return ds(p.tfeClient).ReadDataSource(ctx, req)
+
+	ctx, span := startOperationSpan(ctx, req.TypeName, "read")
+	resp, err := ds(p.tfeClient, p.organization).ReadDataSource(ctx, req)
+	endOperationSpan(span, err != nil || hasErrorDiagnostic(resp))
+	return resp, err
+}
+
+// hasErrorDiagnostic reports whether the response of a data source has an
+// error diagnostic.
+func hasErrorDiagnostic(resp *tfprotov5.ReadDataSourceResponse) bool {
+	if resp == nil {
+		return false
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *pluginProviderServer) GetFunctions(ctx context.Context, req *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
+	return &tfprotov5.GetFunctionsResponse{
+		Functions: map[string]*tfprotov5.Function{},
+	}, nil
+}
+
+func (p *pluginProviderServer) CallFunction(ctx context.Context, req *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	return nil, errUnsupportedFunction(req.Name)
+}
+
+func (p *pluginProviderServer) ValidateEphemeralResourceConfig(ctx context.Context, req *tfprotov5.ValidateEphemeralResourceConfigRequest) (*tfprotov5.ValidateEphemeralResourceConfigResponse, error) {
+	return nil, errUnsupportedEphemeralResource(req.TypeName)
+}
+
+func (p *pluginProviderServer) OpenEphemeralResource(ctx context.Context, req *tfprotov5.OpenEphemeralResourceRequest) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	return nil, errUnsupportedEphemeralResource(req.TypeName)
+}
+
+func (p *pluginProviderServer) RenewEphemeralResource(ctx context.Context, req *tfprotov5.RenewEphemeralResourceRequest) (*tfprotov5.RenewEphemeralResourceResponse, error) {
+	return nil, errUnsupportedEphemeralResource(req.TypeName)
+}
+
+func (p *pluginProviderServer) CloseEphemeralResource(ctx context.Context, req *tfprotov5.CloseEphemeralResourceRequest) (*tfprotov5.CloseEphemeralResourceResponse, error) {
+	return nil, errUnsupportedEphemeralResource(req.TypeName)
 }
 
 type resourceRouter map[string]tfprotov5.ResourceServer
@@ -149,6 +244,22 @@ func (r resourceRouter) ImportResourceState(ctx context.Context, req *tfprotov5.
 	return res.ImportResourceState(ctx, req)
 }
 
+func (r resourceRouter) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	res, ok := r[req.TargetTypeName]
+	if !ok {
+		return nil, errUnsupportedResource(req.TargetTypeName)
+	}
+	return res.MoveResourceState(ctx, req)
+}
+
+func (r resourceRouter) UpgradeResourceIdentity(ctx context.Context, req *tfprotov5.UpgradeResourceIdentityRequest) (*tfprotov5.UpgradeResourceIdentityResponse, error) {
+	res, ok := r[req.TypeName]
+	if !ok {
+		return nil, errUnsupportedResource(req.TypeName)
+	}
+	return res.UpgradeResourceIdentity(ctx, req)
+}
+
 // PluginProviderServer returns the implementation of an interface for a lower
 // level usage of the Provider to Terraform protocol.
 // This relies on the terraform-plugin-go library, which provides low level
@@ -176,6 +287,67 @@ func PluginProviderServer() tfprotov5.ProviderServer {
 						Description: descriptions["ssl_skip_verify"],
 						Optional:    true,
 					},
+					{
+						Name:        "reconcile_server_defaults",
+						Type:        tftypes.Bool,
+						Description: descriptions["reconcile_server_defaults"],
+						Optional:    true,
+					},
+					{
+						Name:        "allow_owners_token",
+						Type:        tftypes.Bool,
+						Description: descriptions["allow_owners_token"],
+						Optional:    true,
+					},
+					{
+						Name:        "workspace_name_pattern",
+						Type:        tftypes.String,
+						Description: descriptions["workspace_name_pattern"],
+						Optional:    true,
+					},
+					{
+						Name:        "project_name_pattern",
+						Type:        tftypes.String,
+						Description: descriptions["project_name_pattern"],
+						Optional:    true,
+					},
+					{
+						Name:        "default_organization",
+						Type:        tftypes.String,
+						Description: descriptions["default_organization"],
+						Optional:    true,
+					},
+					{
+						Name:        "token_exchange_url",
+						Type:        tftypes.String,
+						Description: descriptions["token_exchange_url"],
+						Optional:    true,
+					},
+					{
+						Name:        "oidc_token",
+						Type:        tftypes.String,
+						Description: descriptions["oidc_token"],
+						Optional:    true,
+						Sensitive:   true,
+					},
+					{
+						Name:        "oidc_token_file",
+						Type:        tftypes.String,
+						Description: descriptions["oidc_token_file"],
+						Optional:    true,
+					},
+					{
+						Name:        "oidc_audience",
+						Type:        tftypes.String,
+						Description: descriptions["oidc_audience"],
+						Optional:    true,
+					},
+					{
+						Name:        "token_ttl",
+						Type:        tftypes.String,
+						Description: descriptions["token_ttl"],
+						Optional:    true,
+					},
 				},
 			},
 		},
@@ -200,9 +372,10 @@ func PluginProviderServer() tfprotov5.ProviderServer {
 						{
 							Name:            "organization",
 							Type:            tftypes.String,
-							Description:     "The organization to fetch the remote state from.",
+							Description:     "The organization to fetch the remote state from. Defaults to the default organization of the provider.",
 							DescriptionKind: tfprotov5.StringKindPlain,
-							Required:        true,
+							Optional:        true,
+							Computed:        true,
 						},
 						{
 							Name:      "values",
@@ -221,7 +394,7 @@ func PluginProviderServer() tfprotov5.ProviderServer {
 				},
 			},
 		},
-		dataSourceRouter: map[string]func(*tfe.Client) tfprotov5.DataSourceServer{
+		dataSourceRouter: map[string]func(*tfe.Client, string) tfprotov5.DataSourceServer{
 			"tfe_outputs": newDataSourceOutputs,
 		},
 	}
@@ -232,9 +405,19 @@ func retrieveProviderMeta(req *tfprotov5.ConfigureProviderRequest) (providerMeta
 	config := req.Config
 	val, err := config.Unmarshal(tftypes.Object{
 		AttributeTypes: map[string]tftypes.Type{
-			"hostname":        tftypes.String,
-			"token":           tftypes.String,
-			"ssl_skip_verify": tftypes.Bool,
+			"hostname":                  tftypes.String,
+			"token":                     tftypes.String,
+			"ssl_skip_verify":           tftypes.Bool,
+			"reconcile_server_defaults": tftypes.Bool,
+			"allow_owners_token":        tftypes.Bool,
+			"workspace_name_pattern":    tftypes.String,
+			"project_name_pattern":      tftypes.String,
+			"default_organization":      tftypes.String,
+			"token_exchange_url":        tftypes.String,
+			"oidc_token":                tftypes.String,
+			"oidc_token_file":           tftypes.String,
+			"oidc_audience":             tftypes.String,
+			"token_ttl":                 tftypes.String,
 		}})
 
 	if err != nil {
@@ -243,6 +426,7 @@ func retrieveProviderMeta(req *tfprotov5.ConfigureProviderRequest) (providerMeta
 	var hostname string
 	var token string
 	var sslSkipVerify bool
+	var organization string
 	var valMap map[string]tftypes.Value
 	err = val.As(&valMap)
 	if err != nil {
@@ -269,9 +453,31 @@ func retrieveProviderMeta(req *tfprotov5.ConfigureProviderRequest) (providerMeta
 		sslSkipVerify = defaultSSLSkipVerify
 	}
 
+	if !valMap["default_organization"].IsNull() {
+		err = valMap["default_organization"].As(&organization)
+		if err != nil {
+			return meta, fmt.Errorf("Could not set the default_organization value to string %w", err)
+		}
+	}
+
+	for attr, target := range map[string]*string{
+		"token_exchange_url": &meta.dynamicCreds.ExchangeURL,
+		"oidc_token":         &meta.dynamicCreds.OIDCToken,
+		"oidc_token_file":    &meta.dynamicCreds.OIDCTokenFile,
+		"oidc_audience":      &meta.dynamicCreds.Audience,
+	} {
+		if valMap[attr].IsNull() {
+			continue
+		}
+		if err := valMap[attr].As(target); err != nil {
+			return meta, fmt.Errorf("Could not set the %s value to string %w", attr, err)
+		}
+	}
+
 	meta.hostname = hostname
 	meta.token = token
 	meta.sslSkipVerify = sslSkipVerify
+	meta.organization = organization
 
 	return meta, nil
 }
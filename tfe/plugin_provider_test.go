@@ -41,20 +41,50 @@ func TestPluginProvider_providerMeta(t *testing.T) {
 	for name, tc := range cases {
 		config, err := tfprotov5.NewDynamicValue(tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
-				"hostname":        tftypes.String,
-				"token":           tftypes.String,
-				"ssl_skip_verify": tftypes.Bool,
+				"hostname":                  tftypes.String,
+				"token":                     tftypes.String,
+				"ssl_skip_verify":           tftypes.Bool,
+				"reconcile_server_defaults": tftypes.Bool,
+				"allow_owners_token":        tftypes.Bool,
+				"workspace_name_pattern":    tftypes.String,
+				"project_name_pattern":      tftypes.String,
+				"default_organization":      tftypes.String,
+				"token_exchange_url":        tftypes.String,
+				"oidc_token":                tftypes.String,
+				"oidc_token_file":           tftypes.String,
+				"oidc_audience":             tftypes.String,
+				"token_ttl":                 tftypes.String,
 			},
 		}, tftypes.NewValue(tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
-				"hostname":        tftypes.String,
-				"token":           tftypes.String,
-				"ssl_skip_verify": tftypes.Bool,
+				"hostname":                  tftypes.String,
+				"token":                     tftypes.String,
+				"ssl_skip_verify":           tftypes.Bool,
+				"reconcile_server_defaults": tftypes.Bool,
+				"allow_owners_token":        tftypes.Bool,
+				"workspace_name_pattern":    tftypes.String,
+				"project_name_pattern":      tftypes.String,
+				"default_organization":      tftypes.String,
+				"token_exchange_url":        tftypes.String,
+				"oidc_token":                tftypes.String,
+				"oidc_token_file":           tftypes.String,
+				"oidc_audience":             tftypes.String,
+				"token_ttl":                 tftypes.String,
 			},
 		}, map[string]tftypes.Value{
-			"hostname":        tftypes.NewValue(tftypes.String, tc.hostname),
-			"token":           tftypes.NewValue(tftypes.String, tc.token),
-			"ssl_skip_verify": tftypes.NewValue(tftypes.Bool, tc.sslSkipVerify),
+			"hostname":                  tftypes.NewValue(tftypes.String, tc.hostname),
+			"token":                     tftypes.NewValue(tftypes.String, tc.token),
+			"ssl_skip_verify":           tftypes.NewValue(tftypes.Bool, tc.sslSkipVerify),
+			"reconcile_server_defaults": tftypes.NewValue(tftypes.Bool, nil),
+			"allow_owners_token":        tftypes.NewValue(tftypes.Bool, nil),
+			"workspace_name_pattern":    tftypes.NewValue(tftypes.String, nil),
+			"project_name_pattern":      tftypes.NewValue(tftypes.String, nil),
+			"default_organization":      tftypes.NewValue(tftypes.String, nil),
+			"token_exchange_url":        tftypes.NewValue(tftypes.String, nil),
+			"oidc_token":                tftypes.NewValue(tftypes.String, nil),
+			"oidc_token_file":           tftypes.NewValue(tftypes.String, nil),
+			"oidc_audience":             tftypes.NewValue(tftypes.String, nil),
+			"token_ttl":                 tftypes.NewValue(tftypes.String, nil),
 		}))
 
 		req := &tfprotov5.ConfigureProviderRequest{
@@ -0,0 +1,70 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// policySetAgentEnabledOptions updates whether the policies of a policy set
+// are evaluated by agents, which is not exposed by tfe.PolicySetUpdateOptions.
+type policySetAgentEnabledOptions struct {
+	Type         string `jsonapi:"primary,policy-sets"`
+	AgentEnabled *bool  `jsonapi:"attr,agent-enabled"`
+}
+
+// policySetAttributes holds the raw attributes of a policy set, so that
+// settings which are missing on older releases of Terraform Enterprise can be
+// detected.
+type policySetAttributes struct {
+	Data struct {
+		Attributes map[string]interface{} `json:"attributes"`
+	} `json:"data"`
+}
+
+// readPolicySetAgentEnabled returns whether the policies of a policy set are
+// evaluated by agents, and whether the setting is supported at all.
+func readPolicySetAgentEnabled(ctx context.Context, client *tfe.Client, id string) (agentEnabled, supported bool, err error) {
+	u := fmt.Sprintf("policy-sets/%s", url.QueryEscape(id))
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return false, false, err
+	}
+
+	policySet := &policySetAttributes{}
+	if err := req.DoJSON(ctx, policySet); err != nil {
+		return false, false, err
+	}
+
+	v, ok := policySet.Data.Attributes["agent-enabled"]
+	if !ok {
+		return false, false, nil
+	}
+
+	agentEnabled, _ = v.(bool)
+	return agentEnabled, true, nil
+}
+
+// updatePolicySetAgentEnabled sets whether the policies of a policy set are
+// evaluated by agents, failing when the setting is not supported.
+func updatePolicySetAgentEnabled(ctx context.Context, client *tfe.Client, id string, agentEnabled bool) error {
+	_, supported, err := readPolicySetAgentEnabled(ctx, client, id)
+	if err != nil {
+		return fmt.Errorf("Error reading agent setting of policy set %s: %w", id, err)
+	}
+	if !supported {
+		return fmt.Errorf("agent_enabled is not supported by this version of Terraform Enterprise")
+	}
+
+	u := fmt.Sprintf("policy-sets/%s", url.QueryEscape(id))
+	req, err := client.NewRequest("PATCH", u, &policySetAgentEnabledOptions{
+		AgentEnabled: tfe.Bool(agentEnabled),
+	})
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
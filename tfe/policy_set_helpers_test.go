@@ -0,0 +1,59 @@
+package tfe
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestReadPolicySetAgentEnabled(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/policy-sets/polset-agent":
+			fmt.Fprint(w, `{"data":{"id":"polset-agent","type":"policy-sets","attributes":{"kind":"opa","agent-enabled":true}}}`)
+		case "/api/v2/policy-sets/polset-unsupported":
+			fmt.Fprint(w, `{"data":{"id":"polset-unsupported","type":"policy-sets","attributes":{"kind":"sentinel"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	tests := map[string]struct {
+		id           string
+		agentEnabled bool
+		supported    bool
+		err          bool
+	}{
+		"agent enabled": {
+			id:           "polset-agent",
+			agentEnabled: true,
+			supported:    true,
+		},
+		"unsupported": {
+			id: "polset-unsupported",
+		},
+		"non existing policy set": {
+			id:  "polset-missing",
+			err: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			agentEnabled, supported, err := readPolicySetAgentEnabled(ctx, client, test.id)
+			if (err != nil) != test.err {
+				t.Fatalf("expected error is %t, got %v", test.err, err)
+			}
+			if agentEnabled != test.agentEnabled {
+				t.Fatalf("expected agent enabled to be %t, got %t", test.agentEnabled, agentEnabled)
+			}
+			if supported != test.supported {
+				t.Fatalf("expected supported to be %t, got %t", test.supported, supported)
+			}
+		})
+	}
+}
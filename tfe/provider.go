@@ -9,14 +9,21 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	tfe "github.com/hashicorp/go-tfe"
 	version "github.com/hashicorp/go-version"
 	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	providerVersion "github.com/hashicorp/terraform-provider-tfe/version"
 	svchost "github.com/hashicorp/terraform-svchost"
 	"github.com/hashicorp/terraform-svchost/auth"
@@ -25,16 +32,24 @@ import (
 
 const defaultHostname = "app.terraform.io"
 const defaultSSLSkipVerify = false
+const defaultReconcileServerDefaults = true
+
+// TransportHook, when set, wraps the HTTP transport of every client created by
+// the provider. It allows programs embedding the provider, e.g. in tests, to
+// inject a custom http.RoundTripper which records or mocks the API requests.
+var TransportHook func(http.RoundTripper) http.RoundTripper
 
 var (
-	tfeServiceIDs       = []string{"tfe.v2.2"}
-	errMissingAuthToken = errors.New("Required token could not be found. Please set the token using an input variable in the provider configuration block or by using the TFE_TOKEN environment variable.")
+	tfeServiceIDs          = []string{"tfe.v2.2"}
+	errMissingAuthToken    = errors.New("Required token could not be found. Please set the token using an input variable in the provider configuration block or by using the TFE_TOKEN environment variable.")
+	errMissingOrganization = errors.New("No organization was specified on the resource or data source, and no default_organization is set in the provider configuration or by the TFE_ORGANIZATION environment variable.")
 )
 
 // Config is the structure of the configuration for the Terraform CLI.
 type Config struct {
-	Hosts       map[string]*ConfigHost            `hcl:"host"`
-	Credentials map[string]map[string]interface{} `hcl:"credentials"`
+	Hosts              map[string]*ConfigHost              `hcl:"host"`
+	Credentials        map[string]map[string]interface{}   `hcl:"credentials"`
+	CredentialsHelpers map[string]*ConfigCredentialsHelper `hcl:"credentials_helper"`
 }
 
 // ConfigHost is the structure of the "host" nested block within the CLI
@@ -44,12 +59,203 @@ type ConfigHost struct {
 	Services map[string]interface{} `hcl:"services"`
 }
 
-// ctx is used as default context.Context when making TFE calls.
-var ctx = context.Background()
+// ConfigCredentialsHelper is the structure of the "credentials_helper"
+// nested block within the CLI configuration, which configures an external
+// program to obtain credentials from.
+type ConfigCredentialsHelper struct {
+	Args []string `hcl:"args"`
+}
+
+// ConfiguredClient wraps the TFE client together with the provider level
+// settings, and is passed to resources and data sources as meta.
+type ConfiguredClient struct {
+	Client *tfe.Client
+
+	// Hostname is the Terraform Cloud/Enterprise hostname the client
+	// connects to.
+	Hostname string
+
+	// ReconcileServerDefaults controls whether optional and computed
+	// attributes which are not configured are still written to the server.
+	ReconcileServerDefaults bool
+
+	// AllowOwnersToken controls whether a token can be generated for the
+	// owners team of an organization.
+	AllowOwnersToken bool
+
+	// WorkspaceNamePattern and ProjectNamePattern, when set, are the naming
+	// conventions that managed workspace and project names must match.
+	WorkspaceNamePattern *regexp.Regexp
+	ProjectNamePattern   *regexp.Regexp
+
+	// Organization is the default organization of resources and data sources
+	// which do not configure one.
+	Organization string
+
+	// TokenTTL, when set, is the maximum lifetime of the tokens created by
+	// the provider.
+	TokenTTL time.Duration
+}
+
+// shouldWrite reports whether an optional and computed attribute should be
+// sent to the server. Unless server defaults are reconciled, only attributes
+// which are present in the configuration are written.
+func (c ConfiguredClient) shouldWrite(config cty.Value, attr string) bool {
+	return c.ReconcileServerDefaults || !config.GetAttr(attr).IsNull()
+}
+
+// organizationGetter is implemented by both schema.ResourceData and
+// schema.ResourceDiff.
+type organizationGetter interface {
+	GetOk(string) (interface{}, bool)
+}
+
+// organizationName returns the organization configured on a resource or data
+// source, falling back to the default organization of the provider.
+func (c ConfiguredClient) organizationName(d organizationGetter) (string, error) {
+	if v, ok := d.GetOk("organization"); ok {
+		return v.(string), nil
+	}
+	if c.Organization != "" {
+		return c.Organization, nil
+	}
+	return "", errMissingOrganization
+}
+
+// htmlURL returns the URL of a page of the Terraform Cloud/Enterprise UI,
+// given its path, like /app/my-org/workspaces.
+func (c ConfiguredClient) htmlURL(path string) string {
+	return fmt.Sprintf("https://%s%s", c.Hostname, path)
+}
+
+// customizeDiffDefaultOrganization plans the default organization of the
+// provider for resources which do not configure an organization, so that a
+// changed default is shown in the plan and replaces the resource.
+func customizeDiffDefaultOrganization(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.GetAttr("organization").IsNull() {
+		return nil
+	}
+
+	organization := meta.(ConfiguredClient).Organization
+	if organization == "" || d.Get("organization").(string) == organization {
+		return nil
+	}
+
+	return d.SetNew("organization", organization)
+}
+
+// customizeDiffTokenExpiration applies the token_ttl of the provider to new
+// tokens: tokens without an expiration date expire after the TTL, and tokens
+// which expire later are rejected.
+func customizeDiffTokenExpiration(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	ttl := meta.(ConfiguredClient).TokenTTL
+	if ttl == 0 || (d.Id() != "" && !d.HasChange("expired_at")) {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+	configured := d.Get("expired_at").(string) != ""
+	if !config.IsNull() {
+		configured = !config.GetAttr("expired_at").IsNull()
+	}
+	if !configured {
+		expiredAt, err := tokenExpiration(ttl, nil)
+		if err != nil {
+			return err
+		}
+		return d.SetNew("expired_at", expiredAt.Format(time.RFC3339))
+	}
+
+	if !d.NewValueKnown("expired_at") {
+		return nil
+	}
+
+	v := d.Get("expired_at").(string)
+	expiredAt, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return fmt.Errorf("Error parsing expired_at %s: %w", v, err)
+	}
+	_, err = tokenExpiration(ttl, &expiredAt)
+	return err
+}
+
+// tokenExpiration applies the token_ttl of the provider to a new token, like
+// customizeDiffTokenExpiration does for resources. Tokens without an
+// expiration date expire after the TTL, and tokens which expire later are
+// rejected.
+func tokenExpiration(ttl time.Duration, expiredAt *time.Time) (*time.Time, error) {
+	if ttl == 0 {
+		return expiredAt, nil
+	}
+
+	maxExpiredAt := time.Now().Add(ttl).UTC()
+	if expiredAt == nil {
+		maxExpiredAt = maxExpiredAt.Truncate(time.Second)
+		return &maxExpiredAt, nil
+	}
+	if expiredAt.After(maxExpiredAt) {
+		return nil, fmt.Errorf("expired_at %s is later than the token_ttl of %s configured in the provider allows", expiredAt.Format(time.RFC3339), ttl)
+	}
+
+	return expiredAt, nil
+}
+
+// validateAgentTokenTTL rejects new agent tokens when the token_ttl of the
+// provider is set, as agent tokens can not expire.
+func validateAgentTokenTTL(ttl time.Duration) error {
+	if ttl == 0 {
+		return nil
+	}
+	return fmt.Errorf("Agent tokens can not expire, so they can not be created while the token_ttl of %s is configured in the provider", ttl)
+}
+
+// validateNamePattern returns an error when a name does not match the naming
+// convention configured for the kind of object. A nil pattern matches any name.
+func validateNamePattern(pattern *regexp.Regexp, kind, name string) error {
+	if pattern == nil || pattern.MatchString(name) {
+		return nil
+	}
+	return fmt.Errorf("%s name %q does not match the naming convention %q configured in the provider", kind, name, pattern.String())
+}
+
+// compileNamePattern compiles an optional naming convention from the
+// provider configuration.
+func compileNamePattern(v, attr string) (*regexp.Regexp, error) {
+	if v == "" {
+		return nil, nil
+	}
+	pattern, err := regexp.Compile(v)
+	if err != nil {
+		return nil, fmt.Errorf("Error compiling %s: %w", attr, err)
+	}
+	return pattern, nil
+}
+
+// validateTokenTTL validates the token_ttl of the provider configuration,
+// which must be a positive duration.
+func validateTokenTTL(v interface{}, k string) ([]string, []error) {
+	ttl, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s must be a duration like 720h: %w", k, err)}
+	}
+	if ttl <= 0 {
+		return nil, []error{fmt.Errorf("%s must be positive, got %s", k, ttl)}
+	}
+	return nil, nil
+}
+
+// ProviderServer returns the Provider as a terraform-plugin-go provider
+// server, to be combined with the other providers by terraform-plugin-mux.
+// It warns about workspaces moved to another project outside of Terraform,
+// which needs the private state that the Provider does not have access to.
+func ProviderServer() tfprotov5.ProviderServer {
+	return newWorkspaceProjectMoveServer(Provider().GRPCProvider())
+}
 
 // Provider returns a schema.Provider
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		// Note that defaults and fallbacks which are usually handled by DefaultFunc here are
 		// instead handled when fetching a TFC/E client in getClient(). This is because the this
 		// provider is actually two muxed providers which must respect the same logic for fetching
@@ -72,73 +278,277 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: descriptions["ssl_skip_verify"],
 			},
+
+			"reconcile_server_defaults": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["reconcile_server_defaults"],
+			},
+
+			"allow_owners_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["allow_owners_token"],
+			},
+
+			"workspace_name_pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["workspace_name_pattern"],
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"project_name_pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["project_name_pattern"],
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"default_organization": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["default_organization"],
+			},
+
+			"token_exchange_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["token_exchange_url"],
+			},
+
+			"oidc_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: descriptions["oidc_token"],
+			},
+
+			"oidc_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["oidc_token_file"],
+			},
+
+			"oidc_audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["oidc_audience"],
+			},
+
+			"token_ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["token_ttl"],
+				ValidateFunc: validateTokenTTL,
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"tfe_organizations":           dataSourceTFEOrganizations(),
-			"tfe_organization":            dataSourceTFEOrganization(),
-			"tfe_agent_pool":              dataSourceTFEAgentPool(),
-			"tfe_ip_ranges":               dataSourceTFEIPRanges(),
-			"tfe_oauth_client":            dataSourceTFEOAuthClient(),
-			"tfe_organization_membership": dataSourceTFEOrganizationMembership(),
-			"tfe_organization_run_task":   dataSourceTFEOrganizationRunTask(),
-			"tfe_slug":                    dataSourceTFESlug(),
-			"tfe_ssh_key":                 dataSourceTFESSHKey(),
-			"tfe_team":                    dataSourceTFETeam(),
-			"tfe_team_access":             dataSourceTFETeamAccess(),
-			"tfe_workspace":               dataSourceTFEWorkspace(),
-			"tfe_workspace_ids":           dataSourceTFEWorkspaceIDs(),
-			"tfe_workspace_run_task":      dataSourceTFEWorkspaceRunTask(),
-			"tfe_variables":               dataSourceTFEWorkspaceVariables(),
-			"tfe_variable_set":            dataSourceTFEVariableSet(),
-			"tfe_policy_set":              dataSourceTFEPolicySet(),
-			"tfe_organization_members":    dataSourceTFEOrganizationMembers(),
+			"tfe_organizations":                    dataSourceTFEOrganizations(),
+			"tfe_organization":                     dataSourceTFEOrganization(),
+			"tfe_agent_pool":                       dataSourceTFEAgentPool(),
+			"tfe_audit_events":                     dataSourceTFEAuditEvents(),
+			"tfe_agents":                           dataSourceTFEAgents(),
+			"tfe_ip_ranges":                        dataSourceTFEIPRanges(),
+			"tfe_oauth_client":                     dataSourceTFEOAuthClient(),
+			"tfe_oauth_clients":                    dataSourceTFEOAuthClients(),
+			"tfe_notification_health":              dataSourceTFENotificationHealth(),
+			"tfe_notification_configuration":       dataSourceTFENotificationConfiguration(),
+			"tfe_notification_configurations":      dataSourceTFENotificationConfigurations(),
+			"tfe_organization_membership":          dataSourceTFEOrganizationMembership(),
+			"tfe_organization_memberships":         dataSourceTFEOrganizationMemberships(),
+			"tfe_organization_run_task":            dataSourceTFEOrganizationRunTask(),
+			"tfe_slug":                             dataSourceTFESlug(),
+			"tfe_state_version_outputs":            dataSourceTFEStateVersionOutputs(),
+			"tfe_ssh_key":                          dataSourceTFESSHKey(),
+			"tfe_ssh_keys":                         dataSourceTFESSHKeys(),
+			"tfe_team":                             dataSourceTFETeam(),
+			"tfe_team_access":                      dataSourceTFETeamAccess(),
+			"tfe_teams":                            dataSourceTFETeams(),
+			"tfe_terraform_versions":               dataSourceTFETerraformVersions(),
+			"tfe_workspace":                        dataSourceTFEWorkspace(),
+			"tfe_workspace_ids":                    dataSourceTFEWorkspaceIDs(),
+			"tfe_workspace_run_task":               dataSourceTFEWorkspaceRunTask(),
+			"tfe_workspace_tags":                   dataSourceTFEWorkspaceTags(),
+			"tfe_workspace_associations":           dataSourceTFEWorkspaceAssociations(),
+			"tfe_workspace_remote_state_consumers": dataSourceTFEWorkspaceRemoteStateConsumers(),
+			"tfe_workload_identity_claims":         dataSourceTFEWorkloadIdentityClaims(),
+			"tfe_variables":                        dataSourceTFEWorkspaceVariables(),
+			"tfe_variable_set":                     dataSourceTFEVariableSet(),
+			"tfe_policy_set":                       dataSourceTFEPolicySet(),
+			"tfe_policy_sets":                      dataSourceTFEPolicySets(),
+			"tfe_run":                              dataSourceTFERun(),
+			"tfe_runs":                             dataSourceTFERuns(),
+			"tfe_run_triggers":                     dataSourceTFERunTriggers(),
+			"tfe_registry_module":                  dataSourceTFERegistryModule(),
+			"tfe_registry_modules":                 dataSourceTFERegistryModules(),
+			"tfe_registry_provider_mirror":         dataSourceTFERegistryProviderMirror(),
+			"tfe_organization_members":             dataSourceTFEOrganizationMembers(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"tfe_admin_organization_settings": resourceTFEAdminOrganizationSettings(),
-			"tfe_agent_pool":                  resourceTFEAgentPool(),
-			"tfe_agent_token":                 resourceTFEAgentToken(),
-			"tfe_notification_configuration":  resourceTFENotificationConfiguration(),
-			"tfe_oauth_client":                resourceTFEOAuthClient(),
-			"tfe_organization":                resourceTFEOrganization(),
-			"tfe_organization_membership":     resourceTFEOrganizationMembership(),
-			"tfe_organization_module_sharing": resourceTFEOrganizationModuleSharing(),
-			"tfe_organization_run_task":       resourceTFEOrganizationRunTask(),
-			"tfe_organization_token":          resourceTFEOrganizationToken(),
-			"tfe_policy":                      resourceTFEPolicy(),
-			"tfe_policy_set":                  resourceTFEPolicySet(),
-			"tfe_policy_set_parameter":        resourceTFEPolicySetParameter(),
-			"tfe_project":                     resourceTFEProject(),
-			"tfe_registry_module":             resourceTFERegistryModule(),
-			"tfe_run_trigger":                 resourceTFERunTrigger(),
-			"tfe_sentinel_policy":             resourceTFESentinelPolicy(),
-			"tfe_ssh_key":                     resourceTFESSHKey(),
-			"tfe_team":                        resourceTFETeam(),
-			"tfe_team_access":                 resourceTFETeamAccess(),
-			"tfe_team_organization_member":    resourceTFETeamOrganizationMember(),
-			"tfe_team_organization_members":   resourceTFETeamOrganizationMembers(),
-			"tfe_team_member":                 resourceTFETeamMember(),
-			"tfe_team_members":                resourceTFETeamMembers(),
-			"tfe_team_token":                  resourceTFETeamToken(),
-			"tfe_terraform_version":           resourceTFETerraformVersion(),
-			"tfe_workspace":                   resourceTFEWorkspace(),
-			"tfe_workspace_run_task":          resourceTFEWorkspaceRunTask(),
-			"tfe_variable":                    resourceTFEVariable(),
-			"tfe_variable_set":                resourceTFEVariableSet(),
-			"tfe_workspace_variable_set":      resourceTFEWorkspaceVariableSet(),
-			"tfe_workspace_policy_set":        resourceTFEWorkspacePolicySet(),
+			"tfe_admin_organization_settings":      resourceTFEAdminOrganizationSettings(),
+			"tfe_agent_pool":                       resourceTFEAgentPool(),
+			"tfe_agent_token":                      resourceTFEAgentToken(),
+			"tfe_api_driven_run":                   resourceTFEAPIDrivenRun(),
+			"tfe_audit_trail_token":                resourceTFEAuditTrailToken(),
+			"tfe_data_retention_policy":            resourceTFEDataRetentionPolicy(),
+			"tfe_notification_configuration":       resourceTFENotificationConfiguration(),
+			"tfe_oauth_client":                     resourceTFEOAuthClient(),
+			"tfe_opa_version":                      resourceTFEOPAVersion(),
+			"tfe_organization":                     resourceTFEOrganization(),
+			"tfe_organization_membership":          resourceTFEOrganizationMembership(),
+			"tfe_organization_memberships":         resourceTFEOrganizationMemberships(),
+			"tfe_organization_module_consumers":    resourceTFEOrganizationModuleConsumers(),
+			"tfe_organization_module_sharing":      resourceTFEOrganizationModuleSharing(),
+			"tfe_organization_run_task":            resourceTFEOrganizationRunTask(),
+			"tfe_organization_token":               resourceTFEOrganizationToken(),
+			"tfe_output_change_trigger":            resourceTFEOutputChangeTrigger(),
+			"tfe_policy":                           resourceTFEPolicy(),
+			"tfe_policy_set":                       resourceTFEPolicySet(),
+			"tfe_policy_set_parameter":             resourceTFEPolicySetParameter(),
+			"tfe_project":                          resourceTFEProject(),
+			"tfe_project_oauth_client":             resourceTFEProjectOAuthClient(),
+			"tfe_registry_module":                  resourceTFERegistryModule(),
+			"tfe_registry_module_version":          resourceTFERegistryModuleVersion(),
+			"tfe_run":                              resourceTFERun(),
+			"tfe_run_trigger":                      resourceTFERunTrigger(),
+			"tfe_saml_team_mapping":                resourceTFESAMLTeamMapping(),
+			"tfe_sentinel_policy":                  resourceTFESentinelPolicy(),
+			"tfe_sentinel_version":                 resourceTFESentinelVersion(),
+			"tfe_ssh_key":                          resourceTFESSHKey(),
+			"tfe_state":                            resourceTFEState(),
+			"tfe_team":                             resourceTFETeam(),
+			"tfe_team_access":                      resourceTFETeamAccess(),
+			"tfe_team_access_project":              resourceTFETeamAccessProject(),
+			"tfe_team_organization_member":         resourceTFETeamOrganizationMember(),
+			"tfe_team_organization_members":        resourceTFETeamOrganizationMembers(),
+			"tfe_team_member":                      resourceTFETeamMember(),
+			"tfe_team_members":                     resourceTFETeamMembers(),
+			"tfe_team_notification_configuration":  resourceTFETeamNotificationConfiguration(),
+			"tfe_team_token":                       resourceTFETeamToken(),
+			"tfe_terraform_version":                resourceTFETerraformVersion(),
+			"tfe_workspace":                        resourceTFEWorkspace(),
+			"tfe_workspace_force_unlock":           resourceTFEWorkspaceForceUnlock(),
+			"tfe_workspace_run":                    resourceTFEWorkspaceRun(),
+			"tfe_workspace_run_task":               resourceTFEWorkspaceRunTask(),
+			"tfe_workspace_run_triggers":           resourceTFEWorkspaceRunTriggers(),
+			"tfe_workspace_settings":               resourceTFEWorkspaceSettings(),
+			"tfe_workspace_ssh_key":                resourceTFEWorkspaceSSHKey(),
+			"tfe_workspace_tags":                   resourceTFEWorkspaceTags(),
+			"tfe_workspace_team_accesses":          resourceTFEWorkspaceTeamAccesses(),
+			"tfe_variable":                         resourceTFEVariable(),
+			"tfe_variable_set":                     resourceTFEVariableSet(),
+			"tfe_workspace_variable_set":           resourceTFEWorkspaceVariableSet(),
+			"tfe_workspace_variables":              resourceTFEWorkspaceVariables(),
+			"tfe_workspace_policy_set":             resourceTFEWorkspacePolicySet(),
+			"tfe_workspace_policy_set_exclusion":   resourceTFEWorkspacePolicySetExclusion(),
+			"tfe_workspace_remote_state_consumers": resourceTFEWorkspaceRemoteStateConsumers(),
 		},
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	// The API calls of each operation are traced as children of a span named
+	// after the resource type and the operation.
+	for name, r := range provider.DataSourcesMap {
+		traceResource(name, r)
+	}
+	for name, r := range provider.ResourcesMap {
+		traceResource(name, r)
+	}
+
+	return provider
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	hostname := d.Get("hostname").(string)
-	token := d.Get("token").(string)
-	insecure := d.Get("ssl_skip_verify").(bool)
-	return getClient(hostname, token, insecure)
+	config := providerConfig{
+		hostname:      d.Get("hostname").(string),
+		token:         d.Get("token").(string),
+		sslSkipVerify: d.Get("ssl_skip_verify").(bool),
+		credentials: dynamicCredentials{
+			ExchangeURL:   d.Get("token_exchange_url").(string),
+			OIDCToken:     d.Get("oidc_token").(string),
+			OIDCTokenFile: d.Get("oidc_token_file").(string),
+			Audience:      d.Get("oidc_audience").(string),
+		},
+		allowOwnersToken:     d.Get("allow_owners_token").(bool),
+		workspaceNamePattern: d.Get("workspace_name_pattern").(string),
+		projectNamePattern:   d.Get("project_name_pattern").(string),
+		defaultOrganization:  d.Get("default_organization").(string),
+		tokenTTL:             d.Get("token_ttl").(string),
+	}
+	if v := d.GetRawConfig().GetAttr("reconcile_server_defaults"); !v.IsNull() {
+		reconcile := v.True()
+		config.reconcileServerDefaults = &reconcile
+	}
+
+	return configureClient(config)
+}
+
+// providerConfig is the provider configuration, as read from the schema of
+// the SDK provider or of the framework provider.
+type providerConfig struct {
+	hostname                string
+	token                   string
+	sslSkipVerify           bool
+	credentials             dynamicCredentials
+	reconcileServerDefaults *bool
+	allowOwnersToken        bool
+	workspaceNamePattern    string
+	projectNamePattern      string
+	defaultOrganization     string
+	tokenTTL                string
+}
+
+// configureClient returns the client of a provider configuration. Both the
+// SDK and the framework provider are configured by it, so resources and
+// ephemeral resources apply the same settings.
+func configureClient(config providerConfig) (ConfiguredClient, error) {
+	token, err := resolveDynamicToken(config.token, config.hostname, config.credentials)
+	if err != nil {
+		return ConfiguredClient{}, err
+	}
+
+	client, err := getClient(config.hostname, token, config.sslSkipVerify)
+	if err != nil {
+		return ConfiguredClient{}, err
+	}
+
+	reconcile := defaultReconcileServerDefaults
+	if config.reconcileServerDefaults != nil {
+		reconcile = *config.reconcileServerDefaults
+	}
+
+	workspaceNamePattern, err := compileNamePattern(config.workspaceNamePattern, "workspace_name_pattern")
+	if err != nil {
+		return ConfiguredClient{}, err
+	}
+
+	projectNamePattern, err := compileNamePattern(config.projectNamePattern, "project_name_pattern")
+	if err != nil {
+		return ConfiguredClient{}, err
+	}
+
+	var tokenTTL time.Duration
+	if config.tokenTTL != "" {
+		if _, errs := validateTokenTTL(config.tokenTTL, "token_ttl"); len(errs) > 0 {
+			return ConfiguredClient{}, errs[0]
+		}
+		tokenTTL, _ = time.ParseDuration(config.tokenTTL)
+	}
+
+	return ConfiguredClient{
+		Client:                  client,
+		Hostname:                resolveHostname(config.hostname),
+		ReconcileServerDefaults: reconcile,
+		AllowOwnersToken:        config.allowOwnersToken,
+		WorkspaceNamePattern:    workspaceNamePattern,
+		ProjectNamePattern:      projectNamePattern,
+		Organization:            resolveDefaultOrganization(config.defaultOrganization),
+		TokenTTL:                tokenTTL,
+	}, nil
 }
 
 func getTokenFromEnv() string {
@@ -158,15 +568,29 @@ func getTokenFromCreds(services *disco.Disco, hostname svchost.Hostname) string
 	return ""
 }
 
-func getClient(tfeHost, token string, insecure bool) (*tfe.Client, error) {
-	h := tfeHost
-	if tfeHost == "" {
-		if os.Getenv("TFE_HOSTNAME") != "" {
-			h = os.Getenv("TFE_HOSTNAME")
-		} else {
-			h = defaultHostname
-		}
+// resolveHostname returns the configured hostname, falling back to the
+// TFE_HOSTNAME environment variable and the default hostname.
+func resolveHostname(tfeHost string) string {
+	if tfeHost != "" {
+		return tfeHost
+	}
+	if os.Getenv("TFE_HOSTNAME") != "" {
+		return os.Getenv("TFE_HOSTNAME")
+	}
+	return defaultHostname
+}
+
+// resolveDefaultOrganization returns the configured default organization,
+// falling back to the TFE_ORGANIZATION environment variable.
+func resolveDefaultOrganization(organization string) string {
+	if organization != "" {
+		return organization
 	}
+	return os.Getenv("TFE_ORGANIZATION")
+}
+
+func getClient(tfeHost, token string, insecure bool) (*tfe.Client, error) {
+	h := resolveHostname(tfeHost)
 
 	log.Printf("[DEBUG] Configuring client for host %q", h)
 
@@ -204,6 +628,24 @@ func getClient(tfeHost, token string, insecure bool) (*tfe.Client, error) {
 	}
 	transport.TLSClientConfig.InsecureSkipVerify = insecure
 
+	var baseTransport http.RoundTripper = transport
+	if TransportHook != nil {
+		baseTransport = TransportHook(transport)
+	}
+
+	// A fixed API address skips service discovery entirely, which allows
+	// pointing the provider at a mocked or recorded API over plain HTTP.
+	if apiURL := os.Getenv("TFE_API_URL"); apiURL != "" {
+		log.Printf("[DEBUG] Using API address %q from TFE_API_URL, skipping service discovery", apiURL)
+		if token == "" {
+			token = getTokenFromEnv()
+		}
+		if token == "" {
+			return nil, errMissingAuthToken
+		}
+		return newTFEClient(apiURL, token, httpClient, baseTransport)
+	}
+
 	// Get the Terraform CLI configuration.
 	config := cliConfig()
 
@@ -211,7 +653,7 @@ func getClient(tfeHost, token string, insecure bool) (*tfe.Client, error) {
 	credsSrc := credentialsSource(config)
 	services := disco.NewWithCredentialsSource(credsSrc)
 	services.SetUserAgent(providerUaString)
-	services.Transport = NewLoggingTransport("TFE Discovery", transport)
+	services.Transport = NewLoggingTransport("TFE Discovery", baseTransport)
 
 	// Add any static host configurations service discovery object.
 	for userHost, hostConfig := range config.Hosts {
@@ -283,12 +725,18 @@ func getClient(tfeHost, token string, insecure bool) (*tfe.Client, error) {
 		return nil, errMissingAuthToken
 	}
 
-	// Wrap the configured transport to enable logging.
-	httpClient.Transport = NewLoggingTransport("TFE", transport)
+	return newTFEClient(address.String(), token, httpClient, baseTransport)
+}
+
+// newTFEClient creates a TFE client for the given API address.
+func newTFEClient(address, token string, httpClient *http.Client, transport http.RoundTripper) (*tfe.Client, error) {
+	// Wrap the configured transport to enable logging, and tracing if an
+	// OpenTelemetry collector is configured.
+	httpClient.Transport = NewTracingTransport(NewLoggingTransport("TFE", transport))
 
 	// Create a new TFE client config
 	cfg := &tfe.Config{
-		Address:    address.String(),
+		Address:    address,
 		Token:      token,
 		HTTPClient: httpClient,
 	}
@@ -327,8 +775,10 @@ func cliConfig() *Config {
 		credentialsConfig = readCliConfigFile(credentialsFilePath)
 	}
 
-	// Use host service discovery configs from main config file.
+	// Use host service discovery configs and credentials helpers from main
+	// config file.
 	combinedConfig.Hosts = mainConfig.Hosts
+	combinedConfig.CredentialsHelpers = mainConfig.CredentialsHelpers
 
 	// Combine both sets of credentials. Per Terraform's own behavior, the main
 	// config file overrides the credentials file if they have any overlapping
@@ -390,8 +840,17 @@ func readCliConfigFile(configFilePath string) *Config {
 	return config
 }
 
+// credentialsSource returns the credentials for service hosts. Following
+// Terraform's own behavior, TF_TOKEN_<hostname> environment variables take
+// precedence over the credentials in the CLI configuration, which in turn
+// take precedence over the configured credentials helper.
 func credentialsSource(config *Config) auth.CredentialsSource {
-	creds := auth.NoCredentials
+	var sources auth.Credentials
+
+	// Add all credentials from the environment to the credentials source.
+	if envTable := credentialsFromEnv(); len(envTable) > 0 {
+		sources = append(sources, auth.StaticCredentialsSource(envTable))
+	}
 
 	// Add all configured credentials to the credentials source.
 	if len(config.Credentials) > 0 {
@@ -405,10 +864,100 @@ func credentialsSource(config *Config) auth.CredentialsSource {
 			}
 			staticTable[host] = creds
 		}
-		creds = auth.StaticCredentialsSource(staticTable)
+		sources = append(sources, auth.StaticCredentialsSource(staticTable))
+	}
+
+	if helper := credentialsHelper(config); helper != nil {
+		sources = append(sources, helper)
+	}
+
+	if len(sources) == 0 {
+		return auth.NoCredentials
+	}
+	return sources
+}
+
+// credentialsFromEnv returns the tokens set with TF_TOKEN_<hostname>
+// environment variables. As dots and hyphens are hard to use in the names of
+// environment variables, dots are written as underscores and hyphens as
+// double underscores, e.g. TF_TOKEN_app_terraform_io.
+func credentialsFromEnv() map[svchost.Hostname]map[string]interface{} {
+	const prefix = "TF_TOKEN_"
+
+	table := map[svchost.Hostname]map[string]interface{}{}
+	for _, env := range os.Environ() {
+		name, value, ok := strings.Cut(env, "=")
+		if !ok || !strings.HasPrefix(name, prefix) || value == "" {
+			continue
+		}
+
+		// Hyphens are not allowed at the start or end of a label, so an odd
+		// number of underscores never appears in the name of a valid host.
+		rawHost := strings.TrimPrefix(name, prefix)
+		rawHost = strings.ReplaceAll(rawHost, "__", "-")
+		rawHost = strings.ReplaceAll(rawHost, "_", ".")
+
+		// The hostname may be given in either its Unicode or Punycode form.
+		host, err := svchost.ForComparison(svchost.ForDisplay(rawHost))
+		if err != nil {
+			log.Printf("[DEBUG] Ignoring environment variable %s with an invalid hostname: %s", name, err)
+			continue
+		}
+		table[host] = map[string]interface{}{"token": value}
+	}
+
+	return table
+}
+
+// credentialsHelper returns the credentials helper program configured in the
+// CLI configuration, if any. Like Terraform, the program named
+// terraform-credentials-<name> is looked up in the plugins directories.
+func credentialsHelper(config *Config) auth.CredentialsSource {
+	if len(config.CredentialsHelpers) == 0 {
+		return nil
+	}
+	if len(config.CredentialsHelpers) > 1 {
+		log.Printf("[ERROR] Only one credentials_helper may be configured in the CLI config, ignoring all of them")
+		return nil
+	}
+
+	dir, err := configDir()
+	if err != nil {
+		log.Printf("[ERROR] Error detecting the plugins directory: %s", err)
+		return nil
+	}
+	pluginDirs := []string{
+		filepath.Join(dir, "plugins"),
+		filepath.Join(dir, "plugins", runtime.GOOS+"_"+runtime.GOARCH),
+	}
+
+	for name, helper := range config.CredentialsHelpers {
+		filename := "terraform-credentials-" + name
+		if runtime.GOOS == "windows" {
+			filename += ".exe"
+		}
+
+		for _, pluginDir := range pluginDirs {
+			executable, err := filepath.Abs(filepath.Join(pluginDir, filename))
+			if err != nil {
+				continue
+			}
+			if info, err := os.Stat(executable); err != nil || info.IsDir() {
+				continue
+			}
+
+			log.Printf("[DEBUG] Using credentials helper %s", executable)
+			var args []string
+			if helper != nil {
+				args = helper.Args
+			}
+			return auth.CachingCredentialsSource(auth.HelperProgramCredentialsSource(executable, args...))
+		}
+
+		log.Printf("[ERROR] Credentials helper %q was not found in %s", filename, strings.Join(pluginDirs, ", "))
 	}
 
-	return creds
+	return nil
 }
 
 // checkConstraints checks service version constrains against our own
@@ -521,6 +1070,25 @@ var descriptions = map[string]string{
 	"token": "The token used to authenticate with Terraform Enterprise. We recommend omitting\n" +
 		"the token which can be set as credentials in the CLI config file.",
 	"ssl_skip_verify": "Whether or not to skip certificate verifications.",
+	"reconcile_server_defaults": "Whether or not optional attributes which are computed by the server are\n" +
+		"written when they are not configured. Defaults to true. Set to false when settings\n" +
+		"are also managed outside of Terraform.",
+	"allow_owners_token": "Whether or not a token can be generated for the owners team of an organization.\n" +
+		"Defaults to false.",
+	"workspace_name_pattern": "A regular expression that the names of all managed workspaces must match.\n" +
+		"Names are validated at plan time.",
+	"project_name_pattern": "A regular expression that the names of all managed projects must match.\n" +
+		"Names are validated at plan time.",
+	"default_organization": "The organization of resources and data sources which do not set an organization.\n" +
+		"Can also be set with the TFE_ORGANIZATION environment variable.",
+	"token_exchange_url": "The OAuth 2.0 token exchange endpoint which issues a token for an OIDC token, so no\n" +
+		"static token has to be stored. Can also be set with the TFE_TOKEN_EXCHANGE_URL environment variable.",
+	"oidc_token": "The OIDC token to exchange for a token. Defaults to the workload identity token of\n" +
+		"Terraform Cloud runs, or to an ID token requested from GitHub Actions.",
+	"oidc_token_file": "The path of a file containing the OIDC token to exchange for a token.",
+	"oidc_audience":   "The audience of the ID token requested from GitHub Actions.",
+	"token_ttl": "The maximum lifetime of the team, organization and audit trail tokens created by the\n" +
+		"provider, e.g. 720h. Tokens without expired_at expire after it, and later expiration dates are rejected.",
 }
 
 // A commonly used helper method to check if the error
@@ -3,20 +3,32 @@ package tfe
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	tfmux "github.com/hashicorp/terraform-plugin-mux"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
 	"github.com/hashicorp/terraform-provider-tfe/version"
+	svchost "github.com/hashicorp/terraform-svchost"
 	"github.com/hashicorp/terraform-svchost/disco"
 )
 
+// ctx is used as default context.Context when making TFE calls in tests.
+var ctx = context.Background()
+
 var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
 var testAccMuxedProviders map[string]func() (tfprotov5.ProviderServer, error)
@@ -29,14 +41,19 @@ func init() {
 	testAccMuxedProviders = map[string]func() (tfprotov5.ProviderServer, error){
 		"tfe": func() (tfprotov5.ProviderServer, error) {
 			ctx := context.Background()
-			mux, err := tfmux.NewSchemaServerFactory(
-				ctx, PluginProviderServer, testAccProvider.GRPCProvider,
+			mux, err := tf5muxserver.NewMuxServer(
+				ctx,
+				PluginProviderServer,
+				func() tfprotov5.ProviderServer {
+					return newWorkspaceProjectMoveServer(testAccProvider.GRPCProvider())
+				},
+				providerserver.NewProtocol5(FrameworkProvider()),
 			)
 			if err != nil {
 				return nil, err
 			}
 
-			return mux.Server(), nil
+			return mux.ProviderServer(), nil
 		},
 	}
 }
@@ -65,6 +82,90 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestGetClient_apiURL(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/hashicorp":
+			fmt.Fprint(w, `{"data":{"id":"hashicorp","type":"organizations","attributes":{"name":"hashicorp"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	t.Setenv("TFE_API_URL", server.URL)
+	t.Setenv("TFE_TOKEN", "not-a-token")
+
+	var recorder *testhelper.RequestRecorder
+	TransportHook = func(transport http.RoundTripper) http.RoundTripper {
+		recorder = testhelper.NewRequestRecorder(transport)
+		return recorder
+	}
+	t.Cleanup(func() { TransportHook = nil })
+
+	// The hostname is never resolved, as service discovery is skipped.
+	client, err := getClient("tfe.invalid", "", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	org, err := client.Organizations.Read(ctx, "hashicorp")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if org.Name != "hashicorp" {
+		t.Fatalf("expected organization hashicorp, got %s", org.Name)
+	}
+
+	want := []string{
+		"GET /api/v2/ping",
+		"GET /api/v2/organizations/hashicorp",
+	}
+	if got := recorder.Requests(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("wrong requests\ngot: %v\nwant: %v", got, want)
+	}
+}
+
+func TestProvider_configureClient(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.NotFoundHandler())
+
+	t.Setenv("TFE_API_URL", server.URL)
+	t.Setenv("TFE_TOKEN", "not-a-token")
+	t.Setenv("TFE_ORGANIZATION", "")
+
+	reconcile := false
+	client, err := configureClient(providerConfig{
+		hostname:                "tfe.invalid",
+		reconcileServerDefaults: &reconcile,
+		allowOwnersToken:        true,
+		workspaceNamePattern:    "^prod-",
+		projectNamePattern:      "^team-",
+		defaultOrganization:     "hashicorp",
+		tokenTTL:                "720h",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if client.ReconcileServerDefaults || !client.AllowOwnersToken || client.Organization != "hashicorp" {
+		t.Fatalf("unexpected client settings: %+v", client)
+	}
+	if client.WorkspaceNamePattern.String() != "^prod-" || client.ProjectNamePattern.String() != "^team-" {
+		t.Fatalf("unexpected name patterns: %s, %s", client.WorkspaceNamePattern, client.ProjectNamePattern)
+	}
+	if client.TokenTTL != 720*time.Hour {
+		t.Fatalf("expected a token TTL of 720h, got %s", client.TokenTTL)
+	}
+
+	for name, config := range map[string]providerConfig{
+		"invalid pattern": {hostname: "tfe.invalid", workspaceNamePattern: "("},
+		"negative TTL":    {hostname: "tfe.invalid", tokenTTL: "-1h"},
+	} {
+		if _, err := configureClient(config); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+}
+
 func TestProvider_versionConstraints(t *testing.T) {
 	cases := map[string]struct {
 		constraints *disco.Constraints
@@ -125,6 +226,125 @@ func TestProvider_versionConstraints(t *testing.T) {
 	}
 }
 
+func TestProvider_shouldWrite(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"configured":   cty.StringVal("remote"),
+		"unconfigured": cty.NullVal(cty.String),
+	})
+
+	cases := map[string]struct {
+		reconcile bool
+		attr      string
+		result    bool
+	}{
+		"reconcile configured": {
+			reconcile: true,
+			attr:      "configured",
+			result:    true,
+		},
+		"reconcile unconfigured": {
+			reconcile: true,
+			attr:      "unconfigured",
+			result:    true,
+		},
+		"no reconcile configured": {
+			reconcile: false,
+			attr:      "configured",
+			result:    true,
+		},
+		"no reconcile unconfigured": {
+			reconcile: false,
+			attr:      "unconfigured",
+			result:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		c := ConfiguredClient{ReconcileServerDefaults: tc.reconcile}
+		if result := c.shouldWrite(config, tc.attr); result != tc.result {
+			t.Fatalf("%s: expected %t, got %t", name, tc.result, result)
+		}
+	}
+}
+
+func TestProvider_validateNamePattern(t *testing.T) {
+	cases := map[string]struct {
+		pattern *regexp.Regexp
+		name    string
+		err     bool
+	}{
+		"no pattern": {
+			name: "anything-goes",
+		},
+		"matching name": {
+			pattern: regexp.MustCompile(`^(dev|prod)-[a-z0-9-]+$`),
+			name:    "prod-networking",
+		},
+		"mismatching name": {
+			pattern: regexp.MustCompile(`^(dev|prod)-[a-z0-9-]+$`),
+			name:    "networking",
+			err:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		err := validateNamePattern(tc.pattern, "Workspace", tc.name)
+		if (err != nil) != tc.err {
+			t.Fatalf("%s: expected error is %t, got %v", name, tc.err, err)
+		}
+	}
+}
+
+func TestProvider_organizationName(t *testing.T) {
+	cases := map[string]struct {
+		configured   string
+		defaultOrg   string
+		organization string
+		err          error
+	}{
+		"configured organization": {
+			configured:   "hashicorp",
+			defaultOrg:   "default",
+			organization: "hashicorp",
+		},
+		"default organization": {
+			defaultOrg:   "default",
+			organization: "default",
+		},
+		"no organization": {
+			err: errMissingOrganization,
+		},
+	}
+
+	for name, tc := range cases {
+		d := resourceTFEAgentPool().TestResourceData()
+		d.Set("organization", tc.configured)
+
+		c := ConfiguredClient{Organization: tc.defaultOrg}
+		organization, err := c.organizationName(d)
+		if err != tc.err {
+			t.Fatalf("%s: expected error %v, got %v", name, tc.err, err)
+		}
+		if organization != tc.organization {
+			t.Fatalf("%s: expected organization %q, got %q", name, tc.organization, organization)
+		}
+	}
+}
+
+// testRawConfig returns the raw configuration of a resource in which only the
+// given attributes are set.
+func testRawConfig(r *schema.Resource, attributes map[string]cty.Value) cty.Value {
+	values := map[string]cty.Value{}
+	for name, attributeType := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+		if v, ok := attributes[name]; ok {
+			values[name] = v
+		} else {
+			values[name] = cty.NullVal(attributeType)
+		}
+	}
+	return cty.ObjectVal(values)
+}
+
 func TestProvider_locateConfigFile(t *testing.T) {
 	originalHome := os.Getenv("HOME")
 	originalTfCliConfigFile := os.Getenv("TF_CLI_CONFIG_FILE")
@@ -274,6 +494,171 @@ func TestProvider_cliConfig(t *testing.T) {
 	}
 }
 
+func TestProvider_customizeDiffTokenExpiration(t *testing.T) {
+	now := time.Now().UTC()
+	inOneDay := now.Add(24 * time.Hour).Format(time.RFC3339)
+	inOneYear := now.Add(365 * 24 * time.Hour).Format(time.RFC3339)
+
+	cases := map[string]struct {
+		ttl       time.Duration
+		expiredAt string
+		err       string
+		defaulted bool
+	}{
+		"no ttl": {},
+		"default expiration": {
+			ttl:       30 * 24 * time.Hour,
+			defaulted: true,
+		},
+		"expiration within ttl": {
+			ttl:       30 * 24 * time.Hour,
+			expiredAt: inOneDay,
+		},
+		"expiration after ttl": {
+			ttl:       30 * 24 * time.Hour,
+			expiredAt: inOneYear,
+			err:       "is later than the token_ttl of 720h0m0s",
+		},
+	}
+
+	for name, tc := range cases {
+		raw := map[string]interface{}{"team_id": "team-123"}
+		if tc.expiredAt != "" {
+			raw["expired_at"] = tc.expiredAt
+		}
+
+		diff, err := resourceTFETeamToken().Diff(ctx, nil, terraform.NewResourceConfigRaw(raw), ConfiguredClient{TokenTTL: tc.ttl})
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("%s: expected error to contain %q, got %v", name, tc.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		attr := diff.Attributes["expired_at"]
+		switch {
+		case tc.defaulted:
+			expiredAt, err := time.Parse(time.RFC3339, attr.New)
+			if err != nil {
+				t.Fatalf("%s: expected a default expiration, got %q", name, attr.New)
+			}
+			if expected := now.Add(tc.ttl); expiredAt.Before(expected.Add(-time.Minute)) || expiredAt.After(expected.Add(time.Minute)) {
+				t.Fatalf("%s: expected expiration around %s, got %s", name, expected, expiredAt)
+			}
+		case tc.expiredAt != "":
+			if attr.New != tc.expiredAt {
+				t.Fatalf("%s: expected expiration %s, got %s", name, tc.expiredAt, attr.New)
+			}
+		default:
+			if !attr.NewComputed {
+				t.Fatalf("%s: expected the expiration to be computed, got %q", name, attr.New)
+			}
+		}
+	}
+}
+
+func TestProvider_tokenExpiration(t *testing.T) {
+	ttl := 30 * 24 * time.Hour
+	inOneDay := time.Now().Add(24 * time.Hour)
+	inOneYear := time.Now().Add(365 * 24 * time.Hour)
+
+	if expiredAt, err := tokenExpiration(0, nil); err != nil || expiredAt != nil {
+		t.Fatalf("expected no expiration without a ttl, got %v, %v", expiredAt, err)
+	}
+
+	expiredAt, err := tokenExpiration(ttl, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := time.Now().Add(ttl); expiredAt.Before(expected.Add(-time.Minute)) || expiredAt.After(expected.Add(time.Minute)) {
+		t.Fatalf("expected expiration around %s, got %s", expected, expiredAt)
+	}
+
+	if expiredAt, err := tokenExpiration(ttl, &inOneDay); err != nil || !expiredAt.Equal(inOneDay) {
+		t.Fatalf("expected expiration %s, got %v, %v", inOneDay, expiredAt, err)
+	}
+
+	if _, err := tokenExpiration(ttl, &inOneYear); err == nil || !strings.Contains(err.Error(), "is later than the token_ttl of 720h0m0s") {
+		t.Fatalf("expected the expiration to be rejected, got %v", err)
+	}
+}
+
+func TestProvider_agentTokenTTL(t *testing.T) {
+	raw := map[string]interface{}{"agent_pool_id": "apool-123", "description": "ci"}
+
+	if _, err := resourceTFEAgentToken().Diff(ctx, nil, terraform.NewResourceConfigRaw(raw), ConfiguredClient{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err := resourceTFEAgentToken().Diff(ctx, nil, terraform.NewResourceConfigRaw(raw), ConfiguredClient{TokenTTL: time.Hour})
+	if err == nil || !strings.Contains(err.Error(), "Agent tokens can not expire") {
+		t.Fatalf("expected new agent tokens to be rejected, got %v", err)
+	}
+}
+
+func TestProvider_credentialsSource(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the credentials helper fixture is a shell script")
+	}
+
+	// The credentials helper is looked up in the plugins directory of the
+	// Terraform configuration directory.
+	home := t.TempDir()
+	pluginDir := filepath.Join(home, ".terraform.d", "plugins")
+	if err := os.MkdirAll(pluginDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	helper := "#!/bin/sh\necho \"{\\\"token\\\":\\\"helper-$1-$2-$3\\\"}\"\n"
+	if err := os.WriteFile(filepath.Join(pluginDir, "terraform-credentials-test"), []byte(helper), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("HOME", home)
+	t.Setenv("TF_TOKEN_app_terraform_io", "env-prod")
+	t.Setenv("TF_TOKEN_tfe__dev_example_com", "env-dev")
+
+	config := &Config{
+		Credentials: map[string]map[string]interface{}{
+			"app.terraform.io":  {"token": "config-prod"},
+			"tfe.example.com":   {"token": "config-tfe"},
+			"other.example.com": {"token": "config-other"},
+		},
+		CredentialsHelpers: map[string]*ConfigCredentialsHelper{
+			"test": {Args: []string{"extra"}},
+		},
+	}
+	creds := credentialsSource(config)
+
+	cases := map[string]string{
+		"app.terraform.io":    "env-prod",
+		"tfe-dev.example.com": "env-dev",
+		"tfe.example.com":     "config-tfe",
+		"other.example.com":   "config-other",
+		"ci.example.com":      "helper-extra-get-ci.example.com",
+	}
+
+	for host, expected := range cases {
+		hostname, err := svchost.ForComparison(host)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		hostCreds, err := creds.ForHost(hostname)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", host, err)
+		}
+		if hostCreds == nil {
+			t.Fatalf("%s: expected credentials, got none", host)
+		}
+		if hostCreds.Token() != expected {
+			t.Fatalf("%s: expected token %q, got %q", host, expected, hostCreds.Token())
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	// The credentials must be provided by the CLI config file for testing.
 	if diags := Provider().Configure(context.Background(), &terraform.ResourceConfig{}); diags.HasError() {
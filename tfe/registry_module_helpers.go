@@ -0,0 +1,98 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// registryModuleVersionDeprecationOptions updates whether a version of a
+// registry module is deprecated, which is not exposed by go-tfe.
+type registryModuleVersionDeprecationOptions struct {
+	Type              string  `jsonapi:"primary,registry-module-versions"`
+	Deprecated        *bool   `jsonapi:"attr,deprecated"`
+	DeprecationReason *string `jsonapi:"attr,deprecation-reason"`
+}
+
+// registryModuleVersionAttributes holds the raw attributes of a registry
+// module version, so that settings which are missing on older releases of
+// Terraform Enterprise can be detected.
+type registryModuleVersionAttributes struct {
+	Data struct {
+		Attributes map[string]interface{} `json:"attributes"`
+	} `json:"data"`
+}
+
+// registryModuleVersionDeprecation is the deprecation of a registry module
+// version.
+type registryModuleVersionDeprecation struct {
+	Deprecated bool
+	Reason     string
+}
+
+// readRegistryModuleVersionDeprecation returns whether a version of a
+// registry module is deprecated, and whether deprecation is supported at all.
+func readRegistryModuleVersionDeprecation(ctx context.Context, client *tfe.Client, rmID tfe.RegistryModuleID, version string) (deprecation registryModuleVersionDeprecation, supported bool, err error) {
+	u := fmt.Sprintf(
+		"organizations/%s/registry-modules/private/%s/%s/%s/version?module_version=%s",
+		url.QueryEscape(rmID.Organization),
+		url.QueryEscape(rmID.Namespace),
+		url.QueryEscape(rmID.Name),
+		url.QueryEscape(rmID.Provider),
+		url.QueryEscape(version),
+	)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return deprecation, false, err
+	}
+
+	rmv := &registryModuleVersionAttributes{}
+	if err := req.DoJSON(ctx, rmv); err != nil {
+		return deprecation, false, err
+	}
+
+	v, ok := rmv.Data.Attributes["deprecated"]
+	if !ok {
+		return deprecation, false, nil
+	}
+
+	deprecation.Deprecated, _ = v.(bool)
+	deprecation.Reason, _ = rmv.Data.Attributes["deprecation-reason"].(string)
+	return deprecation, true, nil
+}
+
+// updateRegistryModuleVersionDeprecation deprecates or undeprecates a version
+// of a registry module, failing when deprecation is not supported.
+func updateRegistryModuleVersionDeprecation(ctx context.Context, client *tfe.Client, rmID tfe.RegistryModuleID, version string, deprecation registryModuleVersionDeprecation) error {
+	_, supported, err := readRegistryModuleVersionDeprecation(ctx, client, rmID, version)
+	if err != nil {
+		return fmt.Errorf("Error reading deprecation of version %s of registry module %s/%s: %w", version, rmID.Name, rmID.Provider, err)
+	}
+	if !supported {
+		return fmt.Errorf("deprecated is not supported by this version of Terraform Enterprise")
+	}
+
+	options := &registryModuleVersionDeprecationOptions{
+		Deprecated: tfe.Bool(deprecation.Deprecated),
+	}
+	if deprecation.Deprecated && deprecation.Reason != "" {
+		options.DeprecationReason = tfe.String(deprecation.Reason)
+	}
+
+	u := fmt.Sprintf(
+		"organizations/%s/registry-modules/private/%s/%s/%s/%s",
+		url.QueryEscape(rmID.Organization),
+		url.QueryEscape(rmID.Namespace),
+		url.QueryEscape(rmID.Name),
+		url.QueryEscape(rmID.Provider),
+		url.QueryEscape(version),
+	)
+	req, err := client.NewRequest("PATCH", u, options)
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
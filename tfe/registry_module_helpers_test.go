@@ -0,0 +1,68 @@
+package tfe
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestRegistryModuleVersionDeprecation(t *testing.T) {
+	var body string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/hashicorp/registry-modules/private/hashicorp/supported/aws/version":
+			fmt.Fprint(w, `{"data":{"id":"modver-1","type":"registry-module-versions","attributes":{"version":"1.0.0","deprecated":true,"deprecation-reason":"CVE-2023-1234"}}}`)
+		case "/api/v2/organizations/hashicorp/registry-modules/private/hashicorp/supported/aws/1.0.0":
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+			fmt.Fprint(w, `{"data":{"id":"modver-1","type":"registry-module-versions","attributes":{}}}`)
+		case "/api/v2/organizations/hashicorp/registry-modules/private/hashicorp/unsupported/aws/version":
+			fmt.Fprint(w, `{"data":{"id":"modver-2","type":"registry-module-versions","attributes":{"version":"1.0.0"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	moduleID := func(name string) tfe.RegistryModuleID {
+		return tfe.RegistryModuleID{
+			Organization: "hashicorp",
+			Namespace:    "hashicorp",
+			Name:         name,
+			Provider:     "aws",
+			RegistryName: tfe.PrivateRegistry,
+		}
+	}
+
+	deprecation, supported, err := readRegistryModuleVersionDeprecation(ctx, client, moduleID("supported"), "1.0.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !supported || !deprecation.Deprecated || deprecation.Reason != "CVE-2023-1234" {
+		t.Fatalf("wrong deprecation: %#v (supported %t)", deprecation, supported)
+	}
+
+	err = updateRegistryModuleVersionDeprecation(ctx, client, moduleID("supported"), "1.0.0", registryModuleVersionDeprecation{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(body, `"deprecated":false`) || !strings.Contains(body, `"deprecation-reason":null`) {
+		t.Fatalf("expected the version to be undeprecated, got %s", body)
+	}
+
+	_, supported, err = readRegistryModuleVersionDeprecation(ctx, client, moduleID("unsupported"), "1.0.0")
+	if err != nil || supported {
+		t.Fatalf("expected deprecation to be unsupported, got supported %t and error %v", supported, err)
+	}
+
+	err = updateRegistryModuleVersionDeprecation(ctx, client, moduleID("unsupported"), "1.0.0", registryModuleVersionDeprecation{Deprecated: true})
+	if err == nil {
+		t.Fatal("expected an error deprecating a version where deprecation is unsupported")
+	}
+}
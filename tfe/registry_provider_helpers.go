@@ -0,0 +1,219 @@
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+
+	tfe "github.com/hashicorp/go-tfe"
+	version "github.com/hashicorp/go-version"
+	svchost "github.com/hashicorp/terraform-svchost"
+	"github.com/hashicorp/terraform-svchost/disco"
+)
+
+const defaultRegistryHostname = "registry.terraform.io"
+
+// providersServiceID is the service discovery ID of the provider registry protocol.
+const providersServiceID = "providers.v1"
+
+// publicRegistryProviderVersion is a single entry of the provider registry
+// protocol's "list available versions" response.
+type publicRegistryProviderVersion struct {
+	Version   string                           `json:"version"`
+	Protocols []string                         `json:"protocols"`
+	Platforms []publicRegistryProviderPlatform `json:"platforms"`
+}
+
+type publicRegistryProviderPlatform struct {
+	OS   string `json:"os"`
+	Arch string `json:"arch"`
+}
+
+type publicRegistryProviderVersionList struct {
+	Versions []publicRegistryProviderVersion `json:"versions"`
+}
+
+// fetchPublicRegistryProviderVersions discovers the provider registry service
+// of the given host and returns all available versions of a provider, sorted
+// from oldest to newest.
+func fetchPublicRegistryProviderVersions(services *disco.Disco, hostname, namespace, name string) ([]publicRegistryProviderVersion, error) {
+	host, err := svchost.ForComparison(hostname)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry hostname %q: %w", hostname, err)
+	}
+
+	serviceURL, err := services.DiscoverServiceURL(host, providersServiceID)
+	if err != nil {
+		return nil, fmt.Errorf("Error discovering provider registry service for %s: %w", hostname, err)
+	}
+
+	versionsURL, err := serviceURL.Parse(fmt.Sprintf("%s/%s/versions", url.PathEscape(namespace), url.PathEscape(name)))
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Listing versions of provider %s/%s from %s", namespace, name, versionsURL)
+	req, err := http.NewRequest("GET", versionsURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{Transport: services.Transport}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving provider versions for %s/%s: %w", namespace, name, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("could not find provider %s/%s on %s", namespace, name, hostname)
+	default:
+		return nil, fmt.Errorf("Error retrieving provider versions for %s/%s: unexpected status %s", namespace, name, resp.Status)
+	}
+
+	var list publicRegistryProviderVersionList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("Error decoding provider versions for %s/%s: %w", namespace, name, err)
+	}
+
+	sortPublicRegistryProviderVersions(list.Versions)
+
+	return list.Versions, nil
+}
+
+// sortPublicRegistryProviderVersions sorts versions from oldest to newest.
+// Versions which cannot be parsed are ordered lexically after all valid ones.
+func sortPublicRegistryProviderVersions(versions []publicRegistryProviderVersion) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		vi, erri := version.NewVersion(versions[i].Version)
+		vj, errj := version.NewVersion(versions[j].Version)
+		switch {
+		case erri != nil && errj != nil:
+			return versions[i].Version < versions[j].Version
+		case erri != nil:
+			return false
+		case errj != nil:
+			return true
+		}
+		return vi.LessThan(vj)
+	})
+}
+
+// registryProviderPlatformKey identifies a platform of a provider version.
+func registryProviderPlatformKey(os, arch string) string {
+	return os + "_" + arch
+}
+
+// fetchPrivateRegistryProviderVersions returns the versions published for a
+// private provider, with the set of platforms of each version whose binary
+// was uploaded, keyed by registryProviderPlatformKey. The platforms of the
+// versions are listed with at most listPageConcurrency requests at a time. A
+// provider which does not exist yet has no versions.
+func fetchPrivateRegistryProviderVersions(ctx context.Context, client *tfe.Client, organization, name string) (map[string]map[string]bool, error) {
+	providerID := tfe.RegistryProviderID{
+		OrganizationName: organization,
+		RegistryName:     tfe.PrivateRegistry,
+		Namespace:        organization,
+		Name:             name,
+	}
+
+	var versions []string
+	options := &tfe.RegistryProviderVersionListOptions{}
+	for {
+		vl, err := client.RegistryProviderVersions.List(ctx, providerID, options)
+		if err != nil {
+			if isErrResourceNotFound(err) {
+				return map[string]map[string]bool{}, nil
+			}
+			return nil, fmt.Errorf("Error retrieving private registry provider versions: %w", err)
+		}
+
+		for _, v := range vl.Items {
+			versions = append(versions, v.Version)
+		}
+
+		// Exit the loop when we've seen all pages.
+		if vl.Pagination == nil || vl.CurrentPage >= vl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = vl.NextPage
+	}
+
+	platforms := make([]map[string]bool, len(versions))
+	errs := make([]error, len(versions))
+	sem := make(chan struct{}, listPageConcurrency)
+	var wg sync.WaitGroup
+
+	for i, v := range versions {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, v string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			platforms[i], errs[i] = fetchPrivateRegistryProviderPlatforms(ctx, client, tfe.RegistryProviderVersionID{
+				RegistryProviderID: providerID,
+				Version:            v,
+			})
+		}(i, v)
+	}
+	wg.Wait()
+
+	result := make(map[string]map[string]bool, len(versions))
+	for i, v := range versions {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("Error retrieving platforms of private registry provider version %s: %w", v, errs[i])
+		}
+		result[v] = platforms[i]
+	}
+
+	return result, nil
+}
+
+// fetchPrivateRegistryProviderPlatforms returns the set of platforms of a
+// private provider version whose binary was uploaded.
+func fetchPrivateRegistryProviderPlatforms(ctx context.Context, client *tfe.Client, versionID tfe.RegistryProviderVersionID) (map[string]bool, error) {
+	items, err := listAllPages(func(pageNumber int) ([]*tfe.RegistryProviderPlatform, *tfe.Pagination, error) {
+		pl, err := client.RegistryProviderPlatforms.List(ctx, versionID, &tfe.RegistryProviderPlatformListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: listPageSize},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return pl.Items, pl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	platforms := make(map[string]bool, len(items))
+	for _, p := range items {
+		if p.ProviderBinaryUploaded {
+			platforms[registryProviderPlatformKey(p.OS, p.Arch)] = true
+		}
+	}
+	return platforms, nil
+}
+
+// missingRegistryProviderPlatforms returns the platforms of a public provider
+// version which are not in the given set of mirrored platforms of that
+// version. All platforms of a version which is not mirrored are missing.
+func missingRegistryProviderPlatforms(v publicRegistryProviderVersion, mirrored map[string]bool) []publicRegistryProviderPlatform {
+	var missing []publicRegistryProviderPlatform
+	for _, p := range v.Platforms {
+		if !mirrored[registryProviderPlatformKey(p.OS, p.Arch)] {
+			missing = append(missing, p)
+		}
+	}
+	return missing
+}
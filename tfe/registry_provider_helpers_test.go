@@ -0,0 +1,155 @@
+package tfe
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+	svchost "github.com/hashicorp/terraform-svchost"
+	"github.com/hashicorp/terraform-svchost/disco"
+)
+
+func TestFetchPublicRegistryProviderVersions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/providers/hashicorp/null/versions":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"versions":[
+  {"version":"3.10.0","protocols":["5.0"],"platforms":[{"os":"linux","arch":"amd64"}]},
+  {"version":"3.2.0","protocols":["5.0"],"platforms":[{"os":"darwin","arch":"arm64"},{"os":"linux","arch":"amd64"}]},
+  {"version":"3.9.1","protocols":["5.0"],"platforms":[]}
+]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	host, err := svchost.ForComparison(u.Host)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	services := disco.New()
+	services.ForceHostServices(host, map[string]interface{}{
+		providersServiceID: server.URL + "/v1/providers/",
+	})
+
+	tests := map[string]struct {
+		namespace string
+		name      string
+		want      []string
+		err       bool
+	}{
+		"existing provider": {
+			namespace: "hashicorp",
+			name:      "null",
+			want:      []string{"3.2.0", "3.9.1", "3.10.0"},
+		},
+		"non existing provider": {
+			namespace: "hashicorp",
+			name:      "not-a-provider",
+			err:       true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			versions, err := fetchPublicRegistryProviderVersions(services, u.Host, test.namespace, test.name)
+			if (err != nil) != test.err {
+				t.Fatalf("expected error is %t, got %v", test.err, err)
+			}
+
+			var got []string
+			for _, v := range versions {
+				got = append(got, v.Version)
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(test.want) {
+				t.Fatalf("wrong result\ngot: %#v\nwant: %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFetchPrivateRegistryProviderVersions(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pagination := `"meta":{"pagination":{"current-page":1,"total-pages":1}}`
+		switch r.URL.Path {
+		case "/api/v2/organizations/hashicorp/registry-providers/private/hashicorp/null/versions":
+			fmt.Fprintf(w, `{"data":[
+  {"id":"provver-1","type":"registry-provider-versions","attributes":{"version":"3.2.0"}},
+  {"id":"provver-2","type":"registry-provider-versions","attributes":{"version":"3.9.1"}}
+],%s}`, pagination)
+		case "/api/v2/organizations/hashicorp/registry-providers/private/hashicorp/null/versions/3.2.0/platforms":
+			fmt.Fprintf(w, `{"data":[
+  {"id":"provpltfrm-1","type":"registry-provider-platforms","attributes":{"os":"linux","arch":"amd64","provider-binary-uploaded":true}},
+  {"id":"provpltfrm-2","type":"registry-provider-platforms","attributes":{"os":"darwin","arch":"arm64","provider-binary-uploaded":false}}
+],%s}`, pagination)
+		case "/api/v2/organizations/hashicorp/registry-providers/private/hashicorp/null/versions/3.9.1/platforms":
+			fmt.Fprintf(w, `{"data":[],%s}`, pagination)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	versions, err := fetchPrivateRegistryProviderVersions(ctx, server.Client, "hashicorp", "null")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]map[string]bool{
+		"3.2.0": {"linux_amd64": true},
+		"3.9.1": {},
+	}
+	if fmt.Sprint(versions) != fmt.Sprint(want) {
+		t.Fatalf("wrong result\ngot: %#v\nwant: %#v", versions, want)
+	}
+
+	versions, err = fetchPrivateRegistryProviderVersions(ctx, server.Client, "hashicorp", "not-a-provider")
+	if err != nil || len(versions) != 0 {
+		t.Fatalf("expected no versions for a missing provider, got %#v, %v", versions, err)
+	}
+}
+
+func TestMissingRegistryProviderPlatforms(t *testing.T) {
+	v := publicRegistryProviderVersion{
+		Version: "3.2.0",
+		Platforms: []publicRegistryProviderPlatform{
+			{OS: "darwin", Arch: "arm64"},
+			{OS: "linux", Arch: "amd64"},
+		},
+	}
+
+	tests := map[string]struct {
+		mirrored map[string]bool
+		want     []publicRegistryProviderPlatform
+	}{
+		"not mirrored": {
+			want: v.Platforms,
+		},
+		"partially mirrored": {
+			mirrored: map[string]bool{"linux_amd64": true},
+			want:     []publicRegistryProviderPlatform{{OS: "darwin", Arch: "arm64"}},
+		},
+		"fully mirrored": {
+			mirrored: map[string]bool{"darwin_arm64": true, "linux_amd64": true},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := missingRegistryProviderPlatforms(v, test.mirrored)
+			if fmt.Sprint(got) != fmt.Sprint(test.want) {
+				t.Fatalf("wrong result\ngot: %#v\nwant: %#v", got, test.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,15 +12,18 @@ import (
 
 func resourceTFEAdminOrganizationSettings() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEAdminOrganizationSettingsCreate,
-		Read:   resourceTFEAdminOrganizationSettingsRead,
-		Update: resourceTFEAdminOrganizationSettingsUpdate,
-		Delete: resourceTFEAdminOrganizationSettingsDelete,
+		CreateContext: crudContext(resourceTFEAdminOrganizationSettingsCreate),
+		ReadContext:   crudContext(resourceTFEAdminOrganizationSettingsRead),
+		UpdateContext: crudContext(resourceTFEAdminOrganizationSettingsUpdate),
+		DeleteContext: crudContext(resourceTFEAdminOrganizationSettingsDelete),
+
+		CustomizeDiff: customizeDiffDefaultOrganization,
 
 		Schema: map[string]*schema.Schema{
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"access_beta_tools": {
 				Type:     schema.TypeBool,
@@ -50,8 +54,8 @@ func resourceTFEAdminOrganizationSettings() *schema.Resource {
 	}
 }
 
-func resourceTFEAdminOrganizationSettingsRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEAdminOrganizationSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the name.
 	name := d.Get("organization").(string)
@@ -103,21 +107,24 @@ func resourceTFEAdminOrganizationSettingsRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTFEAdminOrganizationSettingsCreate(d *schema.ResourceData, meta interface{}) error {
-	return resourceTFEAdminOrganizationSettingsUpdate(d, meta)
+func resourceTFEAdminOrganizationSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceTFEAdminOrganizationSettingsUpdate(ctx, d, meta)
 }
 
-func resourceTFEAdminOrganizationSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAdminOrganizationSettingsDelete(_ context.Context, d *schema.ResourceData, meta interface{}) error {
 	d.SetId("")
 	return nil
 }
 
-func resourceTFEAdminOrganizationSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
-	name := d.Get("organization").(string)
+func resourceTFEAdminOrganizationSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+	name, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 	globalModuleSharing := d.Get("global_module_sharing").(bool)
 
-	_, err := tfeClient.Admin.Organizations.Update(ctx, name, tfe.AdminOrganizationUpdateOptions{
+	_, err = tfeClient.Admin.Organizations.Update(ctx, name, tfe.AdminOrganizationUpdateOptions{
 		AccessBetaTools:     tfe.Bool(d.Get("access_beta_tools").(bool)),
 		GlobalModuleSharing: tfe.Bool(globalModuleSharing),
 		WorkspaceLimit:      tfe.Int(d.Get("workspace_limit").(int)),
@@ -151,5 +158,5 @@ func resourceTFEAdminOrganizationSettingsUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	return resourceTFEAdminOrganizationSettingsRead(d, meta)
+	return resourceTFEAdminOrganizationSettingsRead(ctx, d, meta)
 }
@@ -5,21 +5,34 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	agentPoolUpdateImmediate = "immediate"
+	agentPoolUpdateDrain     = "drain"
 )
 
 func resourceTFEAgentPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEAgentPoolCreate,
-		Read:   resourceTFEAgentPoolRead,
-		Update: resourceTFEAgentPoolUpdate,
-		Delete: resourceTFEAgentPoolDelete,
+		CreateContext: crudContext(resourceTFEAgentPoolCreate),
+		ReadContext:   crudContext(resourceTFEAgentPoolRead),
+		UpdateContext: crudContext(resourceTFEAgentPoolUpdate),
+		DeleteContext: crudContext(resourceTFEAgentPoolDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEAgentPoolImporter,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customizeDiffDefaultOrganization,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -28,25 +41,61 @@ func resourceTFEAgentPool() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
+
+			"organization_scoped": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"allowed_workspace_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"update_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  agentPoolUpdateImmediate,
+				ValidateFunc: validation.StringInSlice(
+					[]string{agentPoolUpdateImmediate, agentPoolUpdateDrain},
+					false,
+				),
+			},
 		},
 	}
 }
 
-func resourceTFEAgentPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEAgentPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	// Create a new options struct.
 	options := tfe.AgentPoolCreateOptions{
 		Name: tfe.String(name),
 	}
 
+	// Older releases of Terraform Enterprise don't support scoping agent
+	// pools, so only send the setting when configured.
+	if v := d.GetRawConfig().GetAttr("organization_scoped"); !v.IsNull() {
+		options.OrganizationScoped = tfe.Bool(v.True())
+	}
+
+	for _, workspaceID := range d.Get("allowed_workspace_ids").(*schema.Set).List() {
+		options.AllowedWorkspaces = append(options.AllowedWorkspaces, &tfe.Workspace{ID: workspaceID.(string)})
+	}
+
 	log.Printf("[DEBUG] Create new agent pool for organization: %s", organization)
 	agentPool, err := tfeClient.AgentPools.Create(ctx, organization, options)
 	if err != nil {
@@ -56,11 +105,11 @@ func resourceTFEAgentPoolCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(agentPool.ID)
 
-	return resourceTFEAgentPoolRead(d, meta)
+	return resourceTFEAgentPoolRead(ctx, d, meta)
 }
 
-func resourceTFEAgentPoolRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEAgentPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read configuration of agent pool: %s", d.Id())
 	agentPool, err := tfeClient.AgentPools.Read(ctx, d.Id())
@@ -76,29 +125,70 @@ func resourceTFEAgentPoolRead(d *schema.ResourceData, meta interface{}) error {
 	// Update the config.
 	d.Set("name", agentPool.Name)
 	d.Set("organization", agentPool.Organization.Name)
+	d.Set("organization_scoped", agentPool.OrganizationScoped)
+
+	var allowedWorkspaceIDs []interface{}
+	for _, workspace := range agentPool.AllowedWorkspaces {
+		allowedWorkspaceIDs = append(allowedWorkspaceIDs, workspace.ID)
+	}
+	d.Set("allowed_workspace_ids", allowedWorkspaceIDs)
 
 	return nil
 }
 
-func resourceTFEAgentPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEAgentPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// Let the runs in progress finish before restricting which workspaces
+	// can use the agent pool, when requested.
+	oldScoped, newScoped := d.GetChange("organization_scoped")
+	oldIDs, newIDs := d.GetChange("allowed_workspace_ids")
+	restricted := agentPoolScopeRestricted(
+		oldScoped.(bool), newScoped.(bool), oldIDs.(*schema.Set), newIDs.(*schema.Set))
+
+	if restricted && d.Get("update_strategy").(string) == agentPoolUpdateDrain {
+		if err := waitForAgentPoolIdle(ctx, tfeClient, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
 
 	// Create a new options struct.
 	options := tfe.AgentPoolUpdateOptions{
 		Name: tfe.String(d.Get("name").(string)),
 	}
 
+	if d.HasChange("organization_scoped") {
+		options.OrganizationScoped = tfe.Bool(newScoped.(bool))
+	}
+
 	log.Printf("[DEBUG] Update agent pool: %s", d.Id())
 	_, err := tfeClient.AgentPools.Update(ctx, d.Id(), options)
 	if err != nil {
 		return fmt.Errorf("Error updating agent pool %s: %w", d.Id(), err)
 	}
 
-	return resourceTFEAgentPoolRead(d, meta)
+	// The allowed workspaces are updated separately, as clearing them is not
+	// supported by a regular update.
+	if d.HasChange("allowed_workspace_ids") {
+		allowedOptions := tfe.AgentPoolAllowedWorkspacesUpdateOptions{
+			AllowedWorkspaces: []*tfe.Workspace{},
+		}
+		for _, workspaceID := range newIDs.(*schema.Set).List() {
+			allowedOptions.AllowedWorkspaces = append(allowedOptions.AllowedWorkspaces, &tfe.Workspace{ID: workspaceID.(string)})
+		}
+
+		log.Printf("[DEBUG] Update allowed workspaces of agent pool: %s", d.Id())
+		_, err := tfeClient.AgentPools.UpdateAllowedWorkspaces(ctx, d.Id(), allowedOptions)
+		if err != nil {
+			return fmt.Errorf("Error updating allowed workspaces of agent pool %s: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTFEAgentPoolRead(ctx, d, meta)
 }
 
-func resourceTFEAgentPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEAgentPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete agent pool: %s", d.Id())
 	err := tfeClient.AgentPools.Delete(ctx, d.Id())
@@ -113,7 +203,7 @@ func resourceTFEAgentPoolDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceTFEAgentPoolImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	s := strings.Split(d.Id(), "/")
 	if len(s) >= 3 {
@@ -124,7 +214,7 @@ func resourceTFEAgentPoolImporter(ctx context.Context, d *schema.ResourceData, m
 	} else if len(s) == 2 {
 		org := s[0]
 		poolName := s[1]
-		poolID, err := fetchAgentPoolID(org, poolName, tfeClient)
+		poolID, err := fetchAgentPoolID(ctx, org, poolName, tfeClient)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error retrieving agent pool with name %s from organization %s %w", poolName, org, err)
@@ -133,5 +223,8 @@ func resourceTFEAgentPoolImporter(ctx context.Context, d *schema.ResourceData, m
 		d.SetId(poolID)
 	}
 
+	// The update strategy only exists in the configuration.
+	d.Set("update_strategy", agentPoolUpdateImmediate)
+
 	return []*schema.ResourceData{d}, nil
 }
@@ -84,6 +84,53 @@ func TestAccTFEAgentPool_update(t *testing.T) {
 	})
 }
 
+func TestAccTFEAgentPool_scoping(t *testing.T) {
+	skipIfEnterprise(t)
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	agentPool := &tfe.AgentPool{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEAgentPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEAgentPool_basic(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEAgentPoolExists(
+						"tfe_agent_pool.foobar", agentPool),
+					resource.TestCheckResourceAttr(
+						"tfe_agent_pool.foobar", "organization_scoped", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_agent_pool.foobar", "allowed_workspace_ids.#", "0"),
+				),
+			},
+
+			{
+				Config: testAccTFEAgentPool_workspaceScoped(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEAgentPoolExists(
+						"tfe_agent_pool.foobar", agentPool),
+					resource.TestCheckResourceAttr(
+						"tfe_agent_pool.foobar", "organization_scoped", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_agent_pool.foobar", "allowed_workspace_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_agent_pool.foobar", "update_strategy", "drain"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEAgentPool_import(t *testing.T) {
 	skipIfEnterprise(t)
 
@@ -121,7 +168,7 @@ func TestAccTFEAgentPool_import(t *testing.T) {
 func testAccCheckTFEAgentPoolExists(
 	n string, agentPool *tfe.AgentPool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -168,7 +215,7 @@ func testAccCheckTFEAgentPoolAttributesUpdated(
 }
 
 func testAccCheckTFEAgentPoolDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_agent_pool" {
@@ -196,6 +243,22 @@ resource "tfe_agent_pool" "foobar" {
 }`, organization)
 }
 
+func testAccTFEAgentPool_workspaceScoped(organization string) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = "%s"
+}
+
+resource "tfe_agent_pool" "foobar" {
+  name                  = "agent-pool-test"
+  organization          = tfe_workspace.foobar.organization
+  organization_scoped   = false
+  allowed_workspace_ids = [tfe_workspace.foobar.id]
+  update_strategy       = "drain"
+}`, organization)
+}
+
 func testAccTFEAgentPool_update(organization string) string {
 	return fmt.Sprintf(`
 resource "tfe_agent_pool" "foobar" {
@@ -1,8 +1,10 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,9 +12,11 @@ import (
 
 func resourceTFEAgentToken() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEAgentTokenCreate,
-		Read:   resourceTFEAgentTokenRead,
-		Delete: resourceTFEAgentTokenDelete,
+		CreateContext: crudContext(resourceTFEAgentTokenCreate),
+		ReadContext:   crudContext(resourceTFEAgentTokenRead),
+		DeleteContext: crudContext(resourceTFEAgentTokenDelete),
+
+		CustomizeDiff: customizeDiffAgentTokenTTL,
 
 		Schema: map[string]*schema.Schema{
 			"agent_pool_id": {
@@ -30,12 +34,29 @@ func resourceTFEAgentToken() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_used_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func resourceTFEAgentTokenCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+// customizeDiffAgentTokenTTL rejects new agent tokens when the token_ttl of
+// the provider is set.
+func customizeDiffAgentTokenTTL(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	return validateAgentTokenTTL(meta.(ConfiguredClient).TokenTTL)
+}
+
+func resourceTFEAgentTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the agent pool ID
 	agentPoolID := d.Get("agent_pool_id").(string)
@@ -60,11 +81,11 @@ func resourceTFEAgentTokenCreate(d *schema.ResourceData, meta interface{}) error
 	// only be returned once during the creation of the token.
 	d.Set("token", agentToken.Token)
 
-	return resourceTFEAgentTokenRead(d, meta)
+	return resourceTFEAgentTokenRead(ctx, d, meta)
 }
 
-func resourceTFEAgentTokenRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEAgentTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read configuration of agent token: %s", d.Id())
 	agentToken, err := tfeClient.AgentTokens.Read(ctx, d.Id())
@@ -79,12 +100,20 @@ func resourceTFEAgentTokenRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Update the config
 	d.Set("description", agentToken.Description)
+	d.Set("created_at", agentToken.CreatedAt.Format(time.RFC3339))
+
+	// The token has not been used when no agent connected with it yet.
+	var lastUsedAt string
+	if !agentToken.LastUsedAt.IsZero() {
+		lastUsedAt = agentToken.LastUsedAt.Format(time.RFC3339)
+	}
+	d.Set("last_used_at", lastUsedAt)
 
 	return nil
 }
 
-func resourceTFEAgentTokenDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEAgentTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete agent token: %s", d.Id())
 	err := tfeClient.AgentTokens.Delete(ctx, d.Id())
@@ -35,6 +35,12 @@ func TestAccTFEAgentToken_basic(t *testing.T) {
 					testAccCheckTFEAgentTokenAttributes(agentToken),
 					resource.TestCheckResourceAttr(
 						"tfe_agent_token.foobar", "description", "agent-token-test"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_agent_token.foobar", "token"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_agent_token.foobar", "created_at"),
+					resource.TestCheckResourceAttr(
+						"tfe_agent_token.foobar", "last_used_at", ""),
 				),
 			},
 		},
@@ -44,7 +50,7 @@ func TestAccTFEAgentToken_basic(t *testing.T) {
 func testAccCheckTFEAgentTokenExists(
 	n string, agentToken *tfe.AgentToken) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -81,7 +87,7 @@ func testAccCheckTFEAgentTokenAttributes(
 }
 
 func testAccCheckTFEAgentTokenDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_agent_token" {
@@ -0,0 +1,187 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFEAPIDrivenRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEAPIDrivenRunCreate),
+		ReadContext:   crudContext(resourceTFEAPIDrivenRunRead),
+		DeleteContext: crudContext(resourceTFEAPIDrivenRunDelete),
+
+		CustomizeDiff: setAPIDrivenRunSourceChecksum,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"source_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Queued by Terraform",
+			},
+
+			"plan_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"is_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"apply": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       false,
+				ConflictsWith: []string{"plan_only"},
+			},
+
+			"wait_for_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"source_checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"configuration_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// setAPIDrivenRunSourceChecksum makes sure a new run is queued whenever the
+// configuration in the source path changes.
+func setAPIDrivenRunSourceChecksum(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_path") {
+		return d.SetNewComputed("source_checksum")
+	}
+
+	checksum, err := hashPolicies(d.Get("source_path").(string))
+	if err != nil {
+		return fmt.Errorf("Error generating the checksum for the source path files: %w", err)
+	}
+
+	if d.Get("source_checksum").(string) != checksum {
+		if err := d.SetNew("source_checksum", checksum); err != nil {
+			return fmt.Errorf("failed to set source_checksum: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceTFEAPIDrivenRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+	planOnly := d.Get("plan_only").(bool)
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	cv, err := uploadConfigurationVersion(ctx, tfeClient, workspaceID, d.Get("source_path").(string), planOnly, timeout)
+	if err != nil {
+		return err
+	}
+	d.Set("configuration_version_id", cv.ID)
+
+	options := tfe.RunCreateOptions{
+		Workspace:            &tfe.Workspace{ID: workspaceID},
+		ConfigurationVersion: cv,
+		Message:              tfe.String(d.Get("message").(string)),
+		IsDestroy:            tfe.Bool(d.Get("is_destroy").(bool)),
+	}
+
+	// Plan only runs are only supported by newer releases, so the attribute
+	// is only sent when set.
+	if planOnly {
+		options.PlanOnly = tfe.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Create run for workspace: %s", workspaceID)
+	run, err := tfeClient.Runs.Create(ctx, options)
+	if err != nil {
+		return fmt.Errorf("Error creating run for workspace %s: %w", workspaceID, err)
+	}
+
+	d.SetId(run.ID)
+
+	if d.Get("wait_for_run").(bool) {
+		if _, err := waitForRun(ctx, tfeClient, run.ID, d.Get("apply").(bool), d.Get("apply").(bool), timeout); err != nil {
+			return err
+		}
+	}
+
+	return resourceTFEAPIDrivenRunRead(ctx, d, meta)
+}
+
+func resourceTFEAPIDrivenRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read run: %s", d.Id())
+	run, err := tfeClient.Runs.Read(ctx, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Run %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading run %s: %w", d.Id(), err)
+	}
+
+	d.Set("status", string(run.Status))
+
+	return nil
+}
+
+// Runs can not be deleted, so deleting the resource only removes it from the
+// state.
+func resourceTFEAPIDrivenRunDelete(_ context.Context, d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Remove run %s from state", d.Id())
+
+	return nil
+}
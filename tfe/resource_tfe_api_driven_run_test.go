@@ -0,0 +1,78 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEAPIDrivenRun_planOnly(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEAPIDrivenRun_planOnly(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEAPIDrivenRunExists("tfe_api_driven_run.foobar"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_api_driven_run.foobar", "configuration_version_id"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_api_driven_run.foobar", "source_checksum"),
+					resource.TestCheckResourceAttr(
+						"tfe_api_driven_run.foobar", "status", "planned_and_finished"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTFEAPIDrivenRunExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		run, err := tfeClient.Runs.Read(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if run.ConfigurationVersion == nil || run.ConfigurationVersion.ID != rs.Primary.Attributes["configuration_version_id"] {
+			return fmt.Errorf("Run %s does not use the uploaded configuration version", run.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTFEAPIDrivenRun_planOnly(organization string) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = "%s"
+}
+
+resource "tfe_api_driven_run" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  source_path  = "test-fixtures/config-version"
+  plan_only    = true
+}`, organization)
+}
@@ -0,0 +1,158 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFEAuditTrailToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEAuditTrailTokenCreate),
+		ReadContext:   crudContext(resourceTFEAuditTrailTokenRead),
+		DeleteContext: crudContext(resourceTFEAuditTrailTokenDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFEAuditTrailTokenImporter,
+		},
+
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultOrganization,
+			customizeDiffTokenExpiration,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"force_regenerate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"expired_at": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceTFEAuditTrailTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// Get the organization name.
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Check if an audit trail token already exists for organization: %s", organization)
+	_, err = readAuditTrailToken(ctx, tfeClient, organization)
+	if err != nil && !isErrResourceNotFound(err) {
+		return fmt.Errorf("Error checking if an audit trail token exists for organization %s: %w", organization, err)
+	}
+
+	// If error is nil, the token already exists.
+	if err == nil {
+		if !d.Get("force_regenerate").(bool) {
+			return fmt.Errorf("An audit trail token already exists for organization: %s", organization)
+		}
+		log.Printf("[DEBUG] Regenerating existing audit trail token for organization: %s", organization)
+	}
+
+	options := tfe.OrganizationTokenCreateOptions{}
+
+	// Set the expiration date of the token if one is configured.
+	if v, ok := d.GetOk("expired_at"); ok {
+		expiredAt, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing expired_at %s: %w", v.(string), err)
+		}
+		options.ExpiredAt = &expiredAt
+	}
+
+	token, err := createAuditTrailToken(ctx, tfeClient, organization, options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error creating new audit trail token for organization %s: %w", organization, err)
+	}
+
+	d.SetId(organization)
+
+	// We need to set this here in the create function as this value will
+	// only be returned once during the creation of the token.
+	d.Set("token", token.Token)
+
+	return resourceTFEAuditTrailTokenRead(ctx, d, meta)
+}
+
+func resourceTFEAuditTrailTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read the audit trail token from organization: %s", d.Id())
+	token, err := readAuditTrailToken(ctx, tfeClient, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Audit trail token for organization %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading audit trail token from organization %s: %w", d.Id(), err)
+	}
+
+	if !token.CreatedAt.IsZero() {
+		d.Set("created_at", token.CreatedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceTFEAuditTrailTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// Get the organization name.
+	organization := d.Get("organization").(string)
+
+	log.Printf("[DEBUG] Delete audit trail token from organization: %s", organization)
+	err := deleteAuditTrailToken(ctx, tfeClient, organization)
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting audit trail token from organization %s: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceTFEAuditTrailTokenImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Set the organization field.
+	d.Set("organization", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
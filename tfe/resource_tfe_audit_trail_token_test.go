@@ -0,0 +1,74 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEAuditTrailToken_basic(t *testing.T) {
+	skipIfEnterprise(t)
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEAuditTrailTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEAuditTrailToken_basic(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_audit_trail_token.foobar", "organization", org.Name),
+					resource.TestCheckResourceAttrSet(
+						"tfe_audit_trail_token.foobar", "token"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_audit_trail_token.foobar", "created_at"),
+				),
+			},
+			{
+				ResourceName:            "tfe_audit_trail_token.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func testAccCheckTFEAuditTrailTokenDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_audit_trail_token" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := readAuditTrailToken(ctx, tfeClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Audit trail token %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEAuditTrailToken_basic(organization string) string {
+	return fmt.Sprintf(`
+resource "tfe_audit_trail_token" "foobar" {
+  organization = "%s"
+}`, organization)
+}
@@ -0,0 +1,205 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFEDataRetentionPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEDataRetentionPolicyCreate),
+		ReadContext:   crudContext(resourceTFEDataRetentionPolicyRead),
+		UpdateContext: crudContext(resourceTFEDataRetentionPolicyUpdate),
+		DeleteContext: crudContext(resourceTFEDataRetentionPolicyDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFEDataRetentionPolicyImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"workspace_id"},
+			},
+
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"delete_older_than": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"delete_older_than", "dont_delete"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"dont_delete": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ExactlyOneOf: []string{"delete_older_than", "dont_delete"},
+				ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+					if !v.(bool) {
+						return nil, []error{fmt.Errorf("%s can only be set to true, remove it to delete older data instead", k)}
+					}
+					return nil, nil
+				},
+			},
+		},
+	}
+}
+
+// expandDataRetentionPolicyDays returns the number of days after which data
+// is deleted, or zero if data is never deleted.
+func expandDataRetentionPolicyDays(d *schema.ResourceData) int {
+	if v, ok := d.GetOk("delete_older_than"); ok {
+		if blocks := v.([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+			return blocks[0].(map[string]interface{})["days"].(int)
+		}
+	}
+	return 0
+}
+
+func resourceTFEDataRetentionPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(ConfiguredClient)
+
+	// A policy of a workspace is identified by its workspace only.
+	organization := ""
+	workspaceID := d.Get("workspace_id").(string)
+	if workspaceID == "" {
+		var err error
+		organization, err = config.organizationName(d)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Set data retention policy of %s", dataRetentionPolicyPath(organization, workspaceID))
+	policy, err := setDataRetentionPolicy(ctx, config.Client, organization, workspaceID, expandDataRetentionPolicyDays(d))
+	if err != nil {
+		return fmt.Errorf("Error setting data retention policy: %w", err)
+	}
+
+	d.SetId(policy.ID)
+	d.Set("organization", organization)
+
+	return resourceTFEDataRetentionPolicyRead(ctx, d, meta)
+}
+
+func resourceTFEDataRetentionPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(ConfiguredClient)
+
+	organization := d.Get("organization").(string)
+	workspaceID := d.Get("workspace_id").(string)
+
+	if workspaceID != "" {
+		log.Printf("[DEBUG] Read workspace: %s", workspaceID)
+		workspace, err := config.Client.Workspaces.ReadByID(ctx, workspaceID)
+		if err != nil {
+			if errors.Is(err, tfe.ErrResourceNotFound) {
+				log.Printf("[DEBUG] Workspace %s no longer exists", workspaceID)
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("Error reading workspace %s: %w", workspaceID, err)
+		}
+		if workspace.Organization != nil {
+			organization = workspace.Organization.Name
+		}
+	}
+
+	log.Printf("[DEBUG] Read data retention policy: %s", d.Id())
+	policy, err := readDataRetentionPolicy(ctx, config.Client, organization, workspaceID)
+	if err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			log.Printf("[DEBUG] Data retention policy %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading data retention policy %s: %w", d.Id(), err)
+	}
+
+	d.SetId(policy.ID)
+	d.Set("organization", organization)
+
+	if policy.Type == dataRetentionPolicyDontDeleteType {
+		d.Set("delete_older_than", nil)
+		d.Set("dont_delete", true)
+	} else {
+		d.Set("delete_older_than", []interface{}{map[string]interface{}{"days": policy.DeleteOlderThanNDays}})
+		d.Set("dont_delete", nil)
+	}
+
+	return nil
+}
+
+func resourceTFEDataRetentionPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(ConfiguredClient)
+
+	organization := d.Get("organization").(string)
+	workspaceID := d.Get("workspace_id").(string)
+
+	// Setting a policy replaces the existing one.
+	log.Printf("[DEBUG] Update data retention policy: %s", d.Id())
+	policy, err := setDataRetentionPolicy(ctx, config.Client, organization, workspaceID, expandDataRetentionPolicyDays(d))
+	if err != nil {
+		return fmt.Errorf("Error updating data retention policy %s: %w", d.Id(), err)
+	}
+
+	d.SetId(policy.ID)
+
+	return resourceTFEDataRetentionPolicyRead(ctx, d, meta)
+}
+
+func resourceTFEDataRetentionPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(ConfiguredClient)
+
+	organization := d.Get("organization").(string)
+	workspaceID := d.Get("workspace_id").(string)
+
+	log.Printf("[DEBUG] Delete data retention policy: %s", d.Id())
+	err := deleteDataRetentionPolicy(ctx, config.Client, organization, workspaceID)
+	if err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting data retention policy %s: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceTFEDataRetentionPolicyImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The policy is imported by the workspace ID or the organization name it
+	// is set on.
+	if strings.HasPrefix(d.Id(), "ws-") {
+		d.Set("workspace_id", d.Id())
+	} else {
+		d.Set("organization", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
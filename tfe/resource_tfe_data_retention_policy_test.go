@@ -0,0 +1,197 @@
+package tfe
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFEDataRetentionPolicy_workspace(t *testing.T) {
+	skipIfCloud(t)
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEDataRetentionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEDataRetentionPolicy_workspace(rInt, `delete_older_than { days = 42 }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"tfe_data_retention_policy.foobar", "organization", "tfe_organization.foobar", "name"),
+					resource.TestCheckResourceAttr(
+						"tfe_data_retention_policy.foobar", "delete_older_than.0.days", "42"),
+					resource.TestCheckResourceAttr(
+						"tfe_data_retention_policy.foobar", "dont_delete", "false"),
+				),
+			},
+			{
+				Config: testAccTFEDataRetentionPolicy_workspace(rInt, `dont_delete = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_data_retention_policy.foobar", "delete_older_than.#", "0"),
+					resource.TestCheckResourceAttr(
+						"tfe_data_retention_policy.foobar", "dont_delete", "true"),
+				),
+			},
+			{
+				ResourceName: "tfe_data_retention_policy.foobar",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["tfe_workspace.foobar"].Primary.ID, nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTFEDataRetentionPolicy_organization(t *testing.T) {
+	skipIfCloud(t)
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEDataRetentionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEDataRetentionPolicy_organization(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_data_retention_policy.foobar", "organization", fmt.Sprintf("tst-terraform-%d", rInt)),
+					resource.TestCheckResourceAttr(
+						"tfe_data_retention_policy.foobar", "delete_older_than.0.days", "1138"),
+				),
+			},
+			{
+				ResourceName:      "tfe_data_retention_policy.foobar",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("tst-terraform-%d", rInt),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestDataRetentionPolicy(t *testing.T) {
+	var requests []string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, strings.TrimSpace(r.Method+" "+r.URL.Path+" "+string(b)))
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/organizations/my-org/relationships/data-retention-policy":
+			fmt.Fprint(w, `{"data":{"id":"drp-legacy","type":"data-retention-policies","attributes":{"delete-older-than-n-days":30}}}`)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/workspaces/ws-none/relationships/data-retention-policy":
+			fmt.Fprint(w, `{"data":null}`)
+		case r.Method == "POST" && r.URL.Path == "/api/v2/workspaces/ws-123/relationships/data-retention-policy":
+			if strings.Contains(string(b), dataRetentionPolicyDontDeleteType) {
+				fmt.Fprint(w, `{"data":{"id":"drp-keep","type":"data-retention-policy-dont-deletes"}}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":{"id":"drp-delete","type":"data-retention-policy-delete-olders","attributes":{"delete-older-than-n-days":42}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	// Legacy policies always delete older data.
+	policy, err := readDataRetentionPolicy(ctx, client, "my-org", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policy.ID != "drp-legacy" || policy.Type != dataRetentionPolicyDeleteOlderType || policy.DeleteOlderThanNDays != 30 {
+		t.Fatalf("unexpected legacy policy: %+v", policy)
+	}
+
+	if _, err := readDataRetentionPolicy(ctx, client, "my-org", "ws-none"); !errors.Is(err, tfe.ErrResourceNotFound) {
+		t.Fatalf("expected a not found error without a policy, got %v", err)
+	}
+
+	policy, err = setDataRetentionPolicy(ctx, client, "", "ws-123", 42)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policy.ID != "drp-delete" || policy.DeleteOlderThanNDays != 42 {
+		t.Fatalf("unexpected delete older policy: %+v", policy)
+	}
+
+	policy, err = setDataRetentionPolicy(ctx, client, "", "ws-123", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policy.ID != "drp-keep" || policy.Type != dataRetentionPolicyDontDeleteType {
+		t.Fatalf("unexpected don't delete policy: %+v", policy)
+	}
+
+	want := `{"data":{"type":"data-retention-policy-delete-olders","attributes":{"delete-older-than-n-days":42}}}`
+	if got := strings.TrimPrefix(requests[2], "POST /api/v2/workspaces/ws-123/relationships/data-retention-policy "); got != want {
+		t.Fatalf("wrong request body\ngot: %s\nwant: %s", got, want)
+	}
+}
+
+func testAccCheckTFEDataRetentionPolicyDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_data_retention_policy" {
+			continue
+		}
+
+		_, err := readDataRetentionPolicy(ctx, tfeClient, rs.Primary.Attributes["organization"], rs.Primary.Attributes["workspace_id"])
+		if err == nil {
+			return fmt.Errorf("Data retention policy %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEDataRetentionPolicy_workspace(rInt int, policy string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_data_retention_policy" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  %s
+}`, rInt, policy)
+}
+
+func testAccTFEDataRetentionPolicy_organization(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_data_retention_policy" "foobar" {
+  organization = tfe_organization.foobar.name
+
+  delete_older_than {
+    days = 1138
+  }
+}`, rInt)
+}
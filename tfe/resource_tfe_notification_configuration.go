@@ -1,24 +1,31 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTFENotificationConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFENotificationConfigurationCreate,
-		Read:   resourceTFENotificationConfigurationRead,
-		Update: resourceTFENotificationConfigurationUpdate,
-		Delete: resourceTFENotificationConfigurationDelete,
+		CreateContext: resourceTFENotificationConfigurationCreateContext,
+		ReadContext:   crudContext(resourceTFENotificationConfigurationRead),
+		UpdateContext: resourceTFENotificationConfigurationUpdateContext,
+		DeleteContext: crudContext(resourceTFENotificationConfigurationDelete),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceTFENotificationConfigurationImporter,
 		},
 
+		CustomizeDiff: validateNotificationConfigurationDestination,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -45,7 +52,7 @@ func resourceTFENotificationConfiguration() *schema.Resource {
 				Optional:      true,
 				Computed:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
-				ConflictsWith: []string{"token", "url"},
+				ConflictsWith: []string{"token", "token_wo", "url"},
 			},
 
 			"email_user_ids": {
@@ -53,7 +60,7 @@ func resourceTFENotificationConfiguration() *schema.Resource {
 				Optional:      true,
 				Computed:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
-				ConflictsWith: []string{"token", "url"},
+				ConflictsWith: []string{"token", "token_wo", "url"},
 			},
 
 			"enabled": {
@@ -62,30 +69,48 @@ func resourceTFENotificationConfiguration() *schema.Resource {
 				Default:  false,
 			},
 
+			"verify": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  notificationVerifyNone,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						notificationVerifyNone,
+						notificationVerifyWarn,
+						notificationVerifyError,
+					},
+					false,
+				),
+			},
+
 			"token": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"token_wo"},
+			},
+
+			"token_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"token"},
+				RequiredWith:  []string{"token_wo_version"},
+			},
+
+			"token_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"token_wo"},
 			},
 
 			"triggers": {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
-					ValidateFunc: validation.StringInSlice(
-						[]string{
-							string(tfe.NotificationTriggerCreated),
-							string(tfe.NotificationTriggerPlanning),
-							string(tfe.NotificationTriggerNeedsAttention),
-							string(tfe.NotificationTriggerApplying),
-							string(tfe.NotificationTriggerCompleted),
-							string(tfe.NotificationTriggerErrored),
-							string(tfe.NotificationTriggerAssessmentDrifted),
-							string(tfe.NotificationTriggerAssessmentFailed),
-						},
-						false,
-					),
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateNotificationTrigger,
 				},
 			},
 
@@ -100,12 +125,48 @@ func resourceTFENotificationConfiguration() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"last_delivery_response": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"successful": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"sent_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
-func resourceTFENotificationConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFENotificationConfigurationCreateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceTFENotificationConfigurationCreate(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return verifyNotificationConfigurationDiagnostics(ctx, d, meta)
+}
+
+func resourceTFENotificationConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get workspace
 	workspaceID := d.Get("workspace_id").(string)
@@ -114,42 +175,11 @@ func resourceTFENotificationConfigurationCreate(d *schema.ResourceData, meta int
 	destinationType := tfe.NotificationDestinationType(d.Get("destination_type").(string))
 	enabled := d.Get("enabled").(bool)
 	name := d.Get("name").(string)
-	token := d.Get("token").(string)
-	url := d.Get("url").(string)
-
-	// Make sure only the correct schema attributes are set
-	if destinationType == tfe.NotificationDestinationTypeEmail {
-		// When destination_type is 'email':
-		// 1. url and token cannot be set
-		err := validateSchemaAttributesForDestinationTypeEmail(d)
-		if err != nil {
-			return err
-		}
-	} else if destinationType == tfe.NotificationDestinationTypeGeneric {
-		// When destination_type is 'generic':
-		// 1. email_addresses and email_user_ids cannot be set
-		// 2. url must be set
-		err := validateSchemaAttributesForDestinationTypeGeneric(d)
-		if err != nil {
-			return err
-		}
-	} else if destinationType == tfe.NotificationDestinationTypeSlack {
-		// When destination_type is 'slack':
-		// 1. email_addresses, email_user_ids, and token cannot be set
-		// 2. url must be set
-		err := validateSchemaAttributesForDestinationTypeSlack(d)
-		if err != nil {
-			return err
-		}
-	} else if destinationType == tfe.NotificationDestinationTypeMicrosoftTeams {
-		// When destination_type is 'microsoft-teams':
-		// 1. email_addresses, email_user_ids, and token cannot be set
-		// 2. url must be set
-		err := validateSchemaAttributesForDestinationTypeMicrosoftTeams(d)
-		if err != nil {
-			return err
-		}
+	token, err := valueOrWriteOnly(d, "token")
+	if err != nil {
+		return err
 	}
+	url := d.Get("url").(string)
 
 	// Create a new options struct
 	options := tfe.NotificationConfigurationCreateOptions{
@@ -160,10 +190,11 @@ func resourceTFENotificationConfigurationCreate(d *schema.ResourceData, meta int
 		URL:             tfe.String(url),
 	}
 
-	// Add triggers set to the options struct
-	for _, trigger := range d.Get("triggers").(*schema.Set).List() {
-		options.Triggers = append(options.Triggers, tfe.NotificationTriggerType(trigger.(string)))
-	}
+	// Add triggers set to the options struct. Triggers which go-tfe does
+	// not know about are set afterwards.
+	triggers := d.Get("triggers").(*schema.Set).List()
+	var setTriggers bool
+	options.Triggers, setTriggers = expandNotificationTriggers(triggers)
 
 	// Add email_addresses set to the options struct
 	if emailAddresses, ok := d.GetOk("email_addresses"); ok {
@@ -187,11 +218,18 @@ func resourceTFENotificationConfigurationCreate(d *schema.ResourceData, meta int
 
 	d.SetId(notificationConfiguration.ID)
 
-	return resourceTFENotificationConfigurationRead(d, meta)
+	if setTriggers {
+		log.Printf("[DEBUG] Update triggers of notification configuration: %s", d.Id())
+		if err := updateNotificationConfigurationTriggers(ctx, tfeClient, d.Id(), triggers); err != nil {
+			return fmt.Errorf("Error updating triggers of notification configuration %s: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTFENotificationConfigurationRead(ctx, d, meta)
 }
 
-func resourceTFENotificationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFENotificationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read notification configuration: %s", d.Id())
 	notificationConfiguration, err := tfeClient.NotificationConfigurations.Read(ctx, d.Id())
@@ -232,12 +270,55 @@ func resourceTFENotificationConfigurationRead(d *schema.ResourceData, meta inter
 	}
 
 	d.Set("workspace_id", notificationConfiguration.Subscribable.ID)
+	d.Set("last_delivery_response", flattenLastDeliveryResponse(notificationConfiguration.DeliveryResponses))
 
 	return nil
 }
 
-func resourceTFENotificationConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+// flattenLastDeliveryResponse returns the most recently sent delivery response,
+// if the notification configuration has sent any notifications yet.
+func flattenLastDeliveryResponse(deliveryResponses []*tfe.DeliveryResponse) []interface{} {
+	var last *tfe.DeliveryResponse
+	for _, dr := range deliveryResponses {
+		if dr == nil {
+			continue
+		}
+		if last == nil || dr.SentAt.After(last.SentAt) {
+			last = dr
+		}
+	}
+
+	if last == nil {
+		return nil
+	}
+
+	successful, _ := strconv.ParseBool(last.Successful)
+
+	return []interface{}{
+		map[string]interface{}{
+			"code":       last.Code,
+			"successful": successful,
+			"sent_at":    last.SentAt.Format(time.RFC3339),
+			"url":        last.URL,
+		},
+	}
+}
+
+func resourceTFENotificationConfigurationUpdateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceTFENotificationConfigurationUpdate(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only verify the destination again when it could have changed.
+	if !d.HasChanges("url", "token", "token_wo_version", "enabled", "verify") {
+		return nil
+	}
+
+	return verifyNotificationConfigurationDiagnostics(ctx, d, meta)
+}
+
+func resourceTFENotificationConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get attributes
 	enabled := d.Get("enabled").(bool)
@@ -245,41 +326,6 @@ func resourceTFENotificationConfigurationUpdate(d *schema.ResourceData, meta int
 	token := d.Get("token").(string)
 	url := d.Get("url").(string)
 
-	// Make sure only the correct schema attributes are set
-	destinationType := tfe.NotificationDestinationType(d.Get("destination_type").(string))
-	if destinationType == tfe.NotificationDestinationTypeEmail {
-		// When destination_type is 'email':
-		// 1. url and token cannot be set
-		err := validateSchemaAttributesForDestinationTypeEmail(d)
-		if err != nil {
-			return err
-		}
-	} else if destinationType == tfe.NotificationDestinationTypeGeneric {
-		// When destination_type is 'generic':
-		// 1. email_addresses and email_user_ids cannot be set
-		// 2. url must be set
-		err := validateSchemaAttributesForDestinationTypeGeneric(d)
-		if err != nil {
-			return err
-		}
-	} else if destinationType == tfe.NotificationDestinationTypeSlack {
-		// When destination_type is 'slack':
-		// 1. email_addresses, email_user_ids, and token cannot be set
-		// 2. url must be set
-		err := validateSchemaAttributesForDestinationTypeSlack(d)
-		if err != nil {
-			return err
-		}
-	} else if destinationType == tfe.NotificationDestinationTypeMicrosoftTeams {
-		// When destination_type is 'microsoft-teams':
-		// 1. email_addresses, email_user_ids, and token cannot be set
-		// 2. url must be set
-		err := validateSchemaAttributesForDestinationTypeMicrosoftTeams(d)
-		if err != nil {
-			return err
-		}
-	}
-
 	// Create a new options struct
 	options := tfe.NotificationConfigurationUpdateOptions{
 		Enabled: tfe.Bool(enabled),
@@ -288,11 +334,25 @@ func resourceTFENotificationConfigurationUpdate(d *schema.ResourceData, meta int
 		URL:     tfe.String(url),
 	}
 
-	// Add triggers set to the options struct
-	for _, trigger := range d.Get("triggers").(*schema.Set).List() {
-		options.Triggers = append(options.Triggers, tfe.NotificationTriggerType(trigger.(string)))
+	// The write-only token_wo is only sent when token_wo_version changes,
+	// otherwise the API keeps the token.
+	if _, ok := d.GetOkExists("token_wo_version"); ok {
+		options.Token = nil
+		if d.HasChange("token_wo_version") {
+			v, err := writeOnlyValue(d, "token_wo")
+			if err != nil {
+				return err
+			}
+			options.Token = tfe.String(v)
+		}
 	}
 
+	// Add triggers set to the options struct. Triggers which go-tfe does
+	// not know about are set afterwards.
+	triggers := d.Get("triggers").(*schema.Set).List()
+	var setTriggers bool
+	options.Triggers, setTriggers = expandNotificationTriggers(triggers)
+
 	// Add email_addresses set to the options struct
 	if emailAddresses, ok := d.GetOk("email_addresses"); ok {
 		for _, emailAddress := range emailAddresses.(*schema.Set).List() {
@@ -313,11 +373,20 @@ func resourceTFENotificationConfigurationUpdate(d *schema.ResourceData, meta int
 		return fmt.Errorf("Error updating notification configuration %s: %w", d.Id(), err)
 	}
 
-	return resourceTFENotificationConfigurationRead(d, meta)
+	// Removing all triggers is not sent by go-tfe either, as empty triggers
+	// are omitted.
+	if setTriggers || (d.HasChange("triggers") && len(triggers) == 0) {
+		log.Printf("[DEBUG] Update triggers of notification configuration: %s", d.Id())
+		if err := updateNotificationConfigurationTriggers(ctx, tfeClient, d.Id(), triggers); err != nil {
+			return fmt.Errorf("Error updating triggers of notification configuration %s: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTFENotificationConfigurationRead(ctx, d, meta)
 }
 
-func resourceTFENotificationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFENotificationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete notification configuration: %s", d.Id())
 	err := tfeClient.NotificationConfigurations.Delete(ctx, d.Id())
@@ -331,22 +400,106 @@ func resourceTFENotificationConfigurationDelete(d *schema.ResourceData, meta int
 	return nil
 }
 
+func resourceTFENotificationConfigurationImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// Verification is not part of the configuration stored by the API.
+	d.Set("verify", notificationVerifyNone)
+
+	// Notification configurations can be imported by their ID.
+	if !strings.Contains(d.Id(), "/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	s := strings.SplitN(d.Id(), "/", 3)
+	if len(s) != 3 || s[2] == "" {
+		return nil, fmt.Errorf(
+			"invalid notification configuration import format: %s (expected <NOTIFICATION CONFIGURATION ID> or <ORGANIZATION>/<WORKSPACE>/<NOTIFICATION CONFIGURATION NAME>)",
+			d.Id(),
+		)
+	}
+
+	workspaceID, err := fetchWorkspaceExternalID(ctx, s[0]+"/"+s[1], tfeClient)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving workspace %s from organization %s: %w", s[1], s[0], err)
+	}
+
+	configs, err := listWorkspaceNotificationConfigurations(ctx, tfeClient, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refuse to guess when more than one configuration has the name.
+	var ids []string
+	for _, nc := range findNotificationConfigurationsByName(configs, s[2]) {
+		ids = append(ids, nc.ID)
+	}
+
+	switch len(ids) {
+	case 0:
+		return nil, fmt.Errorf("no notification configuration named %s found in workspace %s/%s", s[2], s[0], s[1])
+	case 1:
+		d.SetId(ids[0])
+		return []*schema.ResourceData{d}, nil
+	default:
+		return nil, fmt.Errorf(
+			"found %d notification configurations named %s in workspace %s/%s (%s), import one of them by ID",
+			len(ids), s[2], s[0], s[1], strings.Join(ids, ", "))
+	}
+}
+
 // Custom CustomizeDiff functions and helpers
-func validateSchemaAttributesForDestinationTypeEmail(d *schema.ResourceData) error {
+
+// validateNotificationConfigurationDestination makes sure only the attributes
+// which fit the destination type are set, so that invalid combinations are
+// caught at plan time instead of after a partial apply.
+func validateNotificationConfigurationDestination(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("destination_type") {
+		return nil
+	}
+
+	switch tfe.NotificationDestinationType(d.Get("destination_type").(string)) {
+	case tfe.NotificationDestinationTypeEmail:
+		// When destination_type is 'email':
+		// 1. url and token cannot be set
+		return validateSchemaAttributesForDestinationTypeEmail(d)
+	case tfe.NotificationDestinationTypeGeneric:
+		// When destination_type is 'generic':
+		// 1. email_addresses and email_user_ids cannot be set
+		// 2. url must be set
+		return validateSchemaAttributesForDestinationTypeGeneric(d)
+	case tfe.NotificationDestinationTypeSlack:
+		// When destination_type is 'slack':
+		// 1. email_addresses, email_user_ids, and token cannot be set
+		// 2. url must be set
+		return validateSchemaAttributesForDestinationTypeSlack(d)
+	case tfe.NotificationDestinationTypeMicrosoftTeams:
+		// When destination_type is 'microsoft-teams':
+		// 1. email_addresses, email_user_ids, and token cannot be set
+		// 2. url must be set
+		return validateSchemaAttributesForDestinationTypeMicrosoftTeams(d)
+	}
+
+	return nil
+}
+
+func validateSchemaAttributesForDestinationTypeEmail(d *schema.ResourceDiff) error {
 	// Make sure url and token are not set when destination_type is 'email'
 	_, urlIsSet := d.GetOk("url")
 	if urlIsSet {
 		return fmt.Errorf("URL cannot be set with destination type of %s", string(tfe.NotificationDestinationTypeEmail))
 	}
 	token, tokenIsSet := d.GetOk("token")
-	if tokenIsSet && token != "" {
+	tokenWOIsSet := writeOnlyConfigured(d, "token_wo")
+	if (tokenIsSet && token != "") || tokenWOIsSet {
 		return fmt.Errorf("Token cannot be set with destination type of %s", string(tfe.NotificationDestinationTypeEmail))
 	}
 
 	return nil
 }
 
-func validateSchemaAttributesForDestinationTypeGeneric(d *schema.ResourceData) error {
+func validateSchemaAttributesForDestinationTypeGeneric(d *schema.ResourceDiff) error {
 	// Make sure email_addresses and email_user_ids are not set when destination_type is 'generic'
 	_, emailAddressesIsSet := d.GetOk("email_addresses")
 	if emailAddressesIsSet {
@@ -359,14 +512,14 @@ func validateSchemaAttributesForDestinationTypeGeneric(d *schema.ResourceData) e
 
 	// Make sure url is set when destination_type is 'generic'
 	_, urlIsSet := d.GetOk("url")
-	if !urlIsSet {
+	if !urlIsSet && d.NewValueKnown("url") {
 		return fmt.Errorf("URL is required with destination type of %s", string(tfe.NotificationDestinationTypeGeneric))
 	}
 
 	return nil
 }
 
-func validateSchemaAttributesForDestinationTypeSlack(d *schema.ResourceData) error {
+func validateSchemaAttributesForDestinationTypeSlack(d *schema.ResourceDiff) error {
 	// Make sure email_addresses, email_user_ids, and token are not set when destination_type is 'slack'
 	_, emailAddressesIsSet := d.GetOk("email_addresses")
 	if emailAddressesIsSet {
@@ -377,20 +530,21 @@ func validateSchemaAttributesForDestinationTypeSlack(d *schema.ResourceData) err
 		return fmt.Errorf("Email user IDs cannot be set with destination type of %s", string(tfe.NotificationDestinationTypeSlack))
 	}
 	token, tokenIsSet := d.GetOk("token")
-	if tokenIsSet && token != "" {
+	tokenWOIsSet := writeOnlyConfigured(d, "token_wo")
+	if (tokenIsSet && token != "") || tokenWOIsSet {
 		return fmt.Errorf("Token cannot be set with destination type of %s", string(tfe.NotificationDestinationTypeSlack))
 	}
 
 	// Make sure url is set when destination_type is 'slack'
 	_, urlIsSet := d.GetOk("url")
-	if !urlIsSet {
+	if !urlIsSet && d.NewValueKnown("url") {
 		return fmt.Errorf("URL is required with destination type of %s", string(tfe.NotificationDestinationTypeSlack))
 	}
 
 	return nil
 }
 
-func validateSchemaAttributesForDestinationTypeMicrosoftTeams(d *schema.ResourceData) error {
+func validateSchemaAttributesForDestinationTypeMicrosoftTeams(d *schema.ResourceDiff) error {
 	// Make sure email_addresses, email_user_ids, and token are not set when destination_type is 'microsoft-teams'
 	_, emailAddressesIsSet := d.GetOk("email_addresses")
 	if emailAddressesIsSet {
@@ -401,13 +555,14 @@ func validateSchemaAttributesForDestinationTypeMicrosoftTeams(d *schema.Resource
 		return fmt.Errorf("Email user IDs cannot be set with destination type of %s", string(tfe.NotificationDestinationTypeMicrosoftTeams))
 	}
 	token, tokenIsSet := d.GetOk("token")
-	if tokenIsSet && token != "" {
+	tokenWOIsSet := writeOnlyConfigured(d, "token_wo")
+	if (tokenIsSet && token != "") || tokenWOIsSet {
 		return fmt.Errorf("Token cannot be set with destination type of %s", string(tfe.NotificationDestinationTypeMicrosoftTeams))
 	}
 
 	// Make sure url is set when destination_type is 'microsoft-teams'
 	_, urlIsSet := d.GetOk("url")
-	if !urlIsSet {
+	if !urlIsSet && d.NewValueKnown("url") {
 		return fmt.Errorf("URL is required with destination type of %s", string(tfe.NotificationDestinationTypeMicrosoftTeams))
 	}
 
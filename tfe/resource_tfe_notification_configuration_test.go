@@ -3,6 +3,7 @@ package tfe
 import (
 	"fmt"
 	"math/rand"
+	"net/http"
 	"reflect"
 	"regexp"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
 )
 
 func TestAccTFENotificationConfiguration_basic(t *testing.T) {
@@ -38,6 +40,8 @@ func TestAccTFENotificationConfiguration_basic(t *testing.T) {
 						"tfe_notification_configuration.foobar", "triggers.#", "0"),
 					resource.TestCheckResourceAttr(
 						"tfe_notification_configuration.foobar", "url", "http://example.com"),
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "last_delivery_response.#", "0"),
 				),
 			},
 		},
@@ -478,6 +482,46 @@ func TestAccTFENotificationConfiguration_duplicateTriggers(t *testing.T) {
 	})
 }
 
+func TestAccTFENotificationConfiguration_newTriggers(t *testing.T) {
+	skipIfEnterprise(t)
+
+	notificationConfiguration := &tfe.NotificationConfiguration{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFENotificationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFENotificationConfiguration_triggers(rInt, `["assessment:check_failure", "workspace:auto_destroy_reminder", "workspace:auto_destroy_run_results"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFENotificationConfigurationExists(
+						"tfe_notification_configuration.foobar", notificationConfiguration),
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "triggers.#", "3"),
+					resource.TestCheckTypeSetElemAttr(
+						"tfe_notification_configuration.foobar", "triggers.*", "workspace:auto_destroy_reminder"),
+				),
+			},
+			{
+				Config: testAccTFENotificationConfiguration_triggers(rInt, `["run:errored"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "triggers.#", "1"),
+				),
+			},
+			{
+				Config: testAccTFENotificationConfiguration_triggers(rInt, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_notification_configuration.foobar", "triggers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFENotificationConfigurationImport_basic(t *testing.T) {
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
 
@@ -500,6 +544,29 @@ func TestAccTFENotificationConfigurationImport_basic(t *testing.T) {
 	})
 }
 
+func TestAccTFENotificationConfigurationImport_byName(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFENotificationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFENotificationConfiguration_update(rInt),
+			},
+
+			{
+				ResourceName:            "tfe_notification_configuration.foobar",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("tst-terraform-%d/workspace-test/notification_update", rInt),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
 func TestAccTFENotificationConfigurationImport_emailUserIDs(t *testing.T) {
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
 
@@ -544,9 +611,185 @@ func TestAccTFENotificationConfigurationImport_emptyEmailUserIDs(t *testing.T) {
 	})
 }
 
+func TestFlattenLastDeliveryResponse(t *testing.T) {
+	sentAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	cases := map[string]struct {
+		responses []*tfe.DeliveryResponse
+		want      []interface{}
+	}{
+		"no responses": {
+			responses: nil,
+			want:      nil,
+		},
+		"most recent response": {
+			responses: []*tfe.DeliveryResponse{
+				{Code: "500", Successful: "false", SentAt: sentAt.Add(-time.Hour), URL: "http://example.com"},
+				{Code: "200", Successful: "true", SentAt: sentAt, URL: "http://example.com"},
+			},
+			want: []interface{}{
+				map[string]interface{}{
+					"code":       "200",
+					"successful": true,
+					"sent_at":    "2023-01-02T03:04:05Z",
+					"url":        "http://example.com",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := flattenLastDeliveryResponse(tc.responses)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("wrong result\ngot: %#v\nwant: %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateNotificationConfigurationDestination(t *testing.T) {
+	cases := map[string]struct {
+		config  map[string]interface{}
+		wantErr string
+	}{
+		"valid generic": {
+			config: map[string]interface{}{
+				"destination_type": "generic",
+				"url":              "http://example.com",
+			},
+		},
+		"generic without url": {
+			config: map[string]interface{}{
+				"destination_type": "generic",
+			},
+			wantErr: "URL is required with destination type of generic",
+		},
+		"email with url": {
+			config: map[string]interface{}{
+				"destination_type": "email",
+				"url":              "http://example.com",
+			},
+			wantErr: "URL cannot be set with destination type of email",
+		},
+		"slack with token": {
+			config: map[string]interface{}{
+				"destination_type": "slack",
+				"url":              "http://example.com",
+				"token":            "1234567890",
+			},
+			wantErr: "Token cannot be set with destination type of slack",
+		},
+		"microsoft-teams with email addresses": {
+			config: map[string]interface{}{
+				"destination_type": "microsoft-teams",
+				"url":              "http://example.com",
+				"email_addresses":  []interface{}{"test@example.com"},
+			},
+			wantErr: "Email addresses cannot be set with destination type of microsoft-teams",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.config["name"] = "notification"
+			tc.config["workspace_id"] = "ws-123"
+
+			_, err := resourceTFENotificationConfiguration().Diff(
+				ctx, nil, terraform.NewResourceConfigRaw(tc.config), nil)
+
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.wantErr {
+				t.Fatalf("expected error %q, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestTFENotificationConfigurationImporter(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/organizations/hashicorp/workspaces/a-workspace":
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"a-workspace"}}}`)
+		case "/api/v2/workspaces/ws-123/notification-configurations":
+			fmt.Fprint(w, `{"data":[
+				{"id":"nc-1","type":"notification-configurations","attributes":{"name":"slack"}},
+				{"id":"nc-2","type":"notification-configurations","attributes":{"name":"ops/alerts"}},
+				{"id":"nc-3","type":"notification-configurations","attributes":{"name":"email"}},
+				{"id":"nc-4","type":"notification-configurations","attributes":{"name":"email"}}
+			],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	cases := map[string]struct {
+		id      string
+		want    string
+		wantErr string
+	}{
+		"by ID": {
+			id:   "nc-1",
+			want: "nc-1",
+		},
+		"by name": {
+			id:   "hashicorp/a-workspace/slack",
+			want: "nc-1",
+		},
+		"name with a slash": {
+			id:   "hashicorp/a-workspace/ops/alerts",
+			want: "nc-2",
+		},
+		"unknown name": {
+			id:      "hashicorp/a-workspace/teams",
+			wantErr: "no notification configuration named teams found in workspace hashicorp/a-workspace",
+		},
+		"ambiguous name": {
+			id:      "hashicorp/a-workspace/email",
+			wantErr: "found 2 notification configurations named email in workspace hashicorp/a-workspace (nc-3, nc-4), import one of them by ID",
+		},
+		"unknown workspace": {
+			id:      "hashicorp/not-a-workspace/slack",
+			wantErr: "error retrieving workspace not-a-workspace from organization hashicorp: Error reading configuration of workspace hashicorp/not-a-workspace: resource not found",
+		},
+		"invalid format": {
+			id:      "hashicorp/a-workspace",
+			wantErr: "invalid notification configuration import format: hashicorp/a-workspace (expected <NOTIFICATION CONFIGURATION ID> or <ORGANIZATION>/<WORKSPACE>/<NOTIFICATION CONFIGURATION NAME>)",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := resourceTFENotificationConfiguration().TestResourceData()
+			d.SetId(tc.id)
+
+			_, err := resourceTFENotificationConfigurationImporter(ctx, d, ConfiguredClient{Client: client})
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("expected error %q, got: %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d.Id() != tc.want {
+				t.Fatalf("expected ID %s, got %s", tc.want, d.Id())
+			}
+		})
+	}
+}
+
 func testAccCheckTFENotificationConfigurationExists(n string, notificationConfiguration *tfe.NotificationConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -763,7 +1006,7 @@ func testAccCheckTFENotificationConfigurationAttributesDuplicateTriggers(notific
 }
 
 func testAccCheckTFENotificationConfigurationDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_notification_configuration" {
@@ -803,6 +1046,27 @@ resource "tfe_notification_configuration" "foobar" {
 }`, rInt)
 }
 
+func testAccTFENotificationConfiguration_triggers(rInt int, triggers string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_notification_configuration" "foobar" {
+  name             = "notification_triggers"
+  destination_type = "generic"
+  url              = "http://example.com"
+  triggers         = %s
+  workspace_id     = tfe_workspace.foobar.id
+}`, rInt, triggers)
+}
+
 func testAccTFENotificationConfiguration_emailUserIDs(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,9 +12,15 @@ import (
 
 func resourceTFEOAuthClient() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEOAuthClientCreate,
-		Read:   resourceTFEOAuthClientRead,
-		Delete: resourceTFEOAuthClientDelete,
+		CreateContext: crudContext(resourceTFEOAuthClientCreate),
+		ReadContext:   crudContext(resourceTFEOAuthClientRead),
+		UpdateContext: crudContext(resourceTFEOAuthClientUpdate),
+		DeleteContext: crudContext(resourceTFEOAuthClientDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFEOAuthClientImporter,
+		},
+
+		CustomizeDiff: customizeDiffDefaultOrganization,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -24,7 +31,8 @@ func resourceTFEOAuthClient() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -48,32 +56,90 @@ func resourceTFEOAuthClient() *schema.Resource {
 			},
 
 			"oauth_token": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
-				ForceNew:  true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ForceNew:      true,
+				ConflictsWith: []string{"oauth_token_wo"},
+			},
+
+			"oauth_token_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"oauth_token"},
+				RequiredWith:  []string{"oauth_token_wo_version"},
+			},
+
+			// Write-only arguments cannot force a new resource, so a new
+			// oauth_token_wo_version replaces the OAuth client instead.
+			"oauth_token_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"oauth_token_wo"},
 			},
 
 			"private_key": {
-				Type:      schema.TypeString,
-				ForceNew:  true,
-				Sensitive: true,
-				Optional:  true,
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Sensitive:     true,
+				Optional:      true,
+				ConflictsWith: []string{"private_key_wo"},
+			},
+
+			"private_key_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"private_key"},
+				RequiredWith:  []string{"private_key_wo_version"},
+			},
+
+			// Write-only arguments cannot force a new resource, so a new
+			// private_key_wo_version replaces the OAuth client instead.
+			"private_key_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"private_key_wo"},
 			},
 
 			"secret": {
-				Type:      schema.TypeString,
-				ForceNew:  true,
-				Sensitive: true,
-				Optional:  true,
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Sensitive:     true,
+				Optional:      true,
+				ConflictsWith: []string{"secret_wo"},
+			},
+
+			"secret_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"secret"},
+				RequiredWith:  []string{"secret_wo_version"},
+			},
+
+			// Write-only arguments cannot force a new resource, so a new
+			// secret_wo_version replaces the OAuth client instead.
+			"secret_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"secret_wo"},
 			},
 
 			"rsa_public_key": {
 				Type:     schema.TypeString,
 				ForceNew: true,
 				Optional: true,
-				// this field is only for BitBucket Server, and requires these other
-				RequiredWith: []string{"secret", "key"},
+				// this field is only for BitBucket Server, and requires these other.
+				// The secret may also be set with secret_wo, which is checked on create.
+				RequiredWith: []string{"key"},
 			},
 
 			"service_provider": {
@@ -100,25 +166,53 @@ func resourceTFEOAuthClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"organization_scoped": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"agent_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 		},
 	}
 }
 
-func resourceTFEOAuthClientCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOAuthClientCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the organization and provider.
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 	name := d.Get("name").(string)
-	privateKey := d.Get("private_key").(string)
+	privateKey, err := valueOrWriteOnly(d, "private_key")
+	if err != nil {
+		return err
+	}
 	rsaPublicKey := d.Get("rsa_public_key").(string)
 	key := d.Get("key").(string)
-	secret := d.Get("secret").(string)
+	secret, err := valueOrWriteOnly(d, "secret")
+	if err != nil {
+		return err
+	}
+	oauthToken, err := valueOrWriteOnly(d, "oauth_token")
+	if err != nil {
+		return err
+	}
 	serviceProvider := tfe.ServiceProviderType(d.Get("service_provider").(string))
 
 	if serviceProvider == tfe.ServiceProviderAzureDevOpsServer && privateKey == "" {
 		return fmt.Errorf("private_key is required for service_provider %s", serviceProvider)
 	}
+	if rsaPublicKey != "" && secret == "" {
+		return fmt.Errorf("secret or secret_wo is required with rsa_public_key")
+	}
 
 	// Create a new options struct.
 	// The tfe.OAuthClientCreateOptions has omitempty for these values, so if it
@@ -127,7 +221,7 @@ func resourceTFEOAuthClientCreate(d *schema.ResourceData, meta interface{}) erro
 		Name:            tfe.String(name),
 		APIURL:          tfe.String(d.Get("api_url").(string)),
 		HTTPURL:         tfe.String(d.Get("http_url").(string)),
-		OAuthToken:      tfe.String(d.Get("oauth_token").(string)),
+		OAuthToken:      tfe.String(oauthToken),
 		Key:             tfe.String(key),
 		ServiceProvider: tfe.ServiceProvider(serviceProvider),
 	}
@@ -143,8 +237,19 @@ func resourceTFEOAuthClientCreate(d *schema.ResourceData, meta interface{}) erro
 		options.Secret = tfe.String(secret)
 	}
 
+	// Only send organization_scoped when set, as not all releases of
+	// Terraform Enterprise support scoping OAuth clients to projects.
+	if organizationScoped, ok := d.GetOkExists("organization_scoped"); ok {
+		options.OrganizationScoped = tfe.Bool(organizationScoped.(bool))
+	}
+
 	log.Printf("[DEBUG] Create an OAuth client for organization: %s", organization)
-	oc, err := tfeClient.OAuthClients.Create(ctx, organization, options)
+	var oc *tfe.OAuthClient
+	if agentPoolID, ok := d.GetOk("agent_pool_id"); ok {
+		oc, err = createOAuthClientWithAgentPool(ctx, tfeClient, organization, options, agentPoolID.(string))
+	} else {
+		oc, err = tfeClient.OAuthClients.Create(ctx, organization, options)
+	}
 	if err != nil {
 		return fmt.Errorf(
 			"Error creating OAuth client for organization %s: %w", organization, err)
@@ -152,11 +257,11 @@ func resourceTFEOAuthClientCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(oc.ID)
 
-	return resourceTFEOAuthClientRead(d, meta)
+	return resourceTFEOAuthClientRead(ctx, d, meta)
 }
 
-func resourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOAuthClientRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read configuration of OAuth client: %s", d.Id())
 	oc, err := tfeClient.OAuthClients.Read(ctx, d.Id())
@@ -174,6 +279,14 @@ func resourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("http_url", oc.HTTPURL)
 	d.Set("organization", oc.Organization.Name)
 	d.Set("service_provider", string(oc.ServiceProvider))
+	d.Set("organization_scoped", oc.OrganizationScoped)
+
+	// The agent pool is not exposed by tfe.OAuthClient.
+	agentPoolID, err := readOAuthClientAgentPoolID(ctx, tfeClient, oc.ID)
+	if err != nil {
+		return fmt.Errorf("Error reading agent pool of OAuth client %s: %w", oc.ID, err)
+	}
+	d.Set("agent_pool_id", agentPoolID)
 
 	switch len(oc.OAuthTokens) {
 	case 0:
@@ -187,8 +300,24 @@ func resourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTFEOAuthClientDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOAuthClientUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	options := tfe.OAuthClientUpdateOptions{
+		OrganizationScoped: tfe.Bool(d.Get("organization_scoped").(bool)),
+	}
+
+	log.Printf("[DEBUG] Update configuration of OAuth client: %s", d.Id())
+	_, err := tfeClient.OAuthClients.Update(ctx, d.Id(), options)
+	if err != nil {
+		return fmt.Errorf("Error updating OAuth client %s: %w", d.Id(), err)
+	}
+
+	return resourceTFEOAuthClientRead(ctx, d, meta)
+}
+
+func resourceTFEOAuthClientDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete OAuth client: %s", d.Id())
 	err := tfeClient.OAuthClients.Delete(ctx, d.Id())
@@ -201,3 +330,25 @@ func resourceTFEOAuthClientDelete(d *schema.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+func resourceTFEOAuthClientImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read configuration of OAuth client: %s", d.Id())
+	oc, err := tfeClient.OAuthClients.Read(ctx, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Error reading configuration of OAuth client %s: %w", d.Id(), err)
+	}
+
+	// The arguments which can not be changed are only read when importing, as
+	// the API may fill in values which were not configured. The secrets, like
+	// oauth_token, private_key and secret, are never returned by the API and
+	// are left empty.
+	if oc.Name != nil {
+		d.Set("name", *oc.Name)
+	}
+	d.Set("key", oc.Key)
+	d.Set("rsa_public_key", oc.RSAPublicKey)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -38,6 +38,12 @@ func TestAccTFEOAuthClient_basic(t *testing.T) {
 						"tfe_oauth_client.foobar", "service_provider", "github"),
 				),
 			},
+			{
+				ResourceName:            "tfe_oauth_client.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"oauth_token"},
+			},
 		},
 	})
 }
@@ -75,7 +81,7 @@ func TestAccTFEOAuthClient_rsaKeys(t *testing.T) {
 func testAccCheckTFEOAuthClientExists(
 	n string, oc *tfe.OAuthClient) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -117,7 +123,7 @@ func testAccCheckTFEOAuthClientAttributes(
 }
 
 func testAccCheckTFEOAuthClientDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_oauth_client" {
@@ -0,0 +1,41 @@
+package tfe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTFEOPAVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEOPAVersionCreate),
+		ReadContext:   crudContext(resourceTFEOPAVersionRead),
+		UpdateContext: crudContext(resourceTFEOPAVersionUpdate),
+		DeleteContext: crudContext(resourceTFEOPAVersionDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFEOPAVersionImporter,
+		},
+
+		Schema: adminToolVersionSchema("opa-versions"),
+	}
+}
+
+func resourceTFEOPAVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceTFEAdminToolVersionCreate(ctx, d, meta, "opa-versions")
+}
+
+func resourceTFEOPAVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceTFEAdminToolVersionRead(ctx, d, meta, "opa-versions")
+}
+
+func resourceTFEOPAVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceTFEAdminToolVersionUpdate(ctx, d, meta, "opa-versions")
+}
+
+func resourceTFEOPAVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceTFEAdminToolVersionDelete(ctx, d, meta, "opa-versions")
+}
+
+func resourceTFEOPAVersionImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return resourceTFEAdminToolVersionImport(ctx, d, meta, "opa-versions")
+}
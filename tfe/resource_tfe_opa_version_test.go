@@ -0,0 +1,110 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEOPAVersion_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	sha := genSha(t, "secret", "data")
+	version := genSafeRandomOPAVersion()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEOPAVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEOPAVersion_basic(version, sha),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_opa_version.foobar", "version", version),
+					resource.TestCheckResourceAttr(
+						"tfe_opa_version.foobar", "url", "https://www.hashicorp.com"),
+					resource.TestCheckResourceAttr(
+						"tfe_opa_version.foobar", "sha", sha),
+					resource.TestCheckResourceAttr(
+						"tfe_opa_version.foobar", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccTFEOPAVersion_full(version, sha),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_opa_version.foobar", "beta", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_opa_version.foobar", "deprecated", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_opa_version.foobar", "deprecated_reason", "foobar"),
+				),
+			},
+			{
+				ResourceName:      "tfe_opa_version.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "tfe_opa_version.foobar",
+				ImportState:       true,
+				ImportStateId:     version,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTFEOPAVersionDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_opa_version" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := readAdminToolVersion(ctx, tfeClient, "opa-versions", rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("OPA version %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEOPAVersion_basic(version string, sha string) string {
+	return fmt.Sprintf(`
+resource "tfe_opa_version" "foobar" {
+  version = "%s"
+  url     = "https://www.hashicorp.com"
+  sha     = "%s"
+}`, version, sha)
+}
+
+func testAccTFEOPAVersion_full(version string, sha string) string {
+	return fmt.Sprintf(`
+resource "tfe_opa_version" "foobar" {
+  version           = "%s"
+  url               = "https://www.hashicorp.com"
+  sha               = "%s"
+  official          = false
+  enabled           = true
+  beta              = true
+  deprecated        = true
+  deprecated_reason = "foobar"
+}`, version, sha)
+}
+
+func genSafeRandomOPAVersion() string {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Intn(100000) + 100
+	return fmt.Sprintf("0.44.%d", rInt)
+}
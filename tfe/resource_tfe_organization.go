@@ -1,25 +1,32 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTFEOrganization() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEOrganizationCreate,
-		Read:   resourceTFEOrganizationRead,
-		Update: resourceTFEOrganizationUpdate,
-		Delete: resourceTFEOrganizationDelete,
+		CreateContext: crudContext(resourceTFEOrganizationCreate),
+		ReadContext:   crudContext(resourceTFEOrganizationRead),
+		UpdateContext: crudContext(resourceTFEOrganizationUpdate),
+		DeleteContext: crudContext(resourceTFEOrganizationDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customdiff.All(
+			validateOrganizationDefaultTerraformVersion,
+			validateOrganizationDefaultAgentPool,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -78,6 +85,7 @@ func resourceTFEOrganization() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+
 			"allow_force_delete_workspaces": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -88,12 +96,40 @@ func resourceTFEOrganization() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"default_terraform_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"default_execution_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{"agent", "local", "remote"},
+					false,
+				),
+			},
+
+			"default_agent_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"speculative_plan_management_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func resourceTFEOrganizationCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the organization name.
 	name := d.Get("name").(string)
@@ -112,11 +148,11 @@ func resourceTFEOrganizationCreate(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(org.Name)
 
-	return resourceTFEOrganizationUpdate(d, meta)
+	return resourceTFEOrganizationUpdate(ctx, d, meta)
 }
 
-func resourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read configuration of organization: %s", d.Id())
 	org, err := tfeClient.Organizations.Read(ctx, d.Id())
@@ -142,16 +178,41 @@ func resourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) error
 	// org.AssessmentsEnforced will default to false
 	d.Set("assessments_enforced", org.AssessmentsEnforced)
 	d.Set("allow_force_delete_workspaces", org.AllowForceDeleteWorkspaces)
+	d.Set("default_execution_mode", org.DefaultExecutionMode)
 
 	if org.DefaultProject != nil {
 		d.Set("default_project_id", org.DefaultProject.ID)
 	}
 
+	var defaultAgentPoolID string
+	if org.DefaultAgentPool != nil {
+		defaultAgentPoolID = org.DefaultAgentPool.ID
+	}
+	d.Set("default_agent_pool_id", defaultAgentPoolID)
+
+	// The default Terraform version is not available on all releases of
+	// Terraform Enterprise and is not exposed by tfe.Organization.
+	defaultTerraformVersion, supported, err := readOrganizationDefaultTerraformVersion(ctx, tfeClient, org.Name)
+	if err != nil {
+		return fmt.Errorf("Error reading default Terraform version of organization %s: %w", org.Name, err)
+	}
+	if supported {
+		d.Set("default_terraform_version", defaultTerraformVersion)
+	}
+
+	speculativePlanManagement, supported, err := readOrganizationSpeculativePlanManagement(ctx, tfeClient, org.Name)
+	if err != nil {
+		return fmt.Errorf("Error reading speculative plan management of organization %s: %w", org.Name, err)
+	}
+	if supported {
+		d.Set("speculative_plan_management_enabled", speculativePlanManagement)
+	}
+
 	return nil
 }
 
-func resourceTFEOrganizationUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Create a new options struct.
 	options := tfe.OrganizationUpdateOptions{
@@ -199,6 +260,16 @@ func resourceTFEOrganizationUpdate(d *schema.ResourceData, meta interface{}) err
 		options.AllowForceDeleteWorkspaces = tfe.Bool(allowForceDeleteWorkspaces.(bool))
 	}
 
+	// If default_execution_mode is supplied, set it using the options struct.
+	if defaultExecutionMode, ok := d.GetOk("default_execution_mode"); ok {
+		options.DefaultExecutionMode = tfe.String(defaultExecutionMode.(string))
+	}
+
+	// If default_agent_pool_id is supplied, set it using the options struct.
+	if defaultAgentPoolID, ok := d.GetOk("default_agent_pool_id"); ok {
+		options.DefaultAgentPool = &tfe.AgentPool{ID: defaultAgentPoolID.(string)}
+	}
+
 	log.Printf("[DEBUG] Update configuration of organization: %s", d.Id())
 	org, err := tfeClient.Organizations.Update(ctx, d.Id(), options)
 	if err != nil {
@@ -207,11 +278,81 @@ func resourceTFEOrganizationUpdate(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(org.Name)
 
-	return resourceTFEOrganizationRead(d, meta)
+	if d.HasChange("default_terraform_version") {
+		if v, ok := d.GetOk("default_terraform_version"); ok {
+			log.Printf("[DEBUG] Update default Terraform version of organization: %s", d.Id())
+			if err := updateOrganizationDefaultTerraformVersion(ctx, tfeClient, d.Id(), v.(string)); err != nil {
+				return fmt.Errorf("Error updating default Terraform version of organization %s: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("speculative_plan_management_enabled") {
+		if v, ok := d.GetOkExists("speculative_plan_management_enabled"); ok {
+			log.Printf("[DEBUG] Update speculative plan management of organization: %s", d.Id())
+			if err := updateOrganizationSpeculativePlanManagement(ctx, tfeClient, d.Id(), v.(bool)); err != nil {
+				return fmt.Errorf("Error updating speculative plan management of organization %s: %w", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceTFEOrganizationRead(ctx, d, meta)
+}
+
+// validateOrganizationDefaultTerraformVersion checks at plan time that the
+// default Terraform version of an organization is available.
+func validateOrganizationDefaultTerraformVersion(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("default_terraform_version") || !d.NewValueKnown("default_terraform_version") {
+		return nil
+	}
+
+	version := d.Get("default_terraform_version").(string)
+	if version == "" {
+		return nil
+	}
+
+	return validateTerraformVersion(ctx, version, meta.(ConfiguredClient).Client)
+}
+
+// validateOrganizationDefaultAgentPool checks at plan time that a default
+// agent pool is only configured together with the agent execution mode, which
+// in turn requires one. The default agent pool is cleared when the execution
+// mode changes away from agent.
+func validateOrganizationDefaultAgentPool(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	configured := func(key string) bool {
+		if config.IsNull() {
+			return d.Get(key).(string) != ""
+		}
+		return !config.GetAttr(key).IsNull()
+	}
+
+	if !configured("default_execution_mode") || !d.NewValueKnown("default_execution_mode") {
+		return nil
+	}
+
+	executionMode := d.Get("default_execution_mode").(string)
+	agentPoolConfigured := configured("default_agent_pool_id")
+
+	if executionMode != "agent" {
+		if agentPoolConfigured {
+			return fmt.Errorf("default_agent_pool_id can only be set when default_execution_mode is \"agent\"")
+		}
+		if d.Get("default_agent_pool_id").(string) != "" {
+			return d.SetNew("default_agent_pool_id", "")
+		}
+		return nil
+	}
+
+	if !agentPoolConfigured {
+		return fmt.Errorf("default_agent_pool_id is required when default_execution_mode is \"agent\"")
+	}
+
+	return nil
 }
 
-func resourceTFEOrganizationDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete organization: %s", d.Id())
 	err := tfeClient.Organizations.Delete(ctx, d.Id())
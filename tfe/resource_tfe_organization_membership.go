@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -10,13 +11,15 @@ import (
 
 func resourceTFEOrganizationMembership() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEOrganizationMembershipCreate,
-		Read:   resourceTFEOrganizationMembershipRead,
-		Delete: resourceTFEOrganizationMembershipDelete,
+		CreateContext: crudContext(resourceTFEOrganizationMembershipCreate),
+		ReadContext:   crudContext(resourceTFEOrganizationMembershipRead),
+		DeleteContext: crudContext(resourceTFEOrganizationMembershipDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeDiffDefaultOrganization,
+
 		Schema: map[string]*schema.Schema{
 			"email": {
 				Type:     schema.TypeString,
@@ -26,7 +29,8 @@ func resourceTFEOrganizationMembership() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -43,12 +47,15 @@ func resourceTFEOrganizationMembership() *schema.Resource {
 	}
 }
 
-func resourceTFEOrganizationMembershipCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the email and organization.
 	email := d.Get("email").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	// Create a new options struct.
 	options := tfe.OrganizationMembershipCreateOptions{
@@ -64,11 +71,11 @@ func resourceTFEOrganizationMembershipCreate(d *schema.ResourceData, meta interf
 
 	d.SetId(membership.ID)
 
-	return resourceTFEOrganizationMembershipRead(d, meta)
+	return resourceTFEOrganizationMembershipRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	options := tfe.OrganizationMembershipReadOptions{
 		Include: []tfe.OrgMembershipIncludeOpt{tfe.OrgMembershipUser},
@@ -94,8 +101,8 @@ func resourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTFEOrganizationMembershipDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete membership: %s", d.Id())
 	err := tfeClient.OrganizationMemberships.Delete(ctx, d.Id())
@@ -63,7 +63,7 @@ func TestAccTFEOrganizationMembershipImport(t *testing.T) {
 func testAccCheckTFEOrganizationMembershipExists(
 	n string, membership *tfe.OrganizationMembership) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -111,7 +111,7 @@ func testAccCheckTFEOrganizationMembershipAttributes(
 }
 
 func testAccCheckTFEOrganizationMembershipDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_organization_membership" {
@@ -0,0 +1,215 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The organization memberships resource invites many members at once, which
+// avoids one resource and one refresh request per member. Only the memberships
+// of the configured emails are managed.
+func resourceTFEOrganizationMemberships() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEOrganizationMembershipsCreate),
+		ReadContext:   crudContext(resourceTFEOrganizationMembershipsRead),
+		UpdateContext: crudContext(resourceTFEOrganizationMembershipsUpdate),
+		DeleteContext: crudContext(resourceTFEOrganizationMembershipsDelete),
+
+		CustomizeDiff: customizeDiffDefaultOrganization,
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"emails": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"batch_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"membership_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// inviteMissingOrganizationMembers invites the emails which are not yet
+// members of the organization, and returns the membership IDs of all emails.
+// Existing memberships are adopted instead of failing the invitation.
+func inviteMissingOrganizationMembers(ctx context.Context, d *schema.ResourceData, client *tfe.Client, emails []string) (map[string]string, error) {
+	organization := d.Get("organization").(string)
+
+	existing, err := readOrganizationMembershipsByEmail(ctx, client, organization, emails)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string)
+	var missing []string
+	for _, email := range emails {
+		if membership, ok := existing[strings.ToLower(email)]; ok {
+			ids[email] = membership.ID
+			continue
+		}
+		missing = append(missing, email)
+	}
+
+	interval := time.Duration(d.Get("batch_interval").(int)) * time.Second
+	err = inviteOrganizationMembers(ctx, client, organization, missing, d.Get("batch_size").(int), interval, ids)
+	return ids, err
+}
+
+func resourceTFEOrganizationMembershipsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
+
+	var emails []string
+	for _, email := range d.Get("emails").(*schema.Set).List() {
+		emails = append(emails, email.(string))
+	}
+	sort.Strings(emails)
+
+	ids, err := inviteMissingOrganizationMembers(ctx, d, tfeClient, emails)
+
+	// Keep the memberships created before an error, so they are deleted with
+	// the resource.
+	d.SetId(organization)
+	d.Set("membership_ids", ids)
+	if err != nil {
+		return err
+	}
+
+	return resourceTFEOrganizationMembershipsRead(ctx, d, meta)
+}
+
+func resourceTFEOrganizationMembershipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	var emails []string
+	for email := range d.Get("membership_ids").(map[string]interface{}) {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	log.Printf("[DEBUG] Read %d memberships of organization: %s", len(emails), d.Id())
+	memberships, err := readOrganizationMembershipsByEmail(ctx, tfeClient, d.Id(), emails)
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Organization %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// Emails whose membership was removed outside of Terraform are dropped,
+	// so they are invited again.
+	ids := make(map[string]string)
+	var found []string
+	for _, email := range emails {
+		if membership, ok := memberships[strings.ToLower(email)]; ok {
+			ids[email] = membership.ID
+			found = append(found, email)
+		}
+	}
+
+	d.Set("organization", d.Id())
+	d.Set("emails", found)
+	d.Set("membership_ids", ids)
+
+	return nil
+}
+
+func resourceTFEOrganizationMembershipsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	if d.HasChange("emails") {
+		oldEmails, newEmails := d.GetChange("emails")
+		added := newEmails.(*schema.Set).Difference(oldEmails.(*schema.Set))
+		removed := oldEmails.(*schema.Set).Difference(newEmails.(*schema.Set))
+
+		ids := make(map[string]string)
+		for email, id := range d.Get("membership_ids").(map[string]interface{}) {
+			ids[email] = id.(string)
+		}
+
+		for _, email := range removed.List() {
+			id, ok := ids[email.(string)]
+			if !ok {
+				continue
+			}
+
+			log.Printf("[DEBUG] Delete membership %s of organization: %s", email, d.Id())
+			err := tfeClient.OrganizationMemberships.Delete(ctx, id)
+			if err != nil && err != tfe.ErrResourceNotFound {
+				d.Set("membership_ids", ids)
+				return fmt.Errorf("Error deleting membership %s: %w", id, err)
+			}
+			delete(ids, email.(string))
+		}
+
+		var emails []string
+		for _, email := range added.List() {
+			emails = append(emails, email.(string))
+		}
+		sort.Strings(emails)
+
+		invited, err := inviteMissingOrganizationMembers(ctx, d, tfeClient, emails)
+		for email, id := range invited {
+			ids[email] = id
+		}
+		d.Set("membership_ids", ids)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceTFEOrganizationMembershipsRead(ctx, d, meta)
+}
+
+func resourceTFEOrganizationMembershipsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	for email, id := range d.Get("membership_ids").(map[string]interface{}) {
+		log.Printf("[DEBUG] Delete membership %s of organization: %s", email, d.Id())
+		err := tfeClient.OrganizationMemberships.Delete(ctx, id.(string))
+		if err != nil && err != tfe.ErrResourceNotFound {
+			return fmt.Errorf("Error deleting membership %s: %w", id.(string), err)
+		}
+	}
+
+	return nil
+}
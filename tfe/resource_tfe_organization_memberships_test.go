@@ -0,0 +1,125 @@
+package tfe
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFEOrganizationMemberships_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEOrganizationMemberships_basic(rInt, `"one@company.com", "two@company.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_organization_memberships.foobar", "emails.#", "2"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_organization_memberships.foobar", "membership_ids.one@company.com"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_organization_memberships.foobar", "membership_ids.two@company.com"),
+				),
+			},
+			{
+				Config: testAccTFEOrganizationMemberships_basic(rInt, `"two@company.com", "three@company.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_organization_memberships.foobar", "emails.#", "2"),
+					resource.TestCheckNoResourceAttr(
+						"tfe_organization_memberships.foobar", "membership_ids.one@company.com"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_organization_memberships.foobar", "membership_ids.three@company.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceTFEOrganizationMembershipsCreate(t *testing.T) {
+	var created []string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/organizations/hashicorp/organization-memberships":
+			var data []string
+			for _, email := range strings.Split(r.URL.Query().Get("filter[email]"), ",") {
+				switch email {
+				case "existing@company.com":
+					data = append(data, `{"id":"ou-existing","type":"organization-memberships","attributes":{"email":"Existing@company.com","status":"active"}}`)
+				case "new@company.com", "other@company.com":
+					for _, c := range created {
+						if c == email {
+							data = append(data, fmt.Sprintf(`{"id":"ou-%s","type":"organization-memberships","attributes":{"email":"%s","status":"invited"}}`, email, email))
+						}
+					}
+				}
+			}
+			fmt.Fprintf(w, `{"data":[%s],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`, strings.Join(data, ","))
+		case r.Method == "POST" && r.URL.Path == "/api/v2/organizations/hashicorp/organization-memberships":
+			b, _ := io.ReadAll(r.Body)
+			email := regexp.MustCompile(`"email":"([^"]+)"`).FindStringSubmatch(string(b))[1]
+			created = append(created, email)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"data":{"id":"ou-%s","type":"organization-memberships","attributes":{"email":"%s","status":"invited"}}}`, email, email)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	d := resourceTFEOrganizationMemberships().TestResourceData()
+	d.Set("organization", "hashicorp")
+	d.Set("emails", []interface{}{"existing@company.com", "new@company.com", "other@company.com"})
+	d.Set("batch_size", 1)
+	d.Set("batch_interval", 0)
+
+	if err := resourceTFEOrganizationMembershipsCreate(ctx, d, ConfiguredClient{Client: client}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The existing membership is adopted instead of invited again.
+	if fmt.Sprint(created) != "[new@company.com other@company.com]" {
+		t.Fatalf("expected new@company.com and other@company.com to be invited, got: %v", created)
+	}
+
+	expected := map[string]string{
+		"existing@company.com": "ou-existing",
+		"new@company.com":      "ou-new@company.com",
+		"other@company.com":    "ou-other@company.com",
+	}
+	ids := d.Get("membership_ids").(map[string]interface{})
+	if len(ids) != len(expected) {
+		t.Fatalf("expected %d membership IDs, got: %v", len(expected), ids)
+	}
+	for email, id := range expected {
+		if ids[email] != id {
+			t.Fatalf("expected membership %s for %s, got: %v", id, email, ids[email])
+		}
+	}
+}
+
+func testAccTFEOrganizationMemberships_basic(rInt int, emails string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_organization_memberships" "foobar" {
+  organization   = tfe_organization.foobar.id
+  emails         = [%s]
+  batch_interval = 0
+}`, rInt, emails)
+}
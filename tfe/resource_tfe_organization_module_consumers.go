@@ -0,0 +1,275 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	moduleConsumersAuthoritative = "authoritative"
+	moduleConsumersAdditive      = "additive"
+)
+
+func resourceTFEOrganizationModuleConsumers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEOrganizationModuleConsumersCreate),
+		ReadContext:   crudContext(resourceTFEOrganizationModuleConsumersRead),
+		UpdateContext: crudContext(resourceTFEOrganizationModuleConsumersUpdate),
+		DeleteContext: crudContext(resourceTFEOrganizationModuleConsumersDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFEOrganizationModuleConsumersImporter,
+		},
+
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultOrganization,
+			validateOrganizationModuleConsumersEnterprise,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"global_module_sharing": {
+				Description:   "Whether to share the private modules with all organizations",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"module_consumers"},
+			},
+
+			"module_consumers": {
+				Description: "Names of the organizations which can use the private modules",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"mode": {
+				Description: "Whether the module consumers are the only consumers, or are added to the existing consumers",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     moduleConsumersAuthoritative,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						moduleConsumersAuthoritative,
+						moduleConsumersAdditive,
+					},
+					false,
+				),
+			},
+
+			"all_module_consumers": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceTFEOrganizationModuleConsumersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(organization)
+
+	if err := updateOrganizationModuleConsumers(ctx, d, meta, nil); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceTFEOrganizationModuleConsumersRead(ctx, d, meta)
+}
+
+func resourceTFEOrganizationModuleConsumersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read module sharing of organization: %s", d.Id())
+	org, err := tfeClient.Admin.Organizations.Read(ctx, d.Id())
+	if err != nil {
+		// The admin API also returns not found without a site admin token, so
+		// the organization is read with the regular API to tell both apart.
+		if isErrResourceNotFound(err) {
+			if _, orgErr := tfeClient.Organizations.Read(ctx, d.Id()); isErrResourceNotFound(orgErr) {
+				log.Printf("[DEBUG] Organization %s no longer exists", d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return fmt.Errorf("Error reading module sharing of organization %s: %w", d.Id(), withSiteAdminHint(err))
+	}
+
+	globalModuleSharing := org.GlobalModuleSharing != nil && *org.GlobalModuleSharing
+
+	var consumers []string
+	if !globalModuleSharing {
+		consumers, err = listModuleConsumers(ctx, tfeClient, d.Id())
+		if err != nil {
+			return fmt.Errorf("Error reading module consumers of organization %s: %w", d.Id(), withSiteAdminHint(err))
+		}
+	}
+
+	// In additive mode only the configured consumers are managed, so
+	// consumers added elsewhere do not show up as changes.
+	managed := consumers
+	if d.Get("mode").(string) == moduleConsumersAdditive {
+		managed = nil
+		configured := d.Get("module_consumers").(*schema.Set)
+		for _, consumer := range consumers {
+			if configured.Contains(consumer) {
+				managed = append(managed, consumer)
+			}
+		}
+	}
+
+	d.Set("organization", d.Id())
+	d.Set("global_module_sharing", globalModuleSharing)
+	d.Set("module_consumers", managed)
+	d.Set("all_module_consumers", consumers)
+
+	return nil
+}
+
+func resourceTFEOrganizationModuleConsumersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("global_module_sharing") || d.HasChange("module_consumers") {
+		old, _ := d.GetChange("module_consumers")
+		if err := updateOrganizationModuleConsumers(ctx, d, meta, old.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	return resourceTFEOrganizationModuleConsumersRead(ctx, d, meta)
+}
+
+// Deleting the module consumers disables module sharing in authoritative
+// mode, and only removes the configured consumers in additive mode.
+func resourceTFEOrganizationModuleConsumersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	var consumers []string
+	if d.Get("mode").(string) == moduleConsumersAdditive {
+		current, err := listModuleConsumers(ctx, tfeClient, d.Id())
+		if err != nil {
+			if isErrResourceNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("Error reading module consumers of organization %s: %w", d.Id(), withSiteAdminHint(err))
+		}
+
+		configured := d.Get("module_consumers").(*schema.Set)
+		for _, consumer := range current {
+			if !configured.Contains(consumer) {
+				consumers = append(consumers, consumer)
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Update module consumers of organization %s: %v", d.Id(), consumers)
+	err := tfeClient.Admin.Organizations.UpdateModuleConsumers(ctx, d.Id(), consumers)
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Error removing module consumers of organization %s: %w", d.Id(), withSiteAdminHint(err))
+	}
+
+	return nil
+}
+
+// updateOrganizationModuleConsumers enables global module sharing, or shares
+// the modules with the configured consumers. In additive mode, the existing
+// consumers are kept, except for the previously configured ones which were
+// removed from the configuration.
+func updateOrganizationModuleConsumers(ctx context.Context, d *schema.ResourceData, meta interface{}, old *schema.Set) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	if d.Get("global_module_sharing").(bool) {
+		log.Printf("[DEBUG] Enable global module sharing for organization: %s", d.Id())
+		_, err := tfeClient.Admin.Organizations.Update(ctx, d.Id(), tfe.AdminOrganizationUpdateOptions{
+			GlobalModuleSharing: tfe.Bool(true),
+		})
+		if err != nil {
+			return fmt.Errorf("Error enabling global module sharing for organization %s: %w", d.Id(), withSiteAdminHint(err))
+		}
+		return nil
+	}
+
+	configured := d.Get("module_consumers").(*schema.Set)
+	consumers := make(map[string]bool)
+	for _, consumer := range configured.List() {
+		consumers[consumer.(string)] = true
+	}
+
+	if d.Get("mode").(string) == moduleConsumersAdditive {
+		current, err := listModuleConsumers(ctx, tfeClient, d.Id())
+		if err != nil {
+			return fmt.Errorf("Error reading module consumers of organization %s: %w", d.Id(), withSiteAdminHint(err))
+		}
+
+		for _, consumer := range current {
+			if old != nil && old.Contains(consumer) && !configured.Contains(consumer) {
+				continue
+			}
+			consumers[consumer] = true
+		}
+	}
+
+	names := make([]string, 0, len(consumers))
+	for consumer := range consumers {
+		names = append(names, consumer)
+	}
+	sort.Strings(names)
+
+	// Updating the module consumers also disables global module sharing.
+	log.Printf("[DEBUG] Update module consumers of organization %s: %v", d.Id(), names)
+	err := tfeClient.Admin.Organizations.UpdateModuleConsumers(ctx, d.Id(), names)
+	if err != nil {
+		return fmt.Errorf("Error updating module consumers of organization %s: %w", d.Id(), withSiteAdminHint(err))
+	}
+
+	return nil
+}
+
+// validateOrganizationModuleConsumersEnterprise fails at plan time on
+// Terraform Cloud, as the module consumers are managed with the admin API of
+// Terraform Enterprise.
+func validateOrganizationModuleConsumersEnterprise(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(ConfiguredClient).Client
+	if client != nil && client.IsCloud() {
+		return fmt.Errorf("tfe_organization_module_consumers is only supported by Terraform Enterprise, as it requires the admin API and a site admin token")
+	}
+	return nil
+}
+
+// withSiteAdminHint explains errors of the admin API, which are returned as
+// unauthorized or not found without a site admin token.
+func withSiteAdminHint(err error) error {
+	if errors.Is(err, tfe.ErrUnauthorized) || errors.Is(err, tfe.ErrResourceNotFound) {
+		return fmt.Errorf("%w: managing module consumers requires a site admin token of Terraform Enterprise", err)
+	}
+	return err
+}
+
+func resourceTFEOrganizationModuleConsumersImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The module consumers are identified by the organization name and are
+	// imported in authoritative mode.
+	d.Set("organization", d.Id())
+	d.Set("mode", moduleConsumersAuthoritative)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,155 @@
+package tfe
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFEOrganizationModuleConsumers_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEOrganizationModuleConsumers_basic(rInt, `[tfe_organization.foo.name, tfe_organization.bar.name]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_organization_module_consumers.foobar", "global_module_sharing", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_organization_module_consumers.foobar", "module_consumers.#", "2"),
+					resource.TestCheckResourceAttr(
+						"tfe_organization_module_consumers.foobar", "all_module_consumers.#", "2"),
+				),
+			},
+			{
+				Config: testAccTFEOrganizationModuleConsumers_basic(rInt, `[tfe_organization.foo.name]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_organization_module_consumers.foobar", "module_consumers.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"tfe_organization_module_consumers.foobar", "module_consumers.*", "tfe_organization.foo", "name"),
+				),
+			},
+			{
+				ResourceName:      "tfe_organization_module_consumers.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUpdateOrganizationModuleConsumers_additive(t *testing.T) {
+	var updated []string
+
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/admin/organizations/hashicorp/relationships/module-consumers":
+			if r.URL.Query().Get("page[number]") == "2" {
+				fmt.Fprint(w, `{"data":[
+					{"id":"other","type":"organizations","attributes":{"name":"other"}}
+				],"meta":{"pagination":{"current-page":2,"total-pages":2}}}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":[
+				{"id":"removed","type":"organizations","attributes":{"name":"removed"}},
+				{"id":"kept","type":"organizations","attributes":{"name":"kept"}}
+			],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2}}}`)
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/admin/organizations/hashicorp/relationships/module-consumers":
+			var body struct {
+				Data []struct {
+					ID string `json:"id"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("unexpected error decoding the request: %v", err)
+			}
+			for _, org := range body.Data {
+				updated = append(updated, org.ID)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	d := resourceTFEOrganizationModuleConsumers().TestResourceData()
+	d.SetId("hashicorp")
+	d.Set("organization", "hashicorp")
+	d.Set("mode", moduleConsumersAdditive)
+	d.Set("module_consumers", []interface{}{"kept", "added"})
+
+	// "removed" was configured before, "other" was added elsewhere.
+	old := schema.NewSet(schema.HashString, []interface{}{"kept", "removed"})
+
+	if err := updateOrganizationModuleConsumers(ctx, d, ConfiguredClient{Client: client}, old); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sort.Strings(updated)
+	if got, want := strings.Join(updated, ","), "added,kept,other"; got != want {
+		t.Fatalf("expected module consumers %q, got %q", want, got)
+	}
+}
+
+func TestResourceTFEOrganizationModuleConsumersRead_notSiteAdmin(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/organizations/hashicorp":
+			fmt.Fprint(w, `{"data":{"id":"hashicorp","type":"organizations","attributes":{"name":"hashicorp"}}}`)
+		default:
+			// The admin API is not found without a site admin token.
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	d := resourceTFEOrganizationModuleConsumers().TestResourceData()
+	d.SetId("hashicorp")
+
+	err := resourceTFEOrganizationModuleConsumersRead(ctx, d, ConfiguredClient{Client: server.Client})
+	if err == nil || !strings.Contains(err.Error(), "requires a site admin token") {
+		t.Fatalf("expected an error about the site admin token, got: %v", err)
+	}
+	if d.Id() != "hashicorp" {
+		t.Fatalf("expected the resource to be kept in the state, got ID %q", d.Id())
+	}
+}
+
+func testAccTFEOrganizationModuleConsumers_basic(rInt int, consumers string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%[1]d"
+  email = "admin@company.com"
+}
+
+resource "tfe_organization" "foo" {
+  name  = "tst-terraform-%[1]d-foo"
+  email = "admin@company.com"
+}
+
+resource "tfe_organization" "bar" {
+  name  = "tst-terraform-%[1]d-bar"
+  email = "admin@company.com"
+}
+
+resource "tfe_organization_module_consumers" "foobar" {
+  organization     = tfe_organization.foobar.name
+  module_consumers = %[2]s
+}`, rInt, consumers)
+}
@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,15 +12,19 @@ import (
 
 func resourceTFEOrganizationModuleSharing() *schema.Resource {
 	return &schema.Resource{
-		DeprecationMessage: "the tfe_organization_module_sharing resource is deprecated, please use tfe_admin_organization_settings instead",
-		Create:             resourceTFEOrganizationModuleSharingCreate,
-		Read:               resourceTFEOrganizationModuleSharingRead,
-		Update:             resourceTFEOrganizationModuleSharingUpdate,
-		Delete:             resourceTFEOrganizationModuleSharingDelete,
+		DeprecationMessage: "the tfe_organization_module_sharing resource is deprecated, please use tfe_organization_module_consumers or tfe_admin_organization_settings instead",
+		CreateContext:      crudContext(resourceTFEOrganizationModuleSharingCreate),
+		ReadContext:        crudContext(resourceTFEOrganizationModuleSharingRead),
+		UpdateContext:      crudContext(resourceTFEOrganizationModuleSharingUpdate),
+		DeleteContext:      crudContext(resourceTFEOrganizationModuleSharingDelete),
+
+		CustomizeDiff: customizeDiffDefaultOrganization,
+
 		Schema: map[string]*schema.Schema{
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return strings.EqualFold(old, new)
 				},
@@ -34,18 +39,21 @@ func resourceTFEOrganizationModuleSharing() *schema.Resource {
 	}
 }
 
-func resourceTFEOrganizationModuleSharingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationModuleSharingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// Get the organization name that will share "produce" modules
-	producer := d.Get("organization").(string)
+	producer, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Create %s module consumers", producer)
 	d.SetId(producer)
 
-	return resourceTFEOrganizationModuleSharingUpdate(d, meta)
+	return resourceTFEOrganizationModuleSharingUpdate(ctx, d, meta)
 }
 
-func resourceTFEOrganizationModuleSharingUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationModuleSharingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	var consumers []string
 	for _, name := range d.Get("module_consumers").([]interface{}) {
@@ -62,11 +70,11 @@ func resourceTFEOrganizationModuleSharingUpdate(d *schema.ResourceData, meta int
 		return fmt.Errorf("error updating module consumers to %s: %w", d.Id(), err)
 	}
 
-	return resourceTFEOrganizationModuleSharingRead(d, meta)
+	return resourceTFEOrganizationModuleSharingRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationModuleSharingRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationModuleSharingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	options := &tfe.AdminOrganizationListModuleConsumersOptions{}
 
@@ -92,8 +100,8 @@ func resourceTFEOrganizationModuleSharingRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTFEOrganizationModuleSharingDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationModuleSharingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Disable module sharing for organization: %s", d.Id())
 	err := tfeClient.Admin.Organizations.UpdateModuleConsumers(ctx, d.Id(), []string{})
@@ -13,14 +13,16 @@ import (
 
 func resourceTFEOrganizationRunTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEOrganizationRunTaskCreate,
-		Read:   resourceTFEOrganizationRunTaskRead,
-		Delete: resourceTFEOrganizationRunTaskDelete,
-		Update: resourceTFEOrganizationRunTaskUpdate,
+		CreateContext: crudContext(resourceTFEOrganizationRunTaskCreate),
+		ReadContext:   crudContext(resourceTFEOrganizationRunTaskRead),
+		DeleteContext: crudContext(resourceTFEOrganizationRunTaskDelete),
+		UpdateContext: crudContext(resourceTFEOrganizationRunTaskUpdate),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEOrganizationRunTaskImporter,
 		},
 
+		CustomizeDiff: customizeDiffDefaultOrganization,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -29,7 +31,8 @@ func resourceTFEOrganizationRunTask() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -66,12 +69,15 @@ func resourceTFEOrganizationRunTask() *schema.Resource {
 	}
 }
 
-func resourceTFEOrganizationRunTaskCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationRunTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the task name and organization.
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	// Create a new options struct.
 	options := tfe.RunTaskCreateOptions{
@@ -92,11 +98,11 @@ func resourceTFEOrganizationRunTaskCreate(d *schema.ResourceData, meta interface
 
 	d.SetId(task.ID)
 
-	return resourceTFEOrganizationRunTaskRead(d, meta)
+	return resourceTFEOrganizationRunTaskRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationRunTaskDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationRunTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete task: %s", d.Id())
 	err := tfeClient.RunTasks.Delete(ctx, d.Id())
@@ -110,8 +116,8 @@ func resourceTFEOrganizationRunTaskDelete(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceTFEOrganizationRunTaskUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationRunTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Setup the options struct
 	options := tfe.RunTaskUpdateOptions{}
@@ -142,11 +148,11 @@ func resourceTFEOrganizationRunTaskUpdate(d *schema.ResourceData, meta interface
 
 	d.SetId(task.ID)
 
-	return resourceTFEOrganizationRunTaskRead(d, meta)
+	return resourceTFEOrganizationRunTaskRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationRunTaskRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationRunTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read configuration of task: %s", d.Id())
 	task, err := tfeClient.RunTasks.Read(ctx, d.Id())
@@ -173,7 +179,7 @@ func resourceTFEOrganizationRunTaskRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceTFEOrganizationRunTaskImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	s := strings.Split(d.Id(), "/")
 	if len(s) != 2 {
@@ -183,7 +189,7 @@ func resourceTFEOrganizationRunTaskImporter(ctx context.Context, d *schema.Resou
 		)
 	}
 
-	task, err := fetchOrganizationRunTask(s[1], s[0], tfeClient)
+	task, err := fetchOrganizationRunTask(ctx, s[1], s[0], tfeClient)
 	if err != nil {
 		return nil, err
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +14,49 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestValidateOrganizationDefaultAgentPool(t *testing.T) {
+	cases := map[string]struct {
+		raw map[string]interface{}
+		err string
+	}{
+		"no execution mode": {},
+		"remote execution": {
+			raw: map[string]interface{}{"default_execution_mode": "remote"},
+		},
+		"agent execution with pool": {
+			raw: map[string]interface{}{"default_execution_mode": "agent", "default_agent_pool_id": "apool-123"},
+		},
+		"agent execution without pool": {
+			raw: map[string]interface{}{"default_execution_mode": "agent"},
+			err: "default_agent_pool_id is required",
+		},
+		"remote execution with pool": {
+			raw: map[string]interface{}{"default_execution_mode": "remote", "default_agent_pool_id": "apool-123"},
+			err: "default_agent_pool_id can only be set",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{"name": "my-org", "email": "admin@company.com"}
+			for k, v := range tc.raw {
+				raw[k] = v
+			}
+
+			_, err := resourceTFEOrganization().Diff(ctx, nil, terraform.NewResourceConfigRaw(raw), ConfiguredClient{})
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error to contain %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
 func TestAccTFEOrganization_basic(t *testing.T) {
 	org := &tfe.Organization{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -252,7 +296,7 @@ func TestAccTFEOrganization_import(t *testing.T) {
 func testAccCheckTFEOrganizationExists(
 	n string, org *tfe.Organization) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -368,7 +412,7 @@ func testAccCheckTFEOrganizationAttributesUpdated(
 }
 
 func testAccCheckTFEOrganizationDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_organization" {
@@ -4,24 +4,33 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTFEOrganizationToken() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEOrganizationTokenCreate,
-		Read:   resourceTFEOrganizationTokenRead,
-		Delete: resourceTFEOrganizationTokenDelete,
+		CreateContext: crudContext(resourceTFEOrganizationTokenCreate),
+		ReadContext:   crudContext(resourceTFEOrganizationTokenRead),
+		DeleteContext: crudContext(resourceTFEOrganizationTokenDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEOrganizationTokenImporter,
 		},
 
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultOrganization,
+			customizeDiffTokenExpiration,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -31,6 +40,14 @@ func resourceTFEOrganizationToken() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"expired_at": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
 			"token": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -40,14 +57,17 @@ func resourceTFEOrganizationToken() *schema.Resource {
 	}
 }
 
-func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the organization name.
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Check if a token already exists for organization: %s", organization)
-	_, err := tfeClient.OrganizationTokens.Read(ctx, organization)
+	_, err = tfeClient.OrganizationTokens.Read(ctx, organization)
 	if err != nil && err != tfe.ErrResourceNotFound {
 		return fmt.Errorf("Error checking if a token exists for organization %s: %w", organization, err)
 	}
@@ -60,7 +80,18 @@ func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}
 		log.Printf("[DEBUG] Regenerating existing token for organization: %s", organization)
 	}
 
-	token, err := tfeClient.OrganizationTokens.Create(ctx, organization)
+	options := tfe.OrganizationTokenCreateOptions{}
+
+	// Set the expiration date of the token if one is configured.
+	if v, ok := d.GetOk("expired_at"); ok {
+		expiredAt, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing expired_at %s: %w", v.(string), err)
+		}
+		options.ExpiredAt = &expiredAt
+	}
+
+	token, err := tfeClient.OrganizationTokens.CreateWithOptions(ctx, organization, options)
 	if err != nil {
 		return fmt.Errorf(
 			"Error creating new token for organization %s: %w", organization, err)
@@ -72,11 +103,11 @@ func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}
 	// only be returned once during the creation of the token.
 	d.Set("token", token.Token)
 
-	return resourceTFEOrganizationTokenRead(d, meta)
+	return resourceTFEOrganizationTokenRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationTokenRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read the token from organization: %s", d.Id())
 	_, err := tfeClient.OrganizationTokens.Read(ctx, d.Id())
@@ -92,8 +123,8 @@ func resourceTFEOrganizationTokenRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTFEOrganizationTokenDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEOrganizationTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the organization name.
 	organization := d.Get("organization").(string)
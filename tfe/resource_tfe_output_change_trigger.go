@@ -0,0 +1,279 @@
+package tfe
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// An output change trigger is a client-side alternative to a run trigger: it
+// only queues a run in the workspace when the outputs of the sourceable
+// workspace actually changed, instead of after every apply of the sourceable
+// workspace. The outputs are compared each time the configuration containing
+// the trigger is planned.
+func resourceTFEOutputChangeTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEOutputChangeTriggerCreate),
+		ReadContext:   crudContext(resourceTFEOutputChangeTriggerRead),
+		UpdateContext: crudContext(resourceTFEOutputChangeTriggerUpdate),
+		DeleteContext: crudContext(resourceTFEOutputChangeTriggerDelete),
+
+		CustomizeDiff: setOutputChangeTriggerChecksum,
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"sourceable_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"outputs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Queued by Terraform after the outputs of the source workspace changed",
+			},
+
+			"state_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"serial": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"outputs_checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// outputsSnapshot describes the watched outputs of the current state version
+// of a workspace.
+type outputsSnapshot struct {
+	StateVersionID string
+	Serial         int64
+	Checksum       string
+}
+
+// readOutputsSnapshot reads the current state version of a workspace and
+// computes a checksum of the given outputs, or of all outputs when no names
+// are given. A workspace without state results in an empty snapshot.
+func readOutputsSnapshot(ctx context.Context, client *tfe.Client, workspaceID string, names []string) (*outputsSnapshot, error) {
+	snapshot := &outputsSnapshot{}
+	values := make(map[string]interface{})
+
+	log.Printf("[DEBUG] Read current state version of workspace: %s", workspaceID)
+	sv, err := client.StateVersions.ReadCurrent(ctx, workspaceID)
+	if err != nil && err != tfe.ErrResourceNotFound {
+		return nil, fmt.Errorf("Error retrieving current state version of workspace %s: %w", workspaceID, err)
+	}
+
+	if err == nil {
+		snapshot.StateVersionID = sv.ID
+		snapshot.Serial = sv.Serial
+
+		outputs, err := listStateVersionOutputs(ctx, client, sv.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(names) > 0 {
+			selected := make(map[string]*tfe.StateVersionOutput)
+			for _, n := range names {
+				// Watched outputs which don't exist (yet) are left out, so
+				// adding them later is detected as a change.
+				if output, ok := outputs[n]; ok {
+					selected[n] = output
+				}
+			}
+			outputs = selected
+		}
+
+		for n, output := range outputs {
+			// The values of sensitive outputs are only returned when the
+			// outputs are read one by one.
+			if output.Sensitive {
+				log.Printf("[DEBUG] Read sensitive state version output: %s", output.ID)
+				output, err = client.StateVersionOutputs.Read(ctx, output.ID)
+				if err != nil {
+					return nil, fmt.Errorf("Error retrieving sensitive output %s of workspace %s: %w", n, workspaceID, err)
+				}
+			}
+			values[n] = output.Value
+		}
+	}
+
+	// Maps are marshaled with sorted keys, so equal outputs always result in
+	// the same checksum.
+	b, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("Error computing checksum of the outputs of workspace %s: %w", workspaceID, err)
+	}
+	sum := sha256.Sum256(b)
+	snapshot.Checksum = hex.EncodeToString(sum[:])
+
+	return snapshot, nil
+}
+
+func outputChangeTriggerNames(v interface{}) []string {
+	var names []string
+	for _, n := range v.(*schema.Set).List() {
+		names = append(names, n.(string))
+	}
+	return names
+}
+
+// setOutputChangeTriggerChecksum compares the watched outputs of the
+// sourceable workspace with the ones seen during the last apply. The outputs
+// are only compared when the serial of the state changed, and a new run is
+// only planned when their values changed.
+func setOutputChangeTriggerChecksum(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("sourceable_id") || !d.NewValueKnown("outputs") {
+		return nil
+	}
+
+	tfeClient := meta.(ConfiguredClient).Client
+	sourceableID := d.Get("sourceable_id").(string)
+	names := outputChangeTriggerNames(d.Get("outputs"))
+
+	snapshot, err := readOutputsSnapshot(ctx, tfeClient, sourceableID, names)
+	if err != nil {
+		return err
+	}
+
+	if snapshot.Serial == int64(d.Get("serial").(int)) && !d.HasChange("outputs") {
+		return nil
+	}
+
+	if snapshot.Checksum != d.Get("outputs_checksum").(string) {
+		log.Printf("[DEBUG] Outputs of workspace %s changed since serial %d", sourceableID, d.Get("serial").(int))
+		if err := d.SetNew("outputs_checksum", snapshot.Checksum); err != nil {
+			return err
+		}
+		if err := d.SetNew("state_version_id", snapshot.StateVersionID); err != nil {
+			return err
+		}
+		if err := d.SetNew("serial", int(snapshot.Serial)); err != nil {
+			return err
+		}
+		if !d.HasChange("outputs") {
+			return d.SetNewComputed("run_id")
+		}
+	}
+
+	return nil
+}
+
+func resourceTFEOutputChangeTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+	sourceableID := d.Get("sourceable_id").(string)
+
+	log.Printf("[DEBUG] Read workspace: %s", workspaceID)
+	if _, err := tfeClient.Workspaces.ReadByID(ctx, workspaceID); err != nil {
+		return fmt.Errorf("Error retrieving workspace %s: %w", workspaceID, err)
+	}
+
+	// The outputs seen when creating the trigger are the baseline, so no run
+	// is queued.
+	snapshot, err := readOutputsSnapshot(ctx, tfeClient, sourceableID, outputChangeTriggerNames(d.Get("outputs")))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspaceID, sourceableID))
+	d.Set("state_version_id", snapshot.StateVersionID)
+	d.Set("serial", int(snapshot.Serial))
+	d.Set("outputs_checksum", snapshot.Checksum)
+
+	return resourceTFEOutputChangeTriggerRead(ctx, d, meta)
+}
+
+func resourceTFEOutputChangeTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	for _, id := range []string{d.Get("workspace_id").(string), d.Get("sourceable_id").(string)} {
+		log.Printf("[DEBUG] Read workspace: %s", id)
+		_, err := tfeClient.Workspaces.ReadByID(ctx, id)
+		if err != nil {
+			if err == tfe.ErrResourceNotFound {
+				log.Printf("[DEBUG] Workspace %s of output change trigger %s no longer exists", id, d.Id())
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("Error reading workspace %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceTFEOutputChangeTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+	sourceableID := d.Get("sourceable_id").(string)
+
+	// Changing the watched outputs only moves the baseline.
+	if d.HasChange("outputs_checksum") && !d.HasChange("outputs") {
+		options := tfe.RunCreateOptions{
+			Workspace: &tfe.Workspace{ID: workspaceID},
+			Message:   tfe.String(d.Get("message").(string)),
+		}
+
+		log.Printf("[DEBUG] Queue run in workspace %s after outputs of workspace %s changed", workspaceID, sourceableID)
+		run, err := tfeClient.Runs.Create(ctx, options)
+		if err != nil {
+			return fmt.Errorf("Error queueing run in workspace %s: %w", workspaceID, err)
+		}
+
+		d.Set("run_id", run.ID)
+	}
+
+	return resourceTFEOutputChangeTriggerRead(ctx, d, meta)
+}
+
+// Deleting the trigger only removes it from the state, runs which were
+// already queued are left untouched.
+func resourceTFEOutputChangeTriggerDelete(_ context.Context, d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Remove output change trigger %s from state", d.Id())
+
+	return nil
+}
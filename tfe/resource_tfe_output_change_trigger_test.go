@@ -0,0 +1,126 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFEOutputChangeTrigger_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEOutputChangeTrigger_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"tfe_output_change_trigger.foobar", "outputs_checksum"),
+					resource.TestCheckResourceAttr(
+						"tfe_output_change_trigger.foobar", "state_version_id", ""),
+					resource.TestCheckResourceAttr(
+						"tfe_output_change_trigger.foobar", "run_id", ""),
+				),
+			},
+			{
+				// Without changes to the outputs of the sourceable workspace
+				// no run is planned.
+				Config:   testAccTFEOutputChangeTrigger_basic(rInt),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestReadOutputsSnapshot(t *testing.T) {
+	value := `"10.0.0.0/16"`
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-source/current-state-version":
+			fmt.Fprint(w, `{"data":{"id":"sv-1","type":"state-versions","attributes":{"serial":3}}}`)
+		case "/api/v2/state-versions/sv-1/outputs":
+			fmt.Fprintf(w, `{"data":[
+				{"id":"wsout-1","type":"state-version-outputs","attributes":{"name":"cidr","sensitive":false,"value":%s}},
+				{"id":"wsout-2","type":"state-version-outputs","attributes":{"name":"password","sensitive":true,"value":null}}
+			],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`, value)
+		case "/api/v2/state-version-outputs/wsout-2":
+			fmt.Fprint(w, `{"data":{"id":"wsout-2","type":"state-version-outputs","attributes":{"name":"password","sensitive":true,"value":"secret"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	all, err := readOutputsSnapshot(ctx, client, "ws-source", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if all.StateVersionID != "sv-1" || all.Serial != 3 {
+		t.Fatalf("wrong state version: %#v", all)
+	}
+
+	cidr, err := readOutputsSnapshot(ctx, client, "ws-source", []string{"cidr"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cidr.Checksum == all.Checksum {
+		t.Fatal("expected the checksum of a subset of the outputs to differ")
+	}
+
+	again, err := readOutputsSnapshot(ctx, client, "ws-source", []string{"cidr"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if again.Checksum != cidr.Checksum {
+		t.Fatalf("expected unchanged outputs to keep their checksum, got %s and %s", cidr.Checksum, again.Checksum)
+	}
+
+	value = `"10.1.0.0/16"`
+	changed, err := readOutputsSnapshot(ctx, client, "ws-source", []string{"cidr"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if changed.Checksum == cidr.Checksum {
+		t.Fatal("expected changed outputs to change the checksum")
+	}
+
+	empty, err := readOutputsSnapshot(ctx, client, "ws-without-state", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if empty.StateVersionID != "" || empty.Serial != 0 || empty.Checksum == "" {
+		t.Fatalf("wrong snapshot of a workspace without state: %#v", empty)
+	}
+}
+
+func testAccTFEOutputChangeTrigger_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "workspace" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "sourceable" {
+  name         = "sourceable-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_output_change_trigger" "foobar" {
+  workspace_id  = tfe_workspace.workspace.id
+  sourceable_id = tfe_workspace.sourceable.id
+  outputs       = ["vpc_id"]
+}`, rInt)
+}
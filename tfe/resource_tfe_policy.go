@@ -8,21 +8,27 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTFEPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEPolicyCreate,
-		Read:   resourceTFEPolicyRead,
-		Update: resourceTFEPolicyUpdate,
-		Delete: resourceTFEPolicyDelete,
+		CreateContext: crudContext(resourceTFEPolicyCreate),
+		ReadContext:   crudContext(resourceTFEPolicyRead),
+		UpdateContext: crudContext(resourceTFEPolicyUpdate),
+		DeleteContext: crudContext(resourceTFEPolicyDelete),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEPolicyImporter,
 		},
 
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultOrganization,
+			validatePolicyKindDiff,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "The name of the policy",
@@ -40,7 +46,8 @@ func resourceTFEPolicy() *schema.Resource {
 			"organization": {
 				Description: "Name of the organization that this policy belongs to",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
 
@@ -113,12 +120,67 @@ func opaPolicyEnforcementLevels() []string {
 	}
 }
 
-func resourceTFEPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+// validatePolicyKindDiff makes sure the query and enforcement mode fit the
+// kind of the policy, so that mistakes are caught at plan time.
+func validatePolicyKindDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("kind") || !d.NewValueKnown("query") || !d.NewValueKnown("enforce_mode") {
+		return nil
+	}
+
+	configMap := d.GetRawConfig().AsValueMap()
+
+	var enforceMode string
+	if v, ok := configMap["enforce_mode"]; ok && !v.IsNull() {
+		enforceMode = v.AsString()
+	}
+
+	return validatePolicyKindOptions(
+		tfe.PolicyKind(d.Get("kind").(string)), d.Get("query").(string), enforceMode)
+}
+
+// validatePolicyKindOptions validates the options which depend on the kind of
+// a policy. An empty enforceMode means the default of the kind is used.
+func validatePolicyKindOptions(kind tfe.PolicyKind, query, enforceMode string) error {
+	var enforcementLevels []string
+	switch kind {
+	case tfe.OPA:
+		if query == "" {
+			return fmt.Errorf("query must be set for OPA policies")
+		}
+		enforcementLevels = opaPolicyEnforcementLevels()
+	case tfe.Sentinel:
+		if query != "" {
+			return fmt.Errorf("query can only be set for OPA policies")
+		}
+		enforcementLevels = sentinelPolicyEnforcementLevels()
+	default:
+		return nil
+	}
+
+	if enforceMode == "" {
+		return nil
+	}
+
+	for _, level := range enforcementLevels {
+		if enforceMode == level {
+			return nil
+		}
+	}
+
+	return fmt.Errorf(
+		"enforce_mode %q is not valid for %s policies, must be one of %s",
+		enforceMode, kind, sentenceList(enforcementLevels, "`", "`", "or"))
+}
+
+func resourceTFEPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	var kind string
 	if vKind, ok := d.GetOk("kind"); ok {
@@ -135,7 +197,6 @@ func resourceTFEPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 		options.Description = tfe.String(desc.(string))
 	}
 
-	var err error
 	//  Setup per-kind policy options
 	switch tfe.PolicyKind(kind) {
 	case tfe.Sentinel:
@@ -165,7 +226,7 @@ func resourceTFEPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 			"Error uploading %s policy %s for organization %s: %w", kind, name, organization, err)
 	}
 
-	return resourceTFEPolicyRead(d, meta)
+	return resourceTFEPolicyRead(ctx, d, meta)
 }
 
 func createOPAPolicyOptions(options *tfe.PolicyCreateOptions, d *schema.ResourceData) (*tfe.PolicyCreateOptions, error) {
@@ -222,8 +283,8 @@ func getDefaultEnforcementMode(kind tfe.PolicyKind) tfe.EnforcementLevel {
 	}
 }
 
-func resourceTFEPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read policy: %s", d.Id())
 	policy, err := tfeClient.Policies.Read(ctx, d.Id())
@@ -241,6 +302,10 @@ func resourceTFEPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("description", policy.Description)
 	d.Set("kind", policy.Kind)
 
+	if policy.Query != nil {
+		d.Set("query", *policy.Query)
+	}
+
 	if len(policy.Enforce) == 1 {
 		d.Set("enforce_mode", string(policy.Enforce[0].Mode))
 	}
@@ -254,11 +319,11 @@ func resourceTFEPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFEPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// nolint:nestif
-	if d.HasChange("description") || d.HasChange("enforce_mode") {
+	if d.HasChange("description") || d.HasChange("enforce_mode") || d.HasChange("query") {
 		// Create a new options struct.
 		options := tfe.PolicyUpdateOptions{}
 
@@ -267,10 +332,12 @@ func resourceTFEPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		path := d.Get("name").(string) + ".sentinel"
-		vKind, ok := d.GetOk("kind")
-		if ok {
-			if vKind == tfe.OPA {
-				path = d.Get("name").(string) + ".rego"
+		vKind := d.Get("kind").(string)
+		if tfe.PolicyKind(vKind) == tfe.OPA {
+			path = d.Get("name").(string) + ".rego"
+
+			if d.HasChange("query") {
+				options.Query = tfe.String(d.Get("query").(string))
 			}
 		}
 		if d.HasChange("enforce_mode") {
@@ -299,11 +366,11 @@ func resourceTFEPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return resourceTFEPolicyRead(d, meta)
+	return resourceTFEPolicyRead(ctx, d, meta)
 }
 
-func resourceTFEPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete policy: %s", d.Id())
 	err := tfeClient.Policies.Delete(ctx, d.Id())
@@ -1,25 +1,32 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTFEPolicySet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEPolicySetCreate,
-		Read:   resourceTFEPolicySetRead,
-		Update: resourceTFEPolicySetUpdate,
-		Delete: resourceTFEPolicySetDelete,
+		CreateContext: crudContext(resourceTFEPolicySetCreate),
+		ReadContext:   crudContext(resourceTFEPolicySetRead),
+		UpdateContext: crudContext(resourceTFEPolicySetUpdate),
+		DeleteContext: crudContext(resourceTFEPolicySetDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultOrganization,
+			validatePolicySetOverridable,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -35,7 +42,8 @@ func resourceTFEPolicySet() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -43,7 +51,7 @@ func resourceTFEPolicySet() *schema.Resource {
 				Type:          schema.TypeBool,
 				Optional:      true,
 				Default:       false,
-				ConflictsWith: []string{"workspace_ids"},
+				ConflictsWith: []string{"workspace_ids", "project_ids"},
 			},
 
 			"kind": {
@@ -64,6 +72,12 @@ func resourceTFEPolicySet() *schema.Resource {
 				Default:  false,
 			},
 
+			"agent_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"policies_path": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -124,15 +138,33 @@ func resourceTFEPolicySet() *schema.Resource {
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				ConflictsWith: []string{"global"},
 			},
+
+			"project_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"global"},
+			},
+
+			"workspace_exclusions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
 
-func resourceTFEPolicySetCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEPolicySetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	// Create a new options struct.
 	options := tfe.PolicySetCreateOptions{
@@ -181,6 +213,14 @@ func resourceTFEPolicySetCreate(d *schema.ResourceData, meta interface{}) error
 		options.Workspaces = append(options.Workspaces, &tfe.Workspace{ID: workspaceID.(string)})
 	}
 
+	for _, projectID := range d.Get("project_ids").(*schema.Set).List() {
+		options.Projects = append(options.Projects, &tfe.Project{ID: projectID.(string)})
+	}
+
+	for _, workspaceID := range d.Get("workspace_exclusions").(*schema.Set).List() {
+		options.WorkspaceExclusions = append(options.WorkspaceExclusions, &tfe.Workspace{ID: workspaceID.(string)})
+	}
+
 	log.Printf("[DEBUG] Create policy set %s for organization: %s", name, organization)
 	policySet, err := tfeClient.PolicySets.Create(ctx, organization, options)
 	if err != nil {
@@ -190,7 +230,7 @@ func resourceTFEPolicySetCreate(d *schema.ResourceData, meta interface{}) error
 	_, hasVCSRepo := d.GetOk("vcs_repo")
 	_, hasSlug := d.GetOk("slug")
 	if hasSlug && !hasVCSRepo {
-		err := resourceTFEPolicySetUploadVersion(tfeClient, d, policySet.ID)
+		err := resourceTFEPolicySetUploadVersion(ctx, tfeClient, d, policySet.ID)
 		if err != nil {
 			return err
 		}
@@ -198,14 +238,23 @@ func resourceTFEPolicySetCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(policySet.ID)
 
-	return resourceTFEPolicySetRead(d, meta)
+	if d.Get("agent_enabled").(bool) {
+		log.Printf("[DEBUG] Enable agent evaluation for policy set: %s", policySet.ID)
+		if err := updatePolicySetAgentEnabled(ctx, tfeClient, policySet.ID, true); err != nil {
+			return fmt.Errorf("Error enabling agent evaluation for policy set %s: %w", policySet.ID, err)
+		}
+	}
+
+	return resourceTFEPolicySetRead(ctx, d, meta)
 }
 
-func resourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEPolicySetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read policy set: %s", d.Id())
-	policySet, err := tfeClient.PolicySets.Read(ctx, d.Id())
+	policySet, err := tfeClient.PolicySets.ReadWithOptions(ctx, d.Id(), &tfe.PolicySetReadOptions{
+		Include: []tfe.PolicySetIncludeOpt{tfe.PolicySetProjects, tfe.PolicySetWorkspaceExclusions},
+	})
 	if err != nil {
 		if err == tfe.ErrResourceNotFound {
 			log.Printf("[DEBUG] Policy set %s no longer exists", d.Id())
@@ -234,6 +283,16 @@ func resourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("overridable", policySet.Overridable)
 	}
 
+	// Note: Older versions of Terraform Enterprise don't support evaluating
+	// policies by agents, so keep the default in the schema
+	agentEnabled, supported, err := readPolicySetAgentEnabled(ctx, tfeClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading agent setting of policy set %s: %w", d.Id(), err)
+	}
+	if supported {
+		d.Set("agent_enabled", agentEnabled)
+	}
+
 	// Set VCS policy set options.
 	var vcsRepo []interface{}
 	if policySet.VCSRepo != nil {
@@ -274,11 +333,27 @@ func resourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("workspace_ids", workspaceIDs)
 
+	// Update the projects.
+	var projectIDs []interface{}
+	if !policySet.Global {
+		for _, project := range policySet.Projects {
+			projectIDs = append(projectIDs, project.ID)
+		}
+	}
+	d.Set("project_ids", projectIDs)
+
+	// Update the workspace exclusions.
+	var excludedWorkspaceIDs []interface{}
+	for _, workspace := range policySet.WorkspaceExclusions {
+		excludedWorkspaceIDs = append(excludedWorkspaceIDs, workspace.ID)
+	}
+	d.Set("workspace_exclusions", excludedWorkspaceIDs)
+
 	return nil
 }
 
-func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEPolicySetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	name := d.Get("name").(string)
 	global := d.Get("global").(bool)
@@ -303,6 +378,23 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 				return fmt.Errorf("Error detaching policy set %s from workspaces: %w", d.Id(), err)
 			}
 		}
+
+		// The same goes for the projects.
+		oldProjectIDs, _ := d.GetChange("project_ids")
+
+		if oldProjectIDs.(*schema.Set).Len() > 0 {
+			options := tfe.PolicySetRemoveProjectsOptions{}
+
+			for _, projectID := range oldProjectIDs.(*schema.Set).List() {
+				options.Projects = append(options.Projects, &tfe.Project{ID: projectID.(string)})
+			}
+
+			log.Printf("[DEBUG] Removing previous projects from now-global policy set: %s", d.Id())
+			err := tfeClient.PolicySets.RemoveProjects(ctx, d.Id(), options)
+			if err != nil {
+				return fmt.Errorf("Error detaching policy set %s from projects: %w", d.Id(), err)
+			}
+		}
 	}
 
 	// Don't bother updating the policy set's attributes if they haven't changed
@@ -377,9 +469,16 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("agent_enabled") {
+		log.Printf("[DEBUG] Update agent evaluation for policy set: %s", d.Id())
+		if err := updatePolicySetAgentEnabled(ctx, tfeClient, d.Id(), d.Get("agent_enabled").(bool)); err != nil {
+			return fmt.Errorf("Error updating agent evaluation for policy set %s: %w", d.Id(), err)
+		}
+	}
+
 	_, hasVCSRepo := d.GetOk("vcs_repo")
 	if d.HasChange("slug") && !hasVCSRepo {
-		err := resourceTFEPolicySetUploadVersion(tfeClient, d, d.Id())
+		err := resourceTFEPolicySetUploadVersion(ctx, tfeClient, d, d.Id())
 		if err != nil {
 			return err
 		}
@@ -424,11 +523,89 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return resourceTFEPolicySetRead(d, meta)
+	if !global && d.HasChange("project_ids") {
+		oldProjectIDValues, newProjectIDValues := d.GetChange("project_ids")
+		newProjectIDsSet := newProjectIDValues.(*schema.Set)
+		oldProjectIDsSet := oldProjectIDValues.(*schema.Set)
+
+		newProjectIDs := newProjectIDsSet.Difference(oldProjectIDsSet)
+		oldProjectIDs := oldProjectIDsSet.Difference(newProjectIDsSet)
+
+		// First add the new projects.
+		if newProjectIDs.Len() > 0 {
+			options := tfe.PolicySetAddProjectsOptions{}
+
+			for _, projectID := range newProjectIDs.List() {
+				options.Projects = append(options.Projects, &tfe.Project{ID: projectID.(string)})
+			}
+
+			log.Printf("[DEBUG] Attach policy set to projects: %s", d.Id())
+			err := tfeClient.PolicySets.AddProjects(ctx, d.Id(), options)
+			if err != nil {
+				return fmt.Errorf("Error attaching policy set %s to projects: %w", d.Id(), err)
+			}
+		}
+
+		// Then remove all the old projects.
+		if oldProjectIDs.Len() > 0 {
+			options := tfe.PolicySetRemoveProjectsOptions{}
+
+			for _, projectID := range oldProjectIDs.List() {
+				options.Projects = append(options.Projects, &tfe.Project{ID: projectID.(string)})
+			}
+
+			log.Printf("[DEBUG] Detach policy set from projects: %s", d.Id())
+			err := tfeClient.PolicySets.RemoveProjects(ctx, d.Id(), options)
+			if err != nil {
+				return fmt.Errorf("Error detaching policy set %s from projects: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("workspace_exclusions") {
+		oldExclusionValues, newExclusionValues := d.GetChange("workspace_exclusions")
+		newExclusionsSet := newExclusionValues.(*schema.Set)
+		oldExclusionsSet := oldExclusionValues.(*schema.Set)
+
+		newExclusions := newExclusionsSet.Difference(oldExclusionsSet)
+		oldExclusions := oldExclusionsSet.Difference(newExclusionsSet)
+
+		// First add the new exclusions.
+		if newExclusions.Len() > 0 {
+			options := tfe.PolicySetAddWorkspaceExclusionsOptions{}
+
+			for _, workspaceID := range newExclusions.List() {
+				options.WorkspaceExclusions = append(options.WorkspaceExclusions, &tfe.Workspace{ID: workspaceID.(string)})
+			}
+
+			log.Printf("[DEBUG] Exclude workspaces from policy set: %s", d.Id())
+			err := tfeClient.PolicySets.AddWorkspaceExclusions(ctx, d.Id(), options)
+			if err != nil {
+				return fmt.Errorf("Error excluding workspaces from policy set %s: %w", d.Id(), err)
+			}
+		}
+
+		// Then remove all the old exclusions.
+		if oldExclusions.Len() > 0 {
+			options := tfe.PolicySetRemoveWorkspaceExclusionsOptions{}
+
+			for _, workspaceID := range oldExclusions.List() {
+				options.WorkspaceExclusions = append(options.WorkspaceExclusions, &tfe.Workspace{ID: workspaceID.(string)})
+			}
+
+			log.Printf("[DEBUG] Remove workspace exclusions from policy set: %s", d.Id())
+			err := tfeClient.PolicySets.RemoveWorkspaceExclusions(ctx, d.Id(), options)
+			if err != nil {
+				return fmt.Errorf("Error removing workspace exclusions from policy set %s: %w", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceTFEPolicySetRead(ctx, d, meta)
 }
 
-func resourceTFEPolicySetDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEPolicySetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete policy set: %s", d.Id())
 	err := tfeClient.PolicySets.Delete(ctx, d.Id())
@@ -442,7 +619,7 @@ func resourceTFEPolicySetDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTFEPolicySetUploadVersion(client *tfe.Client, d *schema.ResourceData, policySetID string) error {
+func resourceTFEPolicySetUploadVersion(ctx context.Context, client *tfe.Client, d *schema.ResourceData, policySetID string) error {
 	log.Printf("[DEBUG] Create policy set version for policy set %s.", policySetID)
 	psv, err := client.PolicySetVersions.Create(ctx, policySetID)
 	if err != nil {
@@ -460,3 +637,17 @@ func resourceTFEPolicySetUploadVersion(client *tfe.Client, d *schema.ResourceDat
 
 	return nil
 }
+
+// validatePolicySetOverridable ensures that only OPA policy sets are marked
+// as overridable, as Sentinel policy sets use soft-mandatory policies instead.
+func validatePolicySetOverridable(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("kind") || !d.NewValueKnown("overridable") {
+		return nil
+	}
+
+	if d.Get("overridable").(bool) && tfe.PolicyKind(d.Get("kind").(string)) != tfe.OPA {
+		return fmt.Errorf("overridable can only be set for OPA policy sets")
+	}
+
+	return nil
+}
@@ -12,10 +12,10 @@ import (
 
 func resourceTFEPolicySetParameter() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEPolicySetParameterCreate,
-		Read:   resourceTFEPolicySetParameterRead,
-		Update: resourceTFEPolicySetParameterUpdate,
-		Delete: resourceTFEPolicySetParameterDelete,
+		CreateContext: crudContext(resourceTFEPolicySetParameterCreate),
+		ReadContext:   crudContext(resourceTFEPolicySetParameterRead),
+		UpdateContext: crudContext(resourceTFEPolicySetParameterUpdate),
+		DeleteContext: crudContext(resourceTFEPolicySetParameterDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEPolicySetParameterImporter,
 		},
@@ -45,11 +45,13 @@ func resourceTFEPolicySetParameter() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: forceRecreateResourceIf(),
 	}
 }
 
-func resourceTFEPolicySetParameterCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEPolicySetParameterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get key
 	key := d.Get("key").(string)
@@ -76,11 +78,11 @@ func resourceTFEPolicySetParameterCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(parameter.ID)
 
-	return resourceTFEPolicySetParameterRead(d, meta)
+	return resourceTFEPolicySetParameterRead(ctx, d, meta)
 }
 
-func resourceTFEPolicySetParameterRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEPolicySetParameterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	ps := d.Get("policy_set_id").(string)
 	policySet, err := tfeClient.PolicySets.Read(ctx, ps)
@@ -111,8 +113,8 @@ func resourceTFEPolicySetParameterRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTFEPolicySetParameterUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEPolicySetParameterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	ps := d.Get("policy_set_id").(string)
 	policySet, err := tfeClient.PolicySets.Read(ctx, ps)
@@ -133,11 +135,11 @@ func resourceTFEPolicySetParameterUpdate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error updating parameter %s: %w", d.Id(), err)
 	}
 
-	return resourceTFEPolicySetParameterRead(d, meta)
+	return resourceTFEPolicySetParameterRead(ctx, d, meta)
 }
 
-func resourceTFEPolicySetParameterDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEPolicySetParameterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	ps := d.Get("policy_set_id").(string)
 	policySet, err := tfeClient.PolicySets.Read(ctx, ps)
@@ -161,7 +163,7 @@ func resourceTFEPolicySetParameterImporter(ctx context.Context, d *schema.Resour
 	s := strings.SplitN(d.Id(), "/", 2)
 	if len(s) != 2 {
 		return nil, fmt.Errorf(
-			"invalid parameter import format: %s (expected <POLICY SET ID>/<PARAMETER ID>)",
+			"invalid parameter import format: %s (expected <POLICY SET ID>/<PARAMETER ID> or <POLICY SET ID>/<PARAMETER KEY>)",
 			d.Id(),
 		)
 	}
@@ -170,5 +172,45 @@ func resourceTFEPolicySetParameterImporter(ctx context.Context, d *schema.Resour
 	d.Set("policy_set_id", s[0])
 	d.SetId(s[1])
 
+	// Parameters can also be imported by key, in which case the ID has to be
+	// looked up.
+	if !strings.HasPrefix(s[1], "var-") {
+		tfeClient := meta.(ConfiguredClient).Client
+
+		id, err := fetchPolicySetParameterID(ctx, tfeClient, s[0], s[1])
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(id)
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
+
+// fetchPolicySetParameterID returns the ID of the parameter of a policy set
+// with the given key.
+func fetchPolicySetParameterID(ctx context.Context, client *tfe.Client, policySetID, key string) (string, error) {
+	options := &tfe.PolicySetParameterListOptions{}
+	for {
+		l, err := client.PolicySetParameters.List(ctx, policySetID, options)
+		if err != nil {
+			return "", fmt.Errorf("Error retrieving parameters of policy set %s: %w", policySetID, err)
+		}
+
+		for _, parameter := range l.Items {
+			if parameter.Key == key {
+				return parameter.ID, nil
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return "", fmt.Errorf("could not find parameter %s of policy set %s", key, policySetID)
+}
@@ -92,6 +92,52 @@ func TestAccTFEPolicySetParameter_update(t *testing.T) {
 	})
 }
 
+func TestAccTFEPolicySetParameter_unsetSensitive(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	sensitiveParameter := &tfe.PolicySetParameter{}
+	parameter := &tfe.PolicySetParameter{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEPolicySetParameterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEPolicySetParameter_update(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicySetParameterExists(
+						"tfe_policy_set_parameter.foobar", sensitiveParameter),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set_parameter.foobar", "sensitive", "true"),
+				),
+			},
+
+			{
+				Config: testAccTFEPolicySetParameter_basic(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicySetParameterExists(
+						"tfe_policy_set_parameter.foobar", parameter),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set_parameter.foobar", "sensitive", "false"),
+					func(_ *terraform.State) error {
+						if parameter.ID == sensitiveParameter.ID {
+							return fmt.Errorf("expected parameter %s to be recreated", parameter.ID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEPolicySetParameter_import(t *testing.T) {
 	tfeClient, err := getClientUsingEnv()
 	if err != nil {
@@ -122,6 +168,19 @@ func TestAccTFEPolicySetParameter_import(t *testing.T) {
 				},
 				ImportStateVerify: true,
 			},
+
+			{
+				ResourceName: "tfe_policy_set_parameter.foobar",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					resources := s.RootModule().Resources
+					policySet := resources["tfe_policy_set.foobar"]
+					param := resources["tfe_policy_set_parameter.foobar"]
+
+					return fmt.Sprintf("%s/%s", policySet.Primary.ID, param.Primary.Attributes["key"]), nil
+				},
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -129,7 +188,7 @@ func TestAccTFEPolicySetParameter_import(t *testing.T) {
 func testAccCheckTFEPolicySetParameterExists(
 	n string, parameter *tfe.PolicySetParameter) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -190,7 +249,7 @@ func testAccCheckTFEPolicySetParameterAttributesUpdate(
 }
 
 func testAccCheckTFEPolicySetParameterDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_policy_set_parameter" {
@@ -139,6 +139,60 @@ func TestAccTFEPolicySet_updateOverridable(t *testing.T) {
 	})
 }
 
+func TestAccTFEPolicySet_agentEnabled(t *testing.T) {
+	skipIfEnterprise(t)
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	policySet := &tfe.PolicySet{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEPolicySetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEPolicySetOPA_agentEnabled(org.Name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicySetExists("tfe_policy_set.foobar", policySet),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "kind", "opa"),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "agent_enabled", "true"),
+				),
+			},
+
+			{
+				Config: testAccTFEPolicySetOPA_agentEnabled(org.Name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicySetExists("tfe_policy_set.foobar", policySet),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "agent_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEPolicySet_overridableSentinel(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFEPolicySet_overridableSentinel("my-org"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`overridable can only be set for OPA policy sets`),
+			},
+		},
+	})
+}
+
 func TestAccTFEPolicySet_update(t *testing.T) {
 	tfeClient, err := getClientUsingEnv()
 	if err != nil {
@@ -347,6 +401,60 @@ func TestAccTFEPolicySet_updateToGlobal(t *testing.T) {
 	})
 }
 
+func TestAccTFEPolicySet_projectsAndExclusions(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	policySet := &tfe.PolicySet{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEPolicySetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEPolicySet_projects(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicySetExists("tfe_policy_set.foobar", policySet),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "project_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "workspace_exclusions.#", "0"),
+				),
+			},
+
+			{
+				Config: testAccTFEPolicySet_projectsWithExclusions(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicySetExists("tfe_policy_set.foobar", policySet),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "project_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "workspace_exclusions.#", "1"),
+				),
+			},
+
+			{
+				Config: testAccTFEPolicySet_globalWithExclusions(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicySetExists("tfe_policy_set.foobar", policySet),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "global", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "project_ids.#", "0"),
+					resource.TestCheckResourceAttr(
+						"tfe_policy_set.foobar", "workspace_exclusions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEPolicySet_updateToWorkspace(t *testing.T) {
 	tfeClient, err := getClientUsingEnv()
 	if err != nil {
@@ -743,7 +851,7 @@ func TestAccTFEPolicySetImport(t *testing.T) {
 
 func testAccCheckTFEPolicySetExists(n string, policySet *tfe.PolicySet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -789,7 +897,7 @@ func testAccCheckTFEPolicySetAttributes(policySet *tfe.PolicySet) resource.TestC
 
 func testAccCheckTFEPolicySetPopulated(policySet *tfe.PolicySet, orgName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		if policySet.Name != "terraform-populated" {
 			return fmt.Errorf("Bad name: %s", policySet.Name)
@@ -825,7 +933,7 @@ func testAccCheckTFEPolicySetPopulated(policySet *tfe.PolicySet, orgName string)
 
 func testAccCheckTFEPolicySetPopulatedUpdated(policySet *tfe.PolicySet, orgName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		if policySet.Name != "terraform-populated-updated" {
 			return fmt.Errorf("Bad name: %s", policySet.Name)
@@ -861,7 +969,7 @@ func testAccCheckTFEPolicySetPopulatedUpdated(policySet *tfe.PolicySet, orgName
 
 func testAccCheckTFEPolicySetGlobal(policySet *tfe.PolicySet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		if policySet.Name != "terraform-global" {
 			return fmt.Errorf("Bad name: %s", policySet.Name)
@@ -891,7 +999,7 @@ func testAccCheckTFEPolicySetGlobal(policySet *tfe.PolicySet) resource.TestCheck
 }
 
 func testAccCheckTFEPolicySetDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_policy_set" {
@@ -938,6 +1046,27 @@ resource "tfe_policy_set" "foobar" {
 }`, organization)
 }
 
+func testAccTFEPolicySetOPA_agentEnabled(organization string, agentEnabled bool) string {
+	return fmt.Sprintf(`
+resource "tfe_policy_set" "foobar" {
+  name          = "tst-terraform"
+  description   = "Policy Set"
+  organization  = "%s"
+  kind          = "opa"
+  agent_enabled = %t
+}`, organization, agentEnabled)
+}
+
+func testAccTFEPolicySet_overridableSentinel(organization string) string {
+	return fmt.Sprintf(`
+resource "tfe_policy_set" "foobar" {
+  name         = "tst-terraform"
+  organization = "%s"
+  kind         = "sentinel"
+  overridable  = true
+}`, organization)
+}
+
 func testAccTFEPolicySet_empty(organization string) string {
 	return fmt.Sprintf(`
  resource "tfe_policy_set" "foobar" {
@@ -992,6 +1121,77 @@ resource "tfe_policy_set" "foobar" {
 }`, organization)
 }
 
+func testAccTFEPolicySet_projects(organization string) string {
+	return fmt.Sprintf(`
+locals {
+  organization_name = "%s"
+}
+
+resource "tfe_project" "foo" {
+  name         = "project-foo"
+  organization = local.organization_name
+}
+
+resource "tfe_workspace" "foo" {
+  name         = "workspace-foo"
+  organization = local.organization_name
+}
+
+resource "tfe_policy_set" "foobar" {
+  name         = "tst-terraform"
+  organization = local.organization_name
+  project_ids  = [tfe_project.foo.id]
+}`, organization)
+}
+
+func testAccTFEPolicySet_projectsWithExclusions(organization string) string {
+	return fmt.Sprintf(`
+locals {
+  organization_name = "%s"
+}
+
+resource "tfe_project" "foo" {
+  name         = "project-foo"
+  organization = local.organization_name
+}
+
+resource "tfe_workspace" "foo" {
+  name         = "workspace-foo"
+  organization = local.organization_name
+}
+
+resource "tfe_policy_set" "foobar" {
+  name                 = "tst-terraform"
+  organization         = local.organization_name
+  project_ids          = [tfe_project.foo.id]
+  workspace_exclusions = [tfe_workspace.foo.id]
+}`, organization)
+}
+
+func testAccTFEPolicySet_globalWithExclusions(organization string) string {
+	return fmt.Sprintf(`
+locals {
+  organization_name = "%s"
+}
+
+resource "tfe_project" "foo" {
+  name         = "project-foo"
+  organization = local.organization_name
+}
+
+resource "tfe_workspace" "foo" {
+  name         = "workspace-foo"
+  organization = local.organization_name
+}
+
+resource "tfe_policy_set" "foobar" {
+  name                 = "tst-terraform"
+  organization         = local.organization_name
+  global               = true
+  workspace_exclusions = [tfe_workspace.foo.id]
+}`, organization)
+}
+
 func testAccTFEPolicySet_updatePopulated(organization string) string {
 	return fmt.Sprintf(`
 locals {
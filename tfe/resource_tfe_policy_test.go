@@ -2,6 +2,7 @@ package tfe
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	tfe "github.com/hashicorp/go-tfe"
@@ -300,6 +301,116 @@ func TestAccTFEPolicyOPA_update(t *testing.T) {
 	})
 }
 
+func TestAccTFEPolicyOPA_updateQuery(t *testing.T) {
+	skipUnlessBeta(t)
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	policy := &tfe.Policy{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEPolicyOPA_basic(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicyExists(
+						"tfe_policy.foobar", policy),
+					resource.TestCheckResourceAttr(
+						"tfe_policy.foobar", "query", "data.example.rule"),
+				),
+			},
+
+			{
+				Config: testAccTFEPolicyOPA_updateQuery(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEPolicyExists(
+						"tfe_policy.foobar", policy),
+					resource.TestCheckResourceAttr(
+						"tfe_policy.foobar", "query", "data.example.other_rule"),
+					resource.TestCheckResourceAttr(
+						"tfe_policy.foobar", "enforce_mode", "mandatory"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEPolicy_invalidKindOptions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFEPolicyOPA_missingQuery("my-org"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`query must be set for OPA policies`),
+			},
+			{
+				Config:      testAccTFEPolicyOPA_invalidEnforceMode("my-org"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`enforce_mode "hard-mandatory" is not valid for opa policies`),
+			},
+		},
+	})
+}
+
+func TestValidatePolicyKindOptions(t *testing.T) {
+	cases := map[string]struct {
+		kind        tfe.PolicyKind
+		query       string
+		enforceMode string
+		err         bool
+	}{
+		"sentinel default": {
+			kind: tfe.Sentinel,
+		},
+		"sentinel hard-mandatory": {
+			kind:        tfe.Sentinel,
+			enforceMode: "hard-mandatory",
+		},
+		"sentinel mandatory": {
+			kind:        tfe.Sentinel,
+			enforceMode: "mandatory",
+			err:         true,
+		},
+		"sentinel with query": {
+			kind:  tfe.Sentinel,
+			query: "data.example.rule",
+			err:   true,
+		},
+		"opa mandatory": {
+			kind:        tfe.OPA,
+			query:       "data.example.rule",
+			enforceMode: "mandatory",
+		},
+		"opa soft-mandatory": {
+			kind:        tfe.OPA,
+			query:       "data.example.rule",
+			enforceMode: "soft-mandatory",
+			err:         true,
+		},
+		"opa without query": {
+			kind: tfe.OPA,
+			err:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		err := validatePolicyKindOptions(tc.kind, tc.query, tc.enforceMode)
+		if (err != nil) != tc.err {
+			t.Fatalf("%s: expected error is %t, got %v", name, tc.err, err)
+		}
+	}
+}
+
 func TestAccTFEPolicy_import(t *testing.T) {
 	skipUnlessBeta(t)
 	tfeClient, err := getClientUsingEnv()
@@ -332,7 +443,7 @@ func TestAccTFEPolicy_import(t *testing.T) {
 func testAccCheckTFEPolicyExists(
 	n string, policy *tfe.Policy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -440,7 +551,7 @@ func testAccCheckTFEOPAPolicyAttributesUpdated(
 }
 
 func testAccCheckTFEPolicyDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_policy" {
@@ -527,3 +638,38 @@ resource "tfe_policy" "foobar" {
   enforce_mode = "advisory"
 }`, organization)
 }
+
+func testAccTFEPolicyOPA_updateQuery(organization string) string {
+	return fmt.Sprintf(`
+resource "tfe_policy" "foobar" {
+  name         = "policy-test"
+  description  = "A test policy"
+  organization = "%s"
+  kind         = "opa"
+  policy       = "package example rule[\"not allowed\"] { false }"
+  query        = "data.example.other_rule"
+  enforce_mode = "mandatory"
+}`, organization)
+}
+
+func testAccTFEPolicyOPA_missingQuery(organization string) string {
+	return fmt.Sprintf(`
+resource "tfe_policy" "foobar" {
+  name         = "policy-test"
+  organization = "%s"
+  kind         = "opa"
+  policy       = "package example rule[\"not allowed\"] { false }"
+}`, organization)
+}
+
+func testAccTFEPolicyOPA_invalidEnforceMode(organization string) string {
+	return fmt.Sprintf(`
+resource "tfe_policy" "foobar" {
+  name         = "policy-test"
+  organization = "%s"
+  kind         = "opa"
+  policy       = "package example rule[\"not allowed\"] { false }"
+  query        = "data.example.rule"
+  enforce_mode = "hard-mandatory"
+}`, organization)
+}
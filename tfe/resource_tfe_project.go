@@ -3,10 +3,12 @@ package tfe
 import (
 	"context"
 	"errors"
+	"fmt"
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
+	"net/url"
 )
 
 func resourceTFEProject() *schema.Resource {
@@ -19,6 +21,17 @@ func resourceTFEProject() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: func(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := customizeDiffDefaultOrganization(c, d, meta); err != nil {
+				return err
+			}
+
+			if !d.NewValueKnown("name") {
+				return nil
+			}
+			return validateNamePattern(meta.(ConfiguredClient).ProjectNamePattern, "Project", d.Get("name").(string))
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -27,17 +40,32 @@ func resourceTFEProject() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func resourceTFEProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
-	organizationName := d.Get("organization").(string)
+	organizationName, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	name := d.Get("name").(string)
 
 	options := tfe.ProjectCreateOptions{
@@ -56,7 +84,7 @@ func resourceTFEProjectCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceTFEProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read configuration of project: %s", d.Id())
 	project, err := tfeClient.Projects.Read(ctx, d.Id())
@@ -71,12 +99,17 @@ func resourceTFEProjectRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.Set("name", project.Name)
 	d.Set("organization", project.Organization.Name)
+	d.Set("html_url", meta.(ConfiguredClient).htmlURL(fmt.Sprintf("/app/%s/projects/%s", url.PathEscape(project.Organization.Name), project.ID)))
+
+	if err := readProjectTagBindings(ctx, tfeClient, d); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
 
 func resourceTFEProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	options := tfe.ProjectUpdateOptions{
 		Name: tfe.String(d.Get("name").(string)),
@@ -90,11 +123,18 @@ func resourceTFEProjectUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(project.ID)
 
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		if err := updateTagBindings(ctx, tfeClient, "projects", d.Id(), oldTags.(map[string]interface{}), newTags.(map[string]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceTFEProjectRead(ctx, d, meta)
 }
 
 func resourceTFEProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete project: %s", d.Id())
 	err := tfeClient.Projects.Delete(ctx, d.Id())
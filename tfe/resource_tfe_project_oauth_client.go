@@ -0,0 +1,125 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTFEProjectOAuthClient() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEProjectOAuthClientCreate),
+		ReadContext:   crudContext(resourceTFEProjectOAuthClientRead),
+		DeleteContext: crudContext(resourceTFEProjectOAuthClientDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFEProjectOAuthClientImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"oauth_client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTFEProjectOAuthClientCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	oauthClientID := d.Get("oauth_client_id").(string)
+	projectID := d.Get("project_id").(string)
+
+	log.Printf("[DEBUG] Add project %s to OAuth client %s", projectID, oauthClientID)
+	err := tfeClient.OAuthClients.AddProjects(ctx, oauthClientID, tfe.OAuthClientAddProjectsOptions{
+		Projects: []*tfe.Project{{ID: projectID}},
+	})
+	if err != nil {
+		return fmt.Errorf(
+			"Error adding project %s to OAuth client %s: %w", projectID, oauthClientID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", oauthClientID, projectID))
+
+	return resourceTFEProjectOAuthClientRead(ctx, d, meta)
+}
+
+func resourceTFEProjectOAuthClientRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	oauthClientID := d.Get("oauth_client_id").(string)
+	projectID := d.Get("project_id").(string)
+
+	log.Printf("[DEBUG] Read configuration of OAuth client: %s", oauthClientID)
+	oc, err := tfeClient.OAuthClients.Read(ctx, oauthClientID)
+	if err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			log.Printf("[DEBUG] OAuth client %s no longer exists", oauthClientID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of OAuth client %s: %w", oauthClientID, err)
+	}
+
+	for _, project := range oc.Projects {
+		if project.ID == projectID {
+			d.Set("oauth_client_id", oauthClientID)
+			d.Set("project_id", projectID)
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] Project %s not added to OAuth client %s. Removing from state.", projectID, oauthClientID)
+	d.SetId("")
+	return nil
+}
+
+func resourceTFEProjectOAuthClientDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	oauthClientID := d.Get("oauth_client_id").(string)
+	projectID := d.Get("project_id").(string)
+
+	log.Printf("[DEBUG] Remove project %s from OAuth client %s", projectID, oauthClientID)
+	err := tfeClient.OAuthClients.RemoveProjects(ctx, oauthClientID, tfe.OAuthClientRemoveProjectsOptions{
+		Projects: []*tfe.Project{{ID: projectID}},
+	})
+	if err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			return nil
+		}
+		return fmt.Errorf(
+			"Error removing project %s from OAuth client %s: %w", projectID, oauthClientID, err)
+	}
+
+	return nil
+}
+
+func resourceTFEProjectOAuthClientImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The format of the import ID is <OAUTH CLIENT ID>/<PROJECT ID>
+	oauthClientID, projectID, ok := strings.Cut(d.Id(), "/")
+	if !ok || oauthClientID == "" || projectID == "" {
+		return nil, fmt.Errorf(
+			"invalid project OAuth client input format: %s (expected <OAUTH CLIENT ID>/<PROJECT ID>)",
+			d.Id(),
+		)
+	}
+
+	d.Set("oauth_client_id", oauthClientID)
+	d.Set("project_id", projectID)
+	d.SetId(fmt.Sprintf("%s_%s", oauthClientID, projectID))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,130 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEProjectOAuthClient_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if GITHUB_TOKEN == "" {
+				t.Skip("Please set GITHUB_TOKEN to run this test")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEProjectOAuthClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEProjectOAuthClient_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEProjectOAuthClientExists("tfe_project_oauth_client.foobar"),
+					resource.TestCheckResourceAttr(
+						"tfe_oauth_client.foobar", "organization_scoped", "false"),
+				),
+			},
+			{
+				ResourceName:      "tfe_project_oauth_client.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccTFEProjectOAuthClientImportID("tfe_project_oauth_client.foobar"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTFEProjectOAuthClientImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["oauth_client_id"], rs.Primary.Attributes["project_id"]), nil
+	}
+}
+
+func testAccCheckTFEProjectOAuthClientExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		oauthClientID := rs.Primary.Attributes["oauth_client_id"]
+		projectID := rs.Primary.Attributes["project_id"]
+
+		oc, err := tfeClient.OAuthClients.Read(ctx, oauthClientID)
+		if err != nil {
+			return fmt.Errorf("error reading OAuth client %s: %w", oauthClientID, err)
+		}
+		for _, project := range oc.Projects {
+			if project.ID == projectID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Project (%s) is not added to OAuth client (%s).", projectID, oauthClientID)
+	}
+}
+
+func testAccCheckTFEProjectOAuthClientDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_oauth_client" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := tfeClient.OAuthClients.Read(ctx, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("OAuth client %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEProjectOAuthClient_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "foobar" {
+  name         = "tst-project-%d"
+  organization = tfe_organization.foobar.name
+}
+
+resource "tfe_oauth_client" "foobar" {
+  organization        = tfe_organization.foobar.name
+  api_url             = "https://api.github.com"
+  http_url            = "https://github.com"
+  oauth_token         = "%s"
+  service_provider    = "github"
+  organization_scoped = false
+}
+
+resource "tfe_project_oauth_client" "foobar" {
+  oauth_client_id = tfe_oauth_client.foobar.id
+  project_id      = tfe_project.foobar.id
+}`, rInt, rInt, GITHUB_TOKEN)
+}
@@ -3,6 +3,7 @@ package tfe
 import (
 	"fmt"
 	"math/rand"
+	"regexp"
 	"testing"
 	"time"
 
@@ -39,6 +40,22 @@ func TestAccTFEProject_basic(t *testing.T) {
 	})
 }
 
+func TestAccTFEProject_namePattern(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFEProject_namePattern(rInt),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Project name "projecttest" does not match the naming convention`),
+			},
+		},
+	})
+}
+
 func TestAccTFEProject_update(t *testing.T) {
 	skipUnlessBeta(t)
 
@@ -74,6 +91,35 @@ func TestAccTFEProject_update(t *testing.T) {
 	})
 }
 
+func TestAccTFEProject_tags(t *testing.T) {
+	skipUnlessBeta(t)
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEProject_tags(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_project.foobar", "tags.env", "prod"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "tags.%", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "tags.owner", "platform"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "effective_tags.env", "prod"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "effective_tags.owner", "platform"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEProject_import(t *testing.T) {
 	skipUnlessBeta(t)
 
@@ -118,6 +164,23 @@ resource "tfe_project" "foobar" {
 }`, rInt)
 }
 
+func testAccTFEProject_namePattern(rInt int) string {
+	return fmt.Sprintf(`
+provider "tfe" {
+  project_name_pattern = "^team-"
+}
+
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "foobar" {
+  organization = tfe_organization.foobar.name
+  name = "projecttest"
+}`, rInt)
+}
+
 func testAccTFEProject_basic(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
@@ -131,8 +194,35 @@ resource "tfe_project" "foobar" {
 }`, rInt)
 }
 
+func testAccTFEProject_tags(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "foobar" {
+  organization = tfe_organization.foobar.name
+  name         = "projecttest"
+
+  tags = {
+    env = "prod"
+  }
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.name
+  project_id   = tfe_project.foobar.id
+
+  tags = {
+    owner = "platform"
+  }
+}`, rInt)
+}
+
 func testAccCheckTFEProjectDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_project" {
@@ -154,7 +244,7 @@ func testAccCheckTFEProjectDestroy(s *terraform.State) error {
 
 func testAccCheckTFEProjectExists(n string, project *tfe.Project) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -15,10 +15,10 @@ import (
 
 func resourceTFERegistryModule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFERegistryModuleCreate,
-		Read:   resourceTFERegistryModuleRead,
-		Update: resourceTFERegistryModuleUpdate,
-		Delete: resourceTFERegistryModuleDelete,
+		CreateContext: crudContext(resourceTFERegistryModuleCreate),
+		ReadContext:   crudContext(resourceTFERegistryModuleRead),
+		UpdateContext: crudContext(resourceTFERegistryModuleUpdate),
+		DeleteContext: crudContext(resourceTFERegistryModuleDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFERegistryModuleImporter,
 		},
@@ -36,7 +36,7 @@ func resourceTFERegistryModule() *schema.Resource {
 				Computed:     true,
 				ForceNew:     true,
 				ExactlyOneOf: []string{"vcs_repo"},
-				RequiredWith: []string{"organization", "name"},
+				RequiredWith: []string{"name"},
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -47,7 +47,6 @@ func resourceTFERegistryModule() *schema.Resource {
 			"vcs_repo": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MinItems: 1,
 				MaxItems: 1,
 				Elem: &schema.Resource{
@@ -67,9 +66,36 @@ func resourceTFERegistryModule() *schema.Resource {
 							Required: true,
 							ForceNew: true,
 						},
+						"branch": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"initial_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"vcs_repo.0.branch"},
+			},
+			"test_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tests_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
 					},
 				},
 			},
+			"publishing_mechanism": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"namespace": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -97,8 +123,8 @@ func resourceTFERegistryModule() *schema.Resource {
 	}
 }
 
-func resourceTFERegistryModuleCreateWithVCS(v interface{}, meta interface{}) (*tfe.RegistryModule, error) {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFERegistryModuleCreateWithVCS(ctx context.Context, v interface{}, meta interface{}, d *schema.ResourceData) (*tfe.RegistryModule, error) {
+	tfeClient := meta.(ConfiguredClient).Client
 	// Create module with VCS repo configuration block.
 	options := tfe.RegistryModuleCreateWithVCSConnectionOptions{}
 	vcsRepo := v.([]interface{})[0].(map[string]interface{})
@@ -109,6 +135,28 @@ func resourceTFERegistryModuleCreateWithVCS(v interface{}, meta interface{}) (*t
 		DisplayIdentifier: tfe.String(vcsRepo["display_identifier"].(string)),
 	}
 
+	// Branch-based modules are created within an organization, so it must be
+	// known up front.
+	if branch, ok := vcsRepo["branch"].(string); ok && branch != "" {
+		orgName, err := meta.(ConfiguredClient).organizationName(d)
+		if err != nil {
+			return nil, fmt.Errorf("organization must be set when creating a branch-based registry module: %w", err)
+		}
+
+		options.VCSRepo.Branch = tfe.String(branch)
+		options.VCSRepo.OrganizationName = tfe.String(orgName)
+
+		if initialVersion, ok := d.GetOk("initial_version"); ok {
+			options.InitialVersion = tfe.String(initialVersion.(string))
+		}
+	}
+
+	if testsEnabled, ok := registryModuleTestsEnabled(d); ok {
+		options.TestConfig = &tfe.RegistryModuleTestConfigOptions{
+			TestsEnabled: tfe.Bool(testsEnabled),
+		}
+	}
+
 	log.Printf("[DEBUG] Create registry module from repository %s", *options.VCSRepo.Identifier)
 	registryModule, err := tfeClient.RegistryModules.CreateWithVCSConnection(ctx, options)
 	if err != nil {
@@ -118,13 +166,13 @@ func resourceTFERegistryModuleCreateWithVCS(v interface{}, meta interface{}) (*t
 	return registryModule, nil
 }
 
-func resourceTFERegistryModuleCreateWithoutVCS(meta interface{}, d *schema.ResourceData) (*tfe.RegistryModule, error) {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFERegistryModuleCreateWithoutVCS(ctx context.Context, meta interface{}, d *schema.ResourceData) (*tfe.RegistryModule, error) {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	options := tfe.RegistryModuleCreateOptions{
 		Name:     tfe.String(d.Get("name").(string)),
 		Provider: tfe.String(d.Get("module_provider").(string)),
-		NoCode:   d.Get("no_code").(bool),
+		NoCode:   tfe.Bool(d.Get("no_code").(bool)),
 	}
 
 	if registryName, ok := d.GetOk("registry_name"); ok {
@@ -135,7 +183,10 @@ func resourceTFERegistryModuleCreateWithoutVCS(meta interface{}, d *schema.Resou
 		}
 	}
 
-	orgName := d.Get("organization").(string)
+	orgName, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return nil, err
+	}
 
 	log.Printf("[DEBUG] Create registry module named %s", *options.Name)
 	registryModule, err := tfeClient.RegistryModules.Create(ctx, orgName, options)
@@ -147,16 +198,16 @@ func resourceTFERegistryModuleCreateWithoutVCS(meta interface{}, d *schema.Resou
 	return registryModule, nil
 }
 
-func resourceTFERegistryModuleCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFERegistryModuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	var registryModule *tfe.RegistryModule
 	var err error
 
 	if v, ok := d.GetOk("vcs_repo"); ok {
-		registryModule, err = resourceTFERegistryModuleCreateWithVCS(v, meta)
+		registryModule, err = resourceTFERegistryModuleCreateWithVCS(ctx, v, meta, d)
 	} else {
-		registryModule, err = resourceTFERegistryModuleCreateWithoutVCS(meta, d)
+		registryModule, err = resourceTFERegistryModuleCreateWithoutVCS(ctx, meta, d)
 	}
 
 	if err != nil {
@@ -194,15 +245,33 @@ func resourceTFERegistryModuleCreate(d *schema.ResourceData, meta interface{}) e
 	d.Set("namespace", registryModule.Namespace)
 	d.Set("registry_name", registryModule.RegistryName)
 
-	return resourceTFERegistryModuleRead(d, meta)
+	return resourceTFERegistryModuleRead(ctx, d, meta)
 }
 
-func resourceTFERegistryModuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFERegistryModuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	options := tfe.RegistryModuleUpdateOptions{
 		NoCode: tfe.Bool(d.Get("no_code").(bool)),
 	}
+
+	if d.HasChange("vcs_repo.0.branch") {
+		options.VCSRepo = &tfe.RegistryModuleVCSRepoUpdateOptions{
+			Branch: tfe.String(d.Get("vcs_repo.0.branch").(string)),
+		}
+		// Clearing the branch switches the module back to tag-based publishing.
+		if *options.VCSRepo.Branch == "" {
+			options.VCSRepo.Branch = nil
+			options.VCSRepo.Tags = tfe.Bool(true)
+		}
+	}
+
+	if d.HasChange("test_config") {
+		testsEnabled, _ := registryModuleTestsEnabled(d)
+		options.TestConfig = &tfe.RegistryModuleTestConfigOptions{
+			TestsEnabled: tfe.Bool(testsEnabled),
+		}
+	}
 	var registryModule *tfe.RegistryModule
 	var err error
 
@@ -228,11 +297,11 @@ func resourceTFERegistryModuleUpdate(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(registryModule.ID)
 
-	return resourceTFERegistryModuleRead(d, meta)
+	return resourceTFERegistryModuleRead(ctx, d, meta)
 }
 
-func resourceTFERegistryModuleRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFERegistryModuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read registry module: %s", d.Id())
 
@@ -263,6 +332,7 @@ func resourceTFERegistryModuleRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("namespace", registryModule.Namespace)
 	d.Set("registry_name", registryModule.RegistryName)
 	d.Set("no_code", registryModule.NoCode)
+	d.Set("publishing_mechanism", registryModule.PublishingMechanism)
 
 	// Set VCS repo options.
 	var vcsRepo []interface{}
@@ -271,17 +341,27 @@ func resourceTFERegistryModuleRead(d *schema.ResourceData, meta interface{}) err
 			"identifier":         registryModule.VCSRepo.Identifier,
 			"oauth_token_id":     registryModule.VCSRepo.OAuthTokenID,
 			"display_identifier": registryModule.VCSRepo.DisplayIdentifier,
+			"branch":             registryModule.VCSRepo.Branch,
 		}
 		vcsRepo = append(vcsRepo, vcsConfig)
 
 		d.Set("vcs_repo", vcsRepo)
 	}
 
+	// Only track the test config if it is managed by the configuration.
+	if _, ok := d.GetOk("test_config"); ok && registryModule.TestConfig != nil {
+		d.Set("test_config", []interface{}{
+			map[string]interface{}{
+				"tests_enabled": registryModule.TestConfig.TestsEnabled,
+			},
+		})
+	}
+
 	return nil
 }
 
-func resourceTFERegistryModuleDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFERegistryModuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete registry module: %s", d.Id())
 	organization := d.Get("organization").(string)
@@ -297,6 +377,23 @@ func resourceTFERegistryModuleDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// registryModuleTestsEnabled returns whether tests are enabled in the
+// test_config block, and whether the block is configured at all.
+func registryModuleTestsEnabled(d *schema.ResourceData) (bool, bool) {
+	v, ok := d.GetOk("test_config")
+	if !ok {
+		return false, false
+	}
+
+	testConfigs := v.([]interface{})
+	if len(testConfigs) == 0 || testConfigs[0] == nil {
+		return false, true
+	}
+
+	testConfig := testConfigs[0].(map[string]interface{})
+	return testConfig["tests_enabled"].(bool), true
+}
+
 func resourceTFERegistryModuleImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	registryModuleInfo := strings.SplitN(d.Id(), "/", 6)
 	if len(registryModuleInfo) == 4 {
@@ -70,6 +70,41 @@ func TestAccTFERegistryModule_vcs(t *testing.T) {
 	})
 }
 
+func TestAccTFERegistryModule_branchOnly(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckTFERegistryModule(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFERegistryModuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERegistryModule_branchOnly(rInt, "main", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_registry_module.foobar", "vcs_repo.0.branch", "main"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_module.foobar", "publishing_mechanism", string(tfe.PublishingMechanismBranch)),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_module.foobar", "test_config.0.tests_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_module.foobar", "initial_version", "1.0.0"),
+				),
+			},
+			{
+				Config: testAccTFERegistryModule_branchOnly(rInt, "main", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_registry_module.foobar", "test_config.0.tests_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFERegistryModule_emptyVCSRepo(t *testing.T) {
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
 
@@ -475,7 +510,7 @@ func TestAccTFERegistryModule_invalidWithRegistryNameAndNoModuleProvider(t *test
 }
 func testAccCheckTFERegistryModuleExists(n string, rmID tfe.RegistryModuleID, registryModule *tfe.RegistryModule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -550,7 +585,7 @@ func testAccCheckTFERegistryModuleVCSAttributes(registryModule *tfe.RegistryModu
 }
 
 func testAccCheckTFERegistryModuleDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_registry_module" {
@@ -660,6 +695,44 @@ resource "tfe_registry_module" "foobar" {
 		GITHUB_REGISTRY_MODULE_IDENTIFIER)
 }
 
+func testAccTFERegistryModule_branchOnly(rInt int, branch string, testsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_oauth_client" "foobar" {
+  organization     = tfe_organization.foobar.name
+  api_url          = "https://api.github.com"
+  http_url         = "https://github.com"
+  oauth_token      = "%s"
+  service_provider = "github"
+}
+
+resource "tfe_registry_module" "foobar" {
+  organization    = tfe_organization.foobar.name
+  initial_version = "1.0.0"
+
+  vcs_repo {
+    display_identifier = "%s"
+    identifier         = "%s"
+    oauth_token_id     = tfe_oauth_client.foobar.oauth_token_id
+    branch             = "%s"
+  }
+
+  test_config {
+    tests_enabled = %t
+  }
+}`,
+		rInt,
+		GITHUB_TOKEN,
+		GITHUB_REGISTRY_MODULE_IDENTIFIER,
+		GITHUB_REGISTRY_MODULE_IDENTIFIER,
+		branch,
+		testsEnabled)
+}
+
 func testAccTFERegistryModule_emptyVCSRepo(rInt int, token string) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
@@ -0,0 +1,269 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTFERegistryModuleVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFERegistryModuleVersionCreate),
+		ReadContext:   crudContext(resourceTFERegistryModuleVersionRead),
+		UpdateContext: crudContext(resourceTFERegistryModuleVersionUpdate),
+		DeleteContext: crudContext(resourceTFERegistryModuleVersionDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFERegistryModuleVersionImporter,
+		},
+
+		CustomizeDiff: customdiff.All(
+			customizeDiffDefaultOrganization,
+			validateRegistryModuleVersionDeprecation,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"module_provider": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"deprecated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"deprecation_reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"source": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"upload_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func registryModuleVersionModuleID(d *schema.ResourceData) tfe.RegistryModuleID {
+	organization := d.Get("organization").(string)
+
+	// Versions can only be created for modules in the private registry.
+	return tfe.RegistryModuleID{
+		Organization: organization,
+		Name:         d.Get("name").(string),
+		Provider:     d.Get("module_provider").(string),
+		Namespace:    organization,
+		RegistryName: tfe.PrivateRegistry,
+	}
+}
+
+func resourceTFERegistryModuleVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	if _, err := meta.(ConfiguredClient).organizationName(d); err != nil {
+		return err
+	}
+
+	rmID := registryModuleVersionModuleID(d)
+	options := tfe.RegistryModuleCreateVersionOptions{
+		Version: tfe.String(d.Get("version").(string)),
+	}
+
+	log.Printf("[DEBUG] Create version %s of registry module %s/%s", *options.Version, rmID.Name, rmID.Provider)
+	rmv, err := tfeClient.RegistryModules.CreateVersion(ctx, rmID, options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error creating version %s of registry module %s/%s: %w", *options.Version, rmID.Name, rmID.Provider, err)
+	}
+
+	d.SetId(rmv.ID)
+	d.Set("source", rmv.Source)
+
+	// The upload link is only returned when the version is created.
+	if upload, ok := rmv.Links["upload"].(string); ok {
+		d.Set("upload_url", upload)
+	}
+
+	if d.Get("deprecated").(bool) {
+		log.Printf("[DEBUG] Deprecate version %s of registry module %s/%s", *options.Version, rmID.Name, rmID.Provider)
+		err := updateRegistryModuleVersionDeprecation(ctx, tfeClient, rmID, *options.Version, expandRegistryModuleVersionDeprecation(d))
+		if err != nil {
+			return fmt.Errorf(
+				"Error deprecating version %s of registry module %s/%s: %w", *options.Version, rmID.Name, rmID.Provider, err)
+		}
+	}
+
+	return resourceTFERegistryModuleVersionRead(ctx, d, meta)
+}
+
+func resourceTFERegistryModuleVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	rmID := registryModuleVersionModuleID(d)
+	version := d.Get("version").(string)
+
+	log.Printf("[DEBUG] Read version %s of registry module %s/%s", version, rmID.Name, rmID.Provider)
+	registryModule, err := tfeClient.RegistryModules.Read(ctx, rmID)
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Registry module %s/%s no longer exists", rmID.Name, rmID.Provider)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading registry module %s/%s: %w", rmID.Name, rmID.Provider, err)
+	}
+
+	found := false
+	for _, vs := range registryModule.VersionStatuses {
+		if vs.Version == version {
+			d.Set("status", string(vs.Status))
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		log.Printf("[DEBUG] Version %s of registry module %s/%s no longer exists", version, rmID.Name, rmID.Provider)
+		d.SetId("")
+		return nil
+	}
+
+	// Deprecating module versions is not available on all releases of
+	// Terraform Enterprise and is not exposed by go-tfe.
+	deprecation, supported, err := readRegistryModuleVersionDeprecation(ctx, tfeClient, rmID, version)
+	if err != nil {
+		return fmt.Errorf("Error reading deprecation of version %s of registry module %s/%s: %w", version, rmID.Name, rmID.Provider, err)
+	}
+	if supported {
+		d.Set("deprecated", deprecation.Deprecated)
+		d.Set("deprecation_reason", deprecation.Reason)
+	}
+
+	return nil
+}
+
+func resourceTFERegistryModuleVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	rmID := registryModuleVersionModuleID(d)
+	version := d.Get("version").(string)
+
+	if d.HasChange("deprecated") || d.HasChange("deprecation_reason") {
+		log.Printf("[DEBUG] Update deprecation of version %s of registry module %s/%s", version, rmID.Name, rmID.Provider)
+		err := updateRegistryModuleVersionDeprecation(ctx, tfeClient, rmID, version, expandRegistryModuleVersionDeprecation(d))
+		if err != nil {
+			return fmt.Errorf(
+				"Error updating deprecation of version %s of registry module %s/%s: %w", version, rmID.Name, rmID.Provider, err)
+		}
+	}
+
+	return resourceTFERegistryModuleVersionRead(ctx, d, meta)
+}
+
+// validateRegistryModuleVersionDeprecation makes sure a deprecation reason is
+// only set on a deprecated version, as the API drops it otherwise.
+func validateRegistryModuleVersionDeprecation(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("deprecated") || !d.NewValueKnown("deprecation_reason") {
+		return nil
+	}
+
+	if d.Get("deprecation_reason").(string) != "" && !d.Get("deprecated").(bool) {
+		return fmt.Errorf("deprecation_reason can only be set when deprecated is true")
+	}
+
+	return nil
+}
+
+func expandRegistryModuleVersionDeprecation(d *schema.ResourceData) registryModuleVersionDeprecation {
+	return registryModuleVersionDeprecation{
+		Deprecated: d.Get("deprecated").(bool),
+		Reason:     d.Get("deprecation_reason").(string),
+	}
+}
+
+func resourceTFERegistryModuleVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	rmID := registryModuleVersionModuleID(d)
+	version := d.Get("version").(string)
+
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf(
+			"version %s of registry module %s/%s is protected from deletion, set deletion_protection = false and apply before destroying it",
+			version, rmID.Name, rmID.Provider)
+	}
+
+	log.Printf("[DEBUG] Delete version %s of registry module %s/%s", version, rmID.Name, rmID.Provider)
+	err := tfeClient.RegistryModules.DeleteVersion(ctx, rmID, version)
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting version %s of registry module %s/%s: %w", version, rmID.Name, rmID.Provider, err)
+	}
+
+	return nil
+}
+
+func resourceTFERegistryModuleVersionImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.SplitN(d.Id(), "/", 5)
+	if len(s) != 5 {
+		return nil, fmt.Errorf(
+			"invalid registry module version import format: %s (expected <ORGANIZATION>/<REGISTRY MODULE NAME>/<REGISTRY MODULE PROVIDER>/<VERSION>/<VERSION ID>)",
+			d.Id(),
+		)
+	}
+
+	// Set the fields that are part of the import ID.
+	d.Set("organization", s[0])
+	d.Set("name", s[1])
+	d.Set("module_provider", s[2])
+	d.Set("version", s[3])
+	d.SetId(s[4])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,237 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFERegistryModuleVersion_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFERegistryModuleVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERegistryModuleVersion_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"tfe_registry_module_version.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_module_version.foobar", "version", "1.0.0"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_module_version.foobar", "status", string(tfe.RegistryModuleVersionStatusPending)),
+					resource.TestCheckResourceAttrSet(
+						"tfe_registry_module_version.foobar", "upload_url"),
+				),
+			},
+		},
+	})
+}
+
+func TestTFERegistryModuleVersion_deletionProtection(t *testing.T) {
+	rd := resourceTFERegistryModuleVersion().TestResourceData()
+	rd.SetId("modver-123")
+	rd.Set("organization", "hashicorp")
+	rd.Set("name", "test_module")
+	rd.Set("module_provider", "my_provider")
+	rd.Set("version", "1.0.0")
+	rd.Set("deletion_protection", true)
+
+	// The protection is checked before calling the API.
+	err := resourceTFERegistryModuleVersionDelete(ctx, rd, ConfiguredClient{})
+	if err == nil || !strings.Contains(err.Error(), "deletion_protection = false") {
+		t.Fatalf("expected deleting a protected version to fail, got %v", err)
+	}
+}
+
+func TestTFERegistryModuleVersion_deprecationReason(t *testing.T) {
+	cases := map[string]struct {
+		raw map[string]interface{}
+		err string
+	}{
+		"not deprecated": {},
+		"deprecated": {
+			raw: map[string]interface{}{"deprecated": true},
+		},
+		"deprecated with reason": {
+			raw: map[string]interface{}{"deprecated": true, "deprecation_reason": "CVE-2024-1234"},
+		},
+		"reason without deprecated": {
+			raw: map[string]interface{}{"deprecation_reason": "CVE-2024-1234"},
+			err: "deprecation_reason can only be set when deprecated is true",
+		},
+		"reason when not deprecated": {
+			raw: map[string]interface{}{"deprecated": false, "deprecation_reason": "CVE-2024-1234"},
+			err: "deprecation_reason can only be set when deprecated is true",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"organization":    "hashicorp",
+				"name":            "test_module",
+				"module_provider": "my_provider",
+				"version":         "1.0.0",
+			}
+			for k, v := range tc.raw {
+				raw[k] = v
+			}
+
+			_, err := resourceTFERegistryModuleVersion().Diff(ctx, nil, terraform.NewResourceConfigRaw(raw), ConfiguredClient{})
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error to contain %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestAccTFERegistryModuleVersion_deletionProtection(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFERegistryModuleVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERegistryModuleVersion_deletionProtection(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_registry_module_version.foobar", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccTFERegistryModuleVersion_deletionProtection(rInt, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`is protected from deletion`),
+			},
+			{
+				Config: testAccTFERegistryModuleVersion_deletionProtection(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_registry_module_version.foobar", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFERegistryModuleVersion_import(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFERegistryModuleVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERegistryModuleVersion_basic(rInt),
+			},
+
+			{
+				ResourceName: "tfe_registry_module_version.foobar",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["tfe_registry_module_version.foobar"]
+					return fmt.Sprintf("tst-terraform-%d/test_module/my_provider/1.0.0/%s", rInt, rs.Primary.ID), nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source", "upload_url"},
+			},
+		},
+	})
+}
+
+func testAccCheckTFERegistryModuleVersionDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_registry_module_version" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		rmID := tfe.RegistryModuleID{
+			Organization: rs.Primary.Attributes["organization"],
+			Name:         rs.Primary.Attributes["name"],
+			Provider:     rs.Primary.Attributes["module_provider"],
+			Namespace:    rs.Primary.Attributes["organization"],
+			RegistryName: tfe.PrivateRegistry,
+		}
+		registryModule, err := tfeClient.RegistryModules.Read(ctx, rmID)
+		if err != nil {
+			continue
+		}
+
+		for _, vs := range registryModule.VersionStatuses {
+			if vs.Version == rs.Primary.Attributes["version"] {
+				return fmt.Errorf("Registry module version %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccTFERegistryModuleVersion_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_registry_module" "foobar" {
+  organization    = tfe_organization.foobar.id
+  module_provider = "my_provider"
+  name            = "test_module"
+}
+
+resource "tfe_registry_module_version" "foobar" {
+  organization    = tfe_organization.foobar.id
+  name            = tfe_registry_module.foobar.name
+  module_provider = tfe_registry_module.foobar.module_provider
+  version         = "1.0.0"
+}`, rInt)
+}
+
+func testAccTFERegistryModuleVersion_deletionProtection(rInt int, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_registry_module" "foobar" {
+  organization    = tfe_organization.foobar.id
+  module_provider = "my_provider"
+  name            = "test_module"
+}
+
+resource "tfe_registry_module_version" "foobar" {
+  organization        = tfe_organization.foobar.id
+  name                = tfe_registry_module.foobar.name
+  module_provider     = tfe_registry_module.foobar.module_provider
+  version             = "1.0.0"
+  deletion_protection = %t
+}`, rInt, deletionProtection)
+}
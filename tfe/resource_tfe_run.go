@@ -0,0 +1,178 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFERun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFERunCreate),
+		ReadContext:   crudContext(resourceTFERunRead),
+		DeleteContext: crudContext(resourceTFERunDelete),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Queued by Terraform",
+			},
+
+			"plan_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"is_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"apply": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       false,
+				ConflictsWith: []string{"plan_only"},
+			},
+
+			"wait_for_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"outputs": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceTFERunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+	apply := d.Get("apply").(bool)
+
+	options := tfe.RunCreateOptions{
+		Workspace: &tfe.Workspace{ID: workspaceID},
+		Message:   tfe.String(d.Get("message").(string)),
+		IsDestroy: tfe.Bool(d.Get("is_destroy").(bool)),
+	}
+
+	// Plan only runs are only supported by newer releases, so the attribute
+	// is only sent when set.
+	if d.Get("plan_only").(bool) {
+		options.PlanOnly = tfe.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Create run for workspace: %s", workspaceID)
+	run, err := tfeClient.Runs.Create(ctx, options)
+	if err != nil {
+		return fmt.Errorf("Error creating run for workspace %s: %w", workspaceID, err)
+	}
+
+	d.SetId(run.ID)
+
+	if d.Get("wait_for_run").(bool) {
+		run, err = waitForRun(ctx, tfeClient, run.ID, apply, apply, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+
+		// The outputs of the workspace are only captured once, right after the
+		// run is applied, as later runs change them.
+		if run.Status == tfe.RunApplied {
+			log.Printf("[DEBUG] Read outputs of workspace: %s", workspaceID)
+			outputs, err := tfeClient.StateVersionOutputs.ReadCurrent(ctx, workspaceID)
+			if err != nil {
+				return fmt.Errorf("Error reading outputs of workspace %s: %w", workspaceID, err)
+			}
+
+			flattened, err := flattenRunOutputs(outputs.Items)
+			if err != nil {
+				return err
+			}
+			d.Set("outputs", flattened)
+		}
+	}
+
+	return resourceTFERunRead(ctx, d, meta)
+}
+
+func resourceTFERunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read run: %s", d.Id())
+	run, err := tfeClient.Runs.ReadWithOptions(ctx, d.Id(), &tfe.RunReadOptions{
+		Include: []tfe.RunIncludeOpt{tfe.RunWorkspace},
+	})
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Run %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading run %s: %w", d.Id(), err)
+	}
+
+	d.Set("status", string(run.Status))
+	d.Set("html_url", runHTMLURL(meta.(ConfiguredClient), run))
+
+	return nil
+}
+
+// Runs can not be deleted, so deleting the resource only removes it from the
+// state.
+func resourceTFERunDelete(_ context.Context, d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Remove run %s from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFERun_apply(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERun_apply(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFERunExists("tfe_run.foobar"),
+					resource.TestCheckResourceAttr(
+						"tfe_run.foobar", "status", "applied"),
+					resource.TestCheckResourceAttr(
+						"tfe_run.foobar", "outputs.name", "config-version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTFERunExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		run, err := tfeClient.Runs.Read(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if run.Workspace == nil || run.Workspace.ID != rs.Primary.Attributes["workspace_id"] {
+			return fmt.Errorf("Run %s was not queued on workspace %s", run.ID, rs.Primary.Attributes["workspace_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccTFERun_apply(organization string) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = "%s"
+}
+
+resource "tfe_api_driven_run" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  source_path  = "test-fixtures/config-version"
+  apply        = true
+}
+
+resource "tfe_run" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  apply        = true
+
+  triggers = {
+    configuration = tfe_api_driven_run.foobar.configuration_version_id
+  }
+}`, organization)
+}
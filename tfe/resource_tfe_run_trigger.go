@@ -1,21 +1,19 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"strings"
-	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFERunTrigger() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFERunTriggerCreate,
-		Read:   resourceTFERunTriggerRead,
-		Delete: resourceTFERunTriggerDelete,
+		CreateContext: crudContext(resourceTFERunTriggerCreate),
+		ReadContext:   crudContext(resourceTFERunTriggerRead),
+		DeleteContext: crudContext(resourceTFERunTriggerDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -35,45 +33,26 @@ func resourceTFERunTrigger() *schema.Resource {
 	}
 }
 
-func resourceTFERunTriggerCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFERunTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get attributes
 	workspaceID := d.Get("workspace_id").(string)
 	sourceableID := d.Get("sourceable_id").(string)
 
-	// Create a new options struct
-	options := tfe.RunTriggerCreateOptions{
-		Sourceable: &tfe.Workspace{
-			ID: sourceableID,
-		},
-	}
-
 	log.Printf("[DEBUG] Create run trigger on workspace %s with sourceable %s", workspaceID, sourceableID)
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		runTrigger, err := tfeClient.RunTriggers.Create(ctx, workspaceID, options)
-		if err == nil {
-			d.SetId(runTrigger.ID)
-			return nil
-		}
-
-		if strings.Contains(err.Error(), "Run Trigger creation locked") {
-			log.Printf("[DEBUG] Run triggers are locked for workspace %s, will retry", workspaceID)
-			return resource.RetryableError(err)
-		}
-
-		return resource.NonRetryableError(err)
-	})
-
+	runTrigger, err := createRunTrigger(ctx, tfeClient, workspaceID, sourceableID)
 	if err != nil {
-		return fmt.Errorf("Error creating run trigger on workspace %s with sourceable %s: %w", workspaceID, sourceableID, err)
+		return err
 	}
 
-	return resourceTFERunTriggerRead(d, meta)
+	d.SetId(runTrigger.ID)
+
+	return resourceTFERunTriggerRead(ctx, d, meta)
 }
 
-func resourceTFERunTriggerRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFERunTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read run trigger: %s", d.Id())
 	runTrigger, err := tfeClient.RunTriggers.Read(ctx, d.Id())
@@ -92,8 +71,8 @@ func resourceTFERunTriggerRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFERunTriggerDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFERunTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete run trigger: %s", d.Id())
 	err := tfeClient.RunTriggers.Delete(ctx, d.Id())
@@ -75,7 +75,7 @@ func TestAccTFERunTriggerImport(t *testing.T) {
 
 func testAccCheckTFERunTriggerExists(n string, runTrigger *tfe.RunTrigger) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -99,7 +99,7 @@ func testAccCheckTFERunTriggerExists(n string, runTrigger *tfe.RunTrigger) resou
 
 func testAccCheckTFERunTriggerAttributes(runTrigger *tfe.RunTrigger, orgName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		workspaceID := runTrigger.Workspace.ID
 		workspace, _ := tfeClient.Workspaces.Read(ctx, orgName, "workspace-test")
@@ -118,7 +118,7 @@ func testAccCheckTFERunTriggerAttributes(runTrigger *tfe.RunTrigger, orgName str
 }
 
 func testAccCheckTFERunTriggerDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_run_trigger" {
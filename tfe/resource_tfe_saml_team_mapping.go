@@ -0,0 +1,111 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The SAML team mapping only manages the attributes which map SAML users to
+// teams and site admins. The identity provider settings and certificates of
+// the SAML settings are never read or written by this resource.
+func resourceTFESAMLTeamMapping() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFESAMLTeamMappingCreate),
+		ReadContext:   crudContext(resourceTFESAMLTeamMappingRead),
+		UpdateContext: crudContext(resourceTFESAMLTeamMappingUpdate),
+		DeleteContext: crudContext(resourceTFESAMLTeamMappingDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team_management_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"attr_groups": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"attr_site_admin": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"site_admin_role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTFESAMLTeamMappingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceTFESAMLTeamMappingUpdate(ctx, d, meta)
+}
+
+func resourceTFESAMLTeamMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read SAML settings")
+	settings, err := tfeClient.Admin.Settings.SAML.Read(ctx)
+	if err != nil {
+		return fmt.Errorf("Error reading SAML settings: %w", err)
+	}
+
+	d.SetId(settings.ID)
+	d.Set("team_management_enabled", settings.TeamManagementEnabled)
+	d.Set("attr_groups", settings.AttrGroups)
+	d.Set("attr_site_admin", settings.AttrSiteAdmin)
+	d.Set("site_admin_role", settings.SiteAdminRole)
+
+	return nil
+}
+
+func resourceTFESAMLTeamMappingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// Only configured attributes are sent, the others keep their current
+	// value.
+	options := tfe.AdminSAMLSettingsUpdateOptions{}
+	if v, ok := d.GetOkExists("team_management_enabled"); ok {
+		options.TeamManagementEnabled = tfe.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("attr_groups"); ok {
+		options.AttrGroups = tfe.String(v.(string))
+	}
+	if v, ok := d.GetOk("attr_site_admin"); ok {
+		options.AttrSiteAdmin = tfe.String(v.(string))
+	}
+	if v, ok := d.GetOk("site_admin_role"); ok {
+		options.SiteAdminRole = tfe.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Update SAML team mapping")
+	settings, err := tfeClient.Admin.Settings.SAML.Update(ctx, options)
+	if err != nil {
+		return fmt.Errorf("Error updating SAML team mapping: %w", err)
+	}
+
+	d.SetId(settings.ID)
+
+	return resourceTFESAMLTeamMappingRead(ctx, d, meta)
+}
+
+// The SAML team mapping can not be deleted, so deleting the resource only
+// removes it from the state and leaves the mapping unchanged.
+func resourceTFESAMLTeamMappingDelete(_ context.Context, d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Remove SAML team mapping %s from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package tfe
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestTFESAMLTeamMapping_updateOnlyMapping(t *testing.T) {
+	var body string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/admin/saml-settings":
+			if r.Method == "PATCH" {
+				b, _ := io.ReadAll(r.Body)
+				body = string(b)
+			}
+			fmt.Fprint(w, `{"data":{"id":"saml","type":"saml-settings","attributes":{"team-management-enabled":true,"attr-groups":"Teams","attr-site-admin":"SiteAdmin","site-admin-role":"site-admins","idp-cert":"-----BEGIN CERTIFICATE-----"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	rd := resourceTFESAMLTeamMapping().TestResourceData()
+	rd.Set("team_management_enabled", true)
+	rd.Set("attr_groups", "Teams")
+
+	if err := resourceTFESAMLTeamMappingCreate(ctx, rd, ConfiguredClient{Client: client}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(body, `"attr-groups":"Teams"`) || !strings.Contains(body, `"team-management-enabled":true`) {
+		t.Fatalf("expected the team mapping to be updated, got %s", body)
+	}
+	for _, attr := range []string{"idp-cert", "certificate", "private-key", "sso-endpoint-url", "attr-site-admin"} {
+		if strings.Contains(body, attr) {
+			t.Fatalf("expected %s not to be updated, got %s", attr, body)
+		}
+	}
+
+	if rd.Id() != "saml" || rd.Get("site_admin_role").(string) != "site-admins" {
+		t.Fatalf("unexpected state: id %q, site_admin_role %q", rd.Id(), rd.Get("site_admin_role"))
+	}
+}
+
+func TestAccTFESAMLTeamMapping_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFESAMLTeamMapping_basic("MemberOf"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_saml_team_mapping.foobar", "team_management_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_saml_team_mapping.foobar", "attr_groups", "MemberOf"),
+					resource.TestCheckResourceAttr(
+						"tfe_saml_team_mapping.foobar", "site_admin_role", "site-admins"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_saml_team_mapping.foobar", "attr_site_admin"),
+				),
+			},
+			{
+				Config: testAccTFESAMLTeamMapping_basic("Teams"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_saml_team_mapping.foobar", "attr_groups", "Teams"),
+				),
+			},
+			{
+				ResourceName:      "tfe_saml_team_mapping.foobar",
+				ImportState:       true,
+				ImportStateId:     "saml",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTFESAMLTeamMapping_basic(attrGroups string) string {
+	return fmt.Sprintf(`
+resource "tfe_saml_team_mapping" "foobar" {
+  team_management_enabled = true
+  attr_groups             = "%s"
+  site_admin_role         = "site-admins"
+}`, attrGroups)
+}
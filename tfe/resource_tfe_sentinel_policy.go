@@ -14,14 +14,16 @@ import (
 func resourceTFESentinelPolicy() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "tfe_sentinel_policy is deprecated, please use tfe_policy instead",
-		Create:             resourceTFESentinelPolicyCreate,
-		Read:               resourceTFESentinelPolicyRead,
-		Update:             resourceTFESentinelPolicyUpdate,
-		Delete:             resourceTFESentinelPolicyDelete,
+		CreateContext:      crudContext(resourceTFESentinelPolicyCreate),
+		ReadContext:        crudContext(resourceTFESentinelPolicyRead),
+		UpdateContext:      crudContext(resourceTFESentinelPolicyUpdate),
+		DeleteContext:      crudContext(resourceTFESentinelPolicyDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFESentinelPolicyImporter,
 		},
 
+		CustomizeDiff: customizeDiffDefaultOrganization,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -37,7 +39,8 @@ func resourceTFESentinelPolicy() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -63,12 +66,15 @@ func resourceTFESentinelPolicy() *schema.Resource {
 	}
 }
 
-func resourceTFESentinelPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFESentinelPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	// Create a new options struct.
 	options := tfe.PolicyCreateOptions{
@@ -101,11 +107,11 @@ func resourceTFESentinelPolicyCreate(d *schema.ResourceData, meta interface{}) e
 			"Error uploading sentinel policy %s for organization %s: %w", name, organization, err)
 	}
 
-	return resourceTFESentinelPolicyRead(d, meta)
+	return resourceTFESentinelPolicyRead(ctx, d, meta)
 }
 
-func resourceTFESentinelPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFESentinelPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read sentinel policy: %s", d.Id())
 	policy, err := tfeClient.Policies.Read(ctx, d.Id())
@@ -135,8 +141,8 @@ func resourceTFESentinelPolicyRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceTFESentinelPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFESentinelPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	if d.HasChange("description") || d.HasChange("enforce_mode") {
 		// Create a new options struct.
@@ -171,11 +177,11 @@ func resourceTFESentinelPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	return resourceTFESentinelPolicyRead(d, meta)
+	return resourceTFESentinelPolicyRead(ctx, d, meta)
 }
 
-func resourceTFESentinelPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFESentinelPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete sentinel policy: %s", d.Id())
 	err := tfeClient.Policies.Delete(ctx, d.Id())
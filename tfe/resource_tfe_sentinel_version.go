@@ -0,0 +1,43 @@
+package tfe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTFESentinelVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFESentinelVersionCreate),
+		ReadContext:   crudContext(resourceTFESentinelVersionRead),
+		UpdateContext: crudContext(resourceTFESentinelVersionUpdate),
+		DeleteContext: crudContext(resourceTFESentinelVersionDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFESentinelVersionImporter,
+		},
+
+		CustomizeDiff: customizeDiffAdminToolVersionRelease,
+
+		Schema: adminToolVersionSchema("sentinel-versions"),
+	}
+}
+
+func resourceTFESentinelVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceTFEAdminToolVersionCreate(ctx, d, meta, "sentinel-versions")
+}
+
+func resourceTFESentinelVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceTFEAdminToolVersionRead(ctx, d, meta, "sentinel-versions")
+}
+
+func resourceTFESentinelVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceTFEAdminToolVersionUpdate(ctx, d, meta, "sentinel-versions")
+}
+
+func resourceTFESentinelVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceTFEAdminToolVersionDelete(ctx, d, meta, "sentinel-versions")
+}
+
+func resourceTFESentinelVersionImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return resourceTFEAdminToolVersionImport(ctx, d, meta, "sentinel-versions")
+}
@@ -0,0 +1,110 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFESentinelVersion_basic(t *testing.T) {
+	skipIfCloud(t)
+
+	sha := genSha(t, "secret", "data")
+	version := genSafeRandomSentinelVersion()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFESentinelVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFESentinelVersion_basic(version, sha),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_sentinel_version.foobar", "version", version),
+					resource.TestCheckResourceAttr(
+						"tfe_sentinel_version.foobar", "url", "https://www.hashicorp.com"),
+					resource.TestCheckResourceAttr(
+						"tfe_sentinel_version.foobar", "sha", sha),
+					resource.TestCheckResourceAttr(
+						"tfe_sentinel_version.foobar", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccTFESentinelVersion_full(version, sha),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_sentinel_version.foobar", "beta", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_sentinel_version.foobar", "deprecated", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_sentinel_version.foobar", "deprecated_reason", "foobar"),
+				),
+			},
+			{
+				ResourceName:      "tfe_sentinel_version.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "tfe_sentinel_version.foobar",
+				ImportState:       true,
+				ImportStateId:     version,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTFESentinelVersionDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_sentinel_version" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := readAdminToolVersion(ctx, tfeClient, "sentinel-versions", rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Sentinel version %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFESentinelVersion_basic(version string, sha string) string {
+	return fmt.Sprintf(`
+resource "tfe_sentinel_version" "foobar" {
+  version = "%s"
+  url     = "https://www.hashicorp.com"
+  sha     = "%s"
+}`, version, sha)
+}
+
+func testAccTFESentinelVersion_full(version string, sha string) string {
+	return fmt.Sprintf(`
+resource "tfe_sentinel_version" "foobar" {
+  version           = "%s"
+  url               = "https://www.hashicorp.com"
+  sha               = "%s"
+  official          = false
+  enabled           = true
+  beta              = true
+  deprecated        = true
+  deprecated_reason = "foobar"
+}`, version, sha)
+}
+
+func genSafeRandomSentinelVersion() string {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Intn(100000) + 100
+	return fmt.Sprintf("0.22.%d", rInt)
+}
@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -10,10 +11,12 @@ import (
 
 func resourceTFESSHKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFESSHKeyCreate,
-		Read:   resourceTFESSHKeyRead,
-		Update: resourceTFESSHKeyUpdate,
-		Delete: resourceTFESSHKeyDelete,
+		CreateContext: crudContext(resourceTFESSHKeyCreate),
+		ReadContext:   crudContext(resourceTFESSHKeyRead),
+		UpdateContext: crudContext(resourceTFESSHKeyUpdate),
+		DeleteContext: crudContext(resourceTFESSHKeyDelete),
+
+		CustomizeDiff: customizeDiffDefaultOrganization,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -23,30 +26,57 @@ func resourceTFESSHKey() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
 			"key": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"key", "key_wo"},
+			},
+
+			"key_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				RequiredWith: []string{"key_wo_version"},
+			},
+
+			"key_wo_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				// The key of an SSH key can not be updated, so a new key
+				// replaces the SSH key.
+				ForceNew:     true,
+				RequiredWith: []string{"key_wo"},
 			},
 		},
 	}
 }
 
-func resourceTFESSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFESSHKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
+
+	key, err := valueOrWriteOnly(d, "key")
+	if err != nil {
+		return err
+	}
 
 	// Create a new options struct.
 	options := tfe.SSHKeyCreateOptions{
 		Name:  tfe.String(name),
-		Value: tfe.String(d.Get("key").(string)),
+		Value: tfe.String(key),
 	}
 
 	log.Printf("[DEBUG] Create new SSH key for organization: %s", organization)
@@ -58,11 +88,11 @@ func resourceTFESSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(sshKey.ID)
 
-	return resourceTFESSHKeyUpdate(d, meta)
+	return resourceTFESSHKeyUpdate(ctx, d, meta)
 }
 
-func resourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFESSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read configuration of SSH key: %s", d.Id())
 	sshKey, err := tfeClient.SSHKeys.Read(ctx, d.Id())
@@ -81,8 +111,8 @@ func resourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFESSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFESSHKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Create a new options struct.
 	options := tfe.SSHKeyUpdateOptions{
@@ -95,11 +125,11 @@ func resourceTFESSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error updating SSH key %s: %w", d.Id(), err)
 	}
 
-	return resourceTFESSHKeyRead(d, meta)
+	return resourceTFESSHKeyRead(ctx, d, meta)
 }
 
-func resourceTFESSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFESSHKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete SSH key: %s", d.Id())
 	err := tfeClient.SSHKeys.Delete(ctx, d.Id())
@@ -74,10 +74,52 @@ func TestAccTFESSHKey_update(t *testing.T) {
 	})
 }
 
+func TestAccTFESSHKey_writeOnly(t *testing.T) {
+	sshKey := &tfe.SSHKey{}
+	rotated := &tfe.SSHKey{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFESSHKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFESSHKey_writeOnly(rInt, "SSH-KEY-CONTENT", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFESSHKeyExists(
+						"tfe_ssh_key.foobar", sshKey),
+					resource.TestCheckResourceAttr(
+						"tfe_ssh_key.foobar", "key", ""),
+					resource.TestCheckNoResourceAttr(
+						"tfe_ssh_key.foobar", "key_wo"),
+					resource.TestCheckResourceAttr(
+						"tfe_ssh_key.foobar", "key_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccTFESSHKey_writeOnly(rInt, "SSH-KEY-ROTATED", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFESSHKeyExists(
+						"tfe_ssh_key.foobar", rotated),
+					resource.TestCheckNoResourceAttr(
+						"tfe_ssh_key.foobar", "key_wo"),
+					func(s *terraform.State) error {
+						if rotated.ID == sshKey.ID {
+							return fmt.Errorf("expected the SSH key to be replaced")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTFESSHKeyExists(
 	n string, sshKey *tfe.SSHKey) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -124,7 +166,7 @@ func testAccCheckTFESSHKeyAttributesUpdated(
 }
 
 func testAccCheckTFESSHKeyDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_ssh_key" {
@@ -171,3 +213,18 @@ resource "tfe_ssh_key" "foobar" {
   key          = "SSH-KEY-CONTENT"
 }`, rInt)
 }
+
+func testAccTFESSHKey_writeOnly(rInt int, key string, version int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_ssh_key" "foobar" {
+  name           = "ssh-key-test"
+  organization   = tfe_organization.foobar.id
+  key_wo         = "%s"
+  key_wo_version = %d
+}`, rInt, key, version)
+}
@@ -0,0 +1,161 @@
+package tfe
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFEState() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEStateCreate),
+		ReadContext:   crudContext(resourceTFEStateRead),
+		DeleteContext: crudContext(resourceTFEStateDelete),
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"serial": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"lineage": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// stateFileHeader holds the attributes of a state file which are sent
+// together with the state when creating a state version.
+type stateFileHeader struct {
+	Version int    `json:"version"`
+	Serial  int64  `json:"serial"`
+	Lineage string `json:"lineage"`
+}
+
+// parseStateFile reads the serial and lineage of a state file.
+func parseStateFile(state []byte) (*stateFileHeader, error) {
+	header := &stateFileHeader{}
+	if err := json.Unmarshal(state, header); err != nil {
+		return nil, fmt.Errorf("Error parsing state: %w", err)
+	}
+
+	// Only the state format of Terraform 0.12 and later is supported by
+	// Terraform Cloud.
+	if header.Version < 4 {
+		return nil, fmt.Errorf("state version %d is not supported, upgrade the state with Terraform 0.12 or later", header.Version)
+	}
+
+	return header, nil
+}
+
+func resourceTFEStateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+	state := []byte(d.Get("state").(string))
+
+	header, err := parseStateFile(state)
+	if err != nil {
+		return err
+	}
+
+	options := tfe.StateVersionCreateOptions{
+		Lineage: tfe.String(header.Lineage),
+		MD5:     tfe.String(fmt.Sprintf("%x", md5.Sum(state))),
+		Serial:  tfe.Int64(header.Serial),
+		State:   tfe.String(base64.StdEncoding.EncodeToString(state)),
+	}
+	if d.Get("force").(bool) {
+		options.Force = tfe.Bool(true)
+	}
+
+	// State versions can only be created while the workspace is locked.
+	log.Printf("[DEBUG] Lock workspace: %s", workspaceID)
+	_, err = tfeClient.Workspaces.Lock(ctx, workspaceID, tfe.WorkspaceLockOptions{
+		Reason: tfe.String("Uploading state with Terraform"),
+	})
+	if err != nil {
+		return fmt.Errorf("Error locking workspace %s: %w", workspaceID, err)
+	}
+
+	log.Printf("[DEBUG] Create state version for workspace: %s", workspaceID)
+	sv, err := tfeClient.StateVersions.Create(ctx, workspaceID, options)
+	if err != nil {
+		if _, unlockErr := tfeClient.Workspaces.Unlock(ctx, workspaceID); unlockErr != nil {
+			log.Printf("[ERROR] Error unlocking workspace %s: %s", workspaceID, unlockErr)
+		}
+		return fmt.Errorf("Error creating state version for workspace %s: %w", workspaceID, err)
+	}
+
+	d.SetId(sv.ID)
+	d.Set("lineage", header.Lineage)
+
+	log.Printf("[DEBUG] Unlock workspace: %s", workspaceID)
+	if _, err := tfeClient.Workspaces.Unlock(ctx, workspaceID); err != nil {
+		return fmt.Errorf("Error unlocking workspace %s: %w", workspaceID, err)
+	}
+
+	return resourceTFEStateRead(ctx, d, meta)
+}
+
+func resourceTFEStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read state version: %s", d.Id())
+	sv, err := tfeClient.StateVersions.Read(ctx, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] State version %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading state version %s: %w", d.Id(), err)
+	}
+
+	d.Set("serial", sv.Serial)
+
+	return nil
+}
+
+// State versions can not be deleted, so deleting the resource only removes it
+// from the state. The uploaded state remains the current state of the
+// workspace until a new state version is created.
+func resourceTFEStateDelete(_ context.Context, d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Remove state version %s from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package tfe
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestParseStateFile(t *testing.T) {
+	tests := map[string]struct {
+		state   string
+		serial  int64
+		lineage string
+		err     bool
+	}{
+		"valid state": {
+			state:   `{"version": 4, "serial": 3, "lineage": "b2f1a6e4", "outputs": {}, "resources": []}`,
+			serial:  3,
+			lineage: "b2f1a6e4",
+		},
+		"legacy state": {
+			state: `{"version": 3, "serial": 1, "lineage": "b2f1a6e4"}`,
+			err:   true,
+		},
+		"invalid JSON": {
+			state: `{"version":`,
+			err:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			header, err := parseStateFile([]byte(test.state))
+			if (err != nil) != test.err {
+				t.Fatalf("expected error is %t, got %v", test.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if header.Serial != test.serial || header.Lineage != test.lineage {
+				t.Fatalf("expected serial %d and lineage %s, got %d and %s", test.serial, test.lineage, header.Serial, header.Lineage)
+			}
+		})
+	}
+}
+
+func TestAccTFEState_basic(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	state, err := os.ReadFile("test-fixtures/state-versions/terraform.tfstate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	header, err := parseStateFile(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEState_basic(org.Name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tfe_state.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"tfe_state.foobar", "serial", fmt.Sprint(header.Serial)),
+					resource.TestCheckResourceAttr(
+						"tfe_state.foobar", "lineage", header.Lineage),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEState_basic(organization string) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = "%s"
+}
+
+resource "tfe_state" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  state        = file("test-fixtures/state-versions/terraform.tfstate")
+}
+`, organization)
+}
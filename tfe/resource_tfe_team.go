@@ -14,14 +14,16 @@ import (
 
 func resourceTFETeam() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamCreate,
-		Read:   resourceTFETeamRead,
-		Update: resourceTFETeamUpdate,
-		Delete: resourceTFETeamDelete,
+		CreateContext: crudContext(resourceTFETeamCreate),
+		ReadContext:   crudContext(resourceTFETeamRead),
+		UpdateContext: crudContext(resourceTFETeamUpdate),
+		DeleteContext: crudContext(resourceTFETeamDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETeamImporter,
 		},
 
+		CustomizeDiff: customizeDiffDefaultOrganization,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -29,7 +31,8 @@ func resourceTFETeam() *schema.Resource {
 			},
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"organization_access": {
@@ -74,6 +77,36 @@ func resourceTFETeam() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"manage_projects": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"read_projects": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"read_workspaces": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"manage_membership": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"manage_teams": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"manage_agent_pools": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -90,16 +123,90 @@ func resourceTFETeam() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"allow_member_token_management": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
 
-func resourceTFETeamCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func expandTeamOrganizationAccess(organizationAccess map[string]interface{}) *tfe.OrganizationAccessOptions {
+	return &tfe.OrganizationAccessOptions{
+		ManagePolicies:        tfe.Bool(organizationAccess["manage_policies"].(bool)),
+		ManagePolicyOverrides: tfe.Bool(organizationAccess["manage_policy_overrides"].(bool)),
+		ManageWorkspaces:      tfe.Bool(organizationAccess["manage_workspaces"].(bool)),
+		ManageVCSSettings:     tfe.Bool(organizationAccess["manage_vcs_settings"].(bool)),
+		ManageProviders:       tfe.Bool(organizationAccess["manage_providers"].(bool)),
+		ManageModules:         tfe.Bool(organizationAccess["manage_modules"].(bool)),
+		ManageRunTasks:        tfe.Bool(organizationAccess["manage_run_tasks"].(bool)),
+		ManageProjects:        tfe.Bool(organizationAccess["manage_projects"].(bool)),
+		ReadProjects:          tfe.Bool(organizationAccess["read_projects"].(bool)),
+		ReadWorkspaces:        tfe.Bool(organizationAccess["read_workspaces"].(bool)),
+		ManageMembership:      tfe.Bool(organizationAccess["manage_membership"].(bool)),
+	}
+}
+
+// updateTeamDetailsFromConfig sets the team settings which go-tfe does not know yet.
+// New teams only send them when they differ from the server defaults, and
+// existing teams when they changed, so older Terraform Enterprise versions
+// keep working.
+func updateTeamDetailsFromConfig(ctx context.Context, d *schema.ResourceData, client *tfe.Client) error {
+	options := &teamDetailsUpdateOptions{}
+	send := false
+
+	shouldSend := func(attr string, value, serverDefault bool) bool {
+		if d.IsNewResource() {
+			return value != serverDefault
+		}
+		return d.HasChange(attr)
+	}
+
+	if v, ok := d.GetOk("organization_access"); ok {
+		organizationAccess := v.([]interface{})[0].(map[string]interface{})
+
+		accessOptions := &teamOrganizationAccessOptions{}
+		for attr, field := range map[string]**bool{
+			"manage_teams":       &accessOptions.ManageTeams,
+			"manage_agent_pools": &accessOptions.ManageAgentPools,
+		} {
+			granted := organizationAccess[attr].(bool)
+			if shouldSend("organization_access.0."+attr, granted, false) {
+				*field = tfe.Bool(granted)
+				options.OrganizationAccess = accessOptions
+				send = true
+			}
+		}
+	}
+
+	allow := d.Get("allow_member_token_management").(bool)
+	if shouldSend("allow_member_token_management", allow, true) {
+		options.AllowMemberTokenManagement = tfe.Bool(allow)
+		send = true
+	}
+
+	if !send {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Update settings of team: %s", d.Id())
+	if err := updateTeamDetails(ctx, client, d.Id(), options); err != nil {
+		return fmt.Errorf("Error updating team %s: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceTFETeamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get team attributes.
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	// Create a new options struct.
 	options := tfe.TeamCreateOptions{
@@ -107,17 +214,7 @@ func resourceTFETeamCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("organization_access"); ok {
-		organizationAccess := v.([]interface{})[0].(map[string]interface{})
-
-		options.OrganizationAccess = &tfe.OrganizationAccessOptions{
-			ManagePolicies:        tfe.Bool(organizationAccess["manage_policies"].(bool)),
-			ManagePolicyOverrides: tfe.Bool(organizationAccess["manage_policy_overrides"].(bool)),
-			ManageWorkspaces:      tfe.Bool(organizationAccess["manage_workspaces"].(bool)),
-			ManageVCSSettings:     tfe.Bool(organizationAccess["manage_vcs_settings"].(bool)),
-			ManageProviders:       tfe.Bool(organizationAccess["manage_providers"].(bool)),
-			ManageModules:         tfe.Bool(organizationAccess["manage_modules"].(bool)),
-			ManageRunTasks:        tfe.Bool(organizationAccess["manage_run_tasks"].(bool)),
-		}
+		options.OrganizationAccess = expandTeamOrganizationAccess(v.([]interface{})[0].(map[string]interface{}))
 	}
 
 	if v, ok := d.GetOk("visibility"); ok {
@@ -145,16 +242,20 @@ func resourceTFETeamCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(team.ID)
 
-	return resourceTFETeamRead(d, meta)
+	if err := updateTeamDetailsFromConfig(ctx, d, tfeClient); err != nil {
+		return err
+	}
+
+	return resourceTFETeamRead(ctx, d, meta)
 }
 
-func resourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read configuration of team: %s", d.Id())
-	team, err := tfeClient.Teams.Read(ctx, d.Id())
+	team, err := readTeamDetails(ctx, tfeClient, d.Id())
 	if err != nil {
-		if err == tfe.ErrResourceNotFound {
+		if isErrResourceNotFound(err) {
 			log.Printf("[DEBUG] Team %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -173,6 +274,12 @@ func resourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
 			"manage_providers":        team.OrganizationAccess.ManageProviders,
 			"manage_modules":          team.OrganizationAccess.ManageModules,
 			"manage_run_tasks":        team.OrganizationAccess.ManageRunTasks,
+			"manage_projects":         team.OrganizationAccess.ManageProjects,
+			"read_projects":           team.OrganizationAccess.ReadProjects,
+			"read_workspaces":         team.OrganizationAccess.ReadWorkspaces,
+			"manage_membership":       team.OrganizationAccess.ManageMembership,
+			"manage_teams":            team.OrganizationAccess.ManageTeams,
+			"manage_agent_pools":      team.OrganizationAccess.ManageAgentPools,
 		}}
 		if err := d.Set("organization_access", organizationAccess); err != nil {
 			return fmt.Errorf("error setting organization access for team %s: %w", d.Id(), err)
@@ -180,12 +287,15 @@ func resourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("visibility", team.Visibility)
 	d.Set("sso_team_id", team.SSOTeamID)
+	if team.AllowMemberTokenManagement != nil {
+		d.Set("allow_member_token_management", *team.AllowMemberTokenManagement)
+	}
 
 	return nil
 }
 
-func resourceTFETeamUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
@@ -196,17 +306,7 @@ func resourceTFETeamUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("organization_access"); ok {
-		organizationAccess := v.([]interface{})[0].(map[string]interface{})
-
-		options.OrganizationAccess = &tfe.OrganizationAccessOptions{
-			ManagePolicies:        tfe.Bool(organizationAccess["manage_policies"].(bool)),
-			ManagePolicyOverrides: tfe.Bool(organizationAccess["manage_policy_overrides"].(bool)),
-			ManageWorkspaces:      tfe.Bool(organizationAccess["manage_workspaces"].(bool)),
-			ManageVCSSettings:     tfe.Bool(organizationAccess["manage_vcs_settings"].(bool)),
-			ManageProviders:       tfe.Bool(organizationAccess["manage_providers"].(bool)),
-			ManageModules:         tfe.Bool(organizationAccess["manage_modules"].(bool)),
-			ManageRunTasks:        tfe.Bool(organizationAccess["manage_run_tasks"].(bool)),
-		}
+		options.OrganizationAccess = expandTeamOrganizationAccess(v.([]interface{})[0].(map[string]interface{}))
 	}
 
 	if v, ok := d.GetOk("visibility"); ok {
@@ -226,11 +326,11 @@ func resourceTFETeamUpdate(d *schema.ResourceData, meta interface{}) error {
 			"Error updating team %s: %w", d.Id(), err)
 	}
 
-	return nil
+	return updateTeamDetailsFromConfig(ctx, d, tfeClient)
 }
 
-func resourceTFETeamDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete team: %s", d.Id())
 	err := tfeClient.Teams.Delete(ctx, d.Id())
@@ -13,10 +13,10 @@ import (
 
 func resourceTFETeamAccess() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamAccessCreate,
-		Read:   resourceTFETeamAccessRead,
-		Update: resourceTFETeamAccessUpdate,
-		Delete: resourceTFETeamAccessDelete,
+		CreateContext: crudContext(resourceTFETeamAccessCreate),
+		ReadContext:   crudContext(resourceTFETeamAccessRead),
+		UpdateContext: crudContext(resourceTFETeamAccessUpdate),
+		DeleteContext: crudContext(resourceTFETeamAccessDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETeamAccessImporter,
 		},
@@ -117,6 +117,11 @@ func resourceTFETeamAccess() *schema.Resource {
 							Type:     schema.TypeBool,
 							Required: true,
 						},
+
+						"policy_overrides": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -140,8 +145,8 @@ func resourceTFETeamAccess() *schema.Resource {
 	}
 }
 
-func resourceTFETeamAccessCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the access level
 	access := d.Get("access").(string)
@@ -213,11 +218,20 @@ func resourceTFETeamAccessCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(tmAccess.ID)
 
-	return resourceTFETeamAccessRead(d, meta)
+	// The policy override permission is only sent when granted, so that
+	// releases without support for it keep working.
+	if v, ok := d.GetOkExists("permissions.0.policy_overrides"); ok && v.(bool) {
+		if err := updateTeamAccessPolicyOverrides(ctx, tfeClient, tmAccess.ID, true); err != nil {
+			return fmt.Errorf(
+				"Error granting team %s policy overrides on workspace %s: %w", tm.Name, ws.Name, err)
+		}
+	}
+
+	return resourceTFETeamAccessRead(ctx, d, meta)
 }
 
-func resourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read configuration of team access: %s", d.Id())
 	tmAccess, err := tfeClient.TeamAccess.Read(ctx, d.Id())
@@ -230,6 +244,11 @@ func resourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error reading configuration of team access %s: %w", d.Id(), err)
 	}
 
+	policyOverrides, _, err := readTeamAccessPolicyOverrides(ctx, tfeClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading policy override permission of team access %s: %w", d.Id(), err)
+	}
+
 	// Update config.
 	d.Set("access", string(tmAccess.Access))
 	permissions := []map[string]interface{}{{
@@ -239,6 +258,7 @@ func resourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
 		"sentinel_mocks":    tmAccess.SentinelMocks,
 		"workspace_locking": tmAccess.WorkspaceLocking,
 		"run_tasks":         tmAccess.RunTasks,
+		"policy_overrides":  policyOverrides,
 	}}
 	if err := d.Set("permissions", permissions); err != nil {
 		return fmt.Errorf("error setting permissions for team access %s: %w", d.Id(), err)
@@ -253,8 +273,8 @@ func resourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFETeamAccessUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamAccessUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// create an options struct
 	options := tfe.TeamAccessUpdateOptions{}
@@ -306,6 +326,20 @@ func resourceTFETeamAccessUpdate(d *schema.ResourceData, meta interface{}) error
 			"Error updating team access %s: %w", d.Id(), err)
 	}
 
+	if access == string(tfe.AccessCustom) && d.HasChange("permissions.0.policy_overrides") {
+		if v, ok := d.GetOkExists("permissions.0.policy_overrides"); ok {
+			if err := updateTeamAccessPolicyOverrides(ctx, tfeClient, d.Id(), v.(bool)); err != nil {
+				return fmt.Errorf(
+					"Error updating policy override permission of team access %s: %w", d.Id(), err)
+			}
+		}
+	}
+
+	policyOverrides, _, err := readTeamAccessPolicyOverrides(ctx, tfeClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading policy override permission of team access %s: %w", d.Id(), err)
+	}
+
 	// Update permissions, in the case that they were marked to be recomputed.
 	permissions := []map[string]interface{}{{
 		"runs":              tmAccess.Runs,
@@ -314,6 +348,7 @@ func resourceTFETeamAccessUpdate(d *schema.ResourceData, meta interface{}) error
 		"sentinel_mocks":    tmAccess.SentinelMocks,
 		"workspace_locking": tmAccess.WorkspaceLocking,
 		"run_tasks":         tmAccess.RunTasks,
+		"policy_overrides":  policyOverrides,
 	}}
 	if err := d.Set("permissions", permissions); err != nil {
 		return fmt.Errorf("error setting permissions for team access %s: %w", d.Id(), err)
@@ -322,8 +357,8 @@ func resourceTFETeamAccessUpdate(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTFETeamAccessDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete team access: %s", d.Id())
 	err := tfeClient.TeamAccess.Remove(ctx, d.Id())
@@ -338,7 +373,7 @@ func resourceTFETeamAccessDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceTFETeamAccessImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	s := strings.SplitN(d.Id(), "/", 3)
 	if len(s) != 3 {
@@ -349,7 +384,7 @@ func resourceTFETeamAccessImporter(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// Set the fields that are part of the import ID.
-	workspaceID, err := fetchWorkspaceExternalID(s[0]+"/"+s[1], tfeClient)
+	workspaceID, err := fetchWorkspaceExternalID(ctx, s[0]+"/"+s[1], tfeClient)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"error retrieving workspace %s from organization %s: %w", s[1], s[0], err)
@@ -423,6 +458,7 @@ func setCustomAccess(d *schema.ResourceDiff) error {
 		"permissions.0.sentinel_mocks",
 		"permissions.0.workspace_locking",
 		"permissions.0.run_tasks",
+		"permissions.0.policy_overrides",
 	} {
 		if !d.NewValueKnown(permission) {
 			return fmt.Errorf("'%q' cannot be derived from a value that is unknown during planning", permission)
@@ -42,11 +42,11 @@ func resourceTfeTeamAccessResourceV0() *schema.Resource {
 	}
 }
 
-func resourceTfeTeamAccessStateUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
-	tfeClient := meta.(*tfe.Client)
+func resourceTfeTeamAccessStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	humanID := rawState["workspace_id"].(string)
-	id, err := fetchWorkspaceExternalID(humanID, tfeClient)
+	id, err := fetchWorkspaceExternalID(ctx, humanID, tfeClient)
 	if err != nil {
 		return nil, fmt.Errorf("Error reading configuration of workspace %s: %w", humanID, err)
 	}
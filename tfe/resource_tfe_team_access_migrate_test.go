@@ -28,7 +28,7 @@ func TestResourceTfeTeamAccessStateUpgradeV0(t *testing.T) {
 	})
 
 	expected := testResourceTfeTeamAccessStateDataV1()
-	actual, err := resourceTfeTeamAccessStateUpgradeV0(context.Background(), testResourceTfeTeamAccessStateDataV0(), client)
+	actual, err := resourceTfeTeamAccessStateUpgradeV0(context.Background(), testResourceTfeTeamAccessStateDataV0(), ConfiguredClient{Client: client})
 	if err != nil {
 		t.Fatalf("error migrating state: %s", err)
 	}
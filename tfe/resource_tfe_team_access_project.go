@@ -0,0 +1,251 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFETeamAccessProject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFETeamAccessProjectCreate),
+		ReadContext:   crudContext(resourceTFETeamAccessProjectRead),
+		UpdateContext: crudContext(resourceTFETeamAccessProjectUpdate),
+		DeleteContext: crudContext(resourceTFETeamAccessProjectDelete),
+
+		CustomizeDiff: setTeamAccessProjectWorkspaceIDs,
+
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"access": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.AccessAdmin),
+						string(tfe.AccessRead),
+						string(tfe.AccessPlan),
+						string(tfe.AccessWrite),
+					},
+					false,
+				),
+			},
+
+			"workspace_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// setTeamAccessProjectWorkspaceIDs compares the workspaces the team has access to
+// with the current workspaces of the project, so workspaces which are added
+// to or removed from the project show up in the plan.
+func setTeamAccessProjectWorkspaceIDs(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("project_id") {
+		return d.SetNewComputed("workspace_ids")
+	}
+
+	tfeClient := meta.(ConfiguredClient).Client
+
+	ids, err := listProjectWorkspaceIDs(ctx, tfeClient, d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	expected := newStringSet(ids)
+	if !expected.Equal(d.Get("workspace_ids").(*schema.Set)) {
+		return d.SetNew("workspace_ids", expected)
+	}
+
+	return nil
+}
+
+func resourceTFETeamAccessProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	teamID := d.Get("team_id").(string)
+	projectID := d.Get("project_id").(string)
+
+	d.SetId(fmt.Sprintf("%s_%s", teamID, projectID))
+
+	if err := reconcileTeamAccessProject(ctx, tfeClient, d); err != nil {
+		return err
+	}
+
+	return resourceTFETeamAccessProjectRead(ctx, d, meta)
+}
+
+func resourceTFETeamAccessProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	teamID := d.Get("team_id").(string)
+	projectID := d.Get("project_id").(string)
+	access := tfe.AccessType(d.Get("access").(string))
+
+	log.Printf("[DEBUG] Read workspaces of project: %s", projectID)
+	ids, err := listProjectWorkspaceIDs(ctx, tfeClient, projectID)
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Project %s no longer exists", projectID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// Workspaces which were moved out of the project are checked as well, so
+	// their access is revoked on the next apply.
+	candidates := newStringSet(ids)
+	for _, id := range d.Get("workspace_ids").(*schema.Set).List() {
+		candidates.Add(id)
+	}
+
+	var granted []interface{}
+	for _, id := range candidates.List() {
+		ta, err := findTeamAccess(ctx, tfeClient, teamID, id.(string))
+		if err != nil {
+			if isErrResourceNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		// Workspaces where the team has another access level are left out,
+		// so the access is updated on the next apply.
+		if ta != nil && ta.Access == access {
+			granted = append(granted, id)
+		}
+	}
+
+	d.Set("workspace_ids", granted)
+
+	return nil
+}
+
+func resourceTFETeamAccessProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	if err := reconcileTeamAccessProject(ctx, tfeClient, d); err != nil {
+		return err
+	}
+
+	return resourceTFETeamAccessProjectRead(ctx, d, meta)
+}
+
+func resourceTFETeamAccessProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	teamID := d.Get("team_id").(string)
+	for _, id := range d.Get("workspace_ids").(*schema.Set).List() {
+		if err := revokeTeamWorkspaceAccess(ctx, tfeClient, teamID, id.(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileTeamAccessProject grants the team access to all
+// workspaces of the project, and revokes the access it granted to workspaces
+// which are no longer part of the project.
+func reconcileTeamAccessProject(ctx context.Context, client *tfe.Client, d *schema.ResourceData) error {
+	teamID := d.Get("team_id").(string)
+	projectID := d.Get("project_id").(string)
+	access := tfe.AccessType(d.Get("access").(string))
+
+	ids, err := listProjectWorkspaceIDs(ctx, client, projectID)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]bool)
+	for _, id := range ids {
+		current[id] = true
+
+		ta, err := findTeamAccess(ctx, client, teamID, id)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case ta == nil:
+			log.Printf("[DEBUG] Give team %s %s access to workspace: %s", teamID, access, id)
+			_, err = client.TeamAccess.Add(ctx, tfe.TeamAccessAddOptions{
+				Access:    tfe.Access(access),
+				Team:      &tfe.Team{ID: teamID},
+				Workspace: &tfe.Workspace{ID: id},
+			})
+		case ta.Access != access:
+			log.Printf("[DEBUG] Update access of team %s to workspace %s: %s", teamID, id, access)
+			_, err = client.TeamAccess.Update(ctx, ta.ID, tfe.TeamAccessUpdateOptions{
+				Access: tfe.Access(access),
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("Error giving team %s access to workspace %s: %w", teamID, id, err)
+		}
+	}
+
+	// The previous workspaces are read from the state, as the planned value
+	// already holds the current workspaces of the project.
+	previous, _ := d.GetChange("workspace_ids")
+	for _, id := range previous.(*schema.Set).List() {
+		if current[id.(string)] {
+			continue
+		}
+		if err := revokeTeamWorkspaceAccess(ctx, client, teamID, id.(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// revokeTeamWorkspaceAccess removes the access of a team to a workspace,
+// ignoring workspaces and accesses which no longer exist.
+func revokeTeamWorkspaceAccess(ctx context.Context, client *tfe.Client, teamID, workspaceID string) error {
+	ta, err := findTeamAccess(ctx, client, teamID, workspaceID)
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if ta == nil {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Revoke access of team %s to workspace: %s", teamID, workspaceID)
+	err = client.TeamAccess.Remove(ctx, ta.ID)
+	if err != nil && !isErrResourceNotFound(err) {
+		return fmt.Errorf("Error revoking access of team %s to workspace %s: %w", teamID, workspaceID, err)
+	}
+
+	return nil
+}
+
+func newStringSet(values []string) *schema.Set {
+	set := schema.NewSet(schema.HashString, nil)
+	for _, v := range values {
+		set.Add(v)
+	}
+	return set
+}
@@ -0,0 +1,128 @@
+package tfe
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFETeamAccessProject_basic(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamAccessProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamAccessProject_basic(org.Name, "read", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_team_access_project.foobar", "workspace_ids.#", "2"),
+					testAccCheckTFETeamAccessProjectAccessIs("tfe_team_access_project.foobar", tfe.AccessRead),
+				),
+			},
+			{
+				// Workspaces added to the project are granted access as well.
+				Config: testAccTFETeamAccessProject_basic(org.Name, "write", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_team_access_project.foobar", "workspace_ids.#", "3"),
+					testAccCheckTFETeamAccessProjectAccessIs("tfe_team_access_project.foobar", tfe.AccessWrite),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTFETeamAccessProjectAccessIs(n string, access tfe.AccessType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		ids, err := listProjectWorkspaceIDs(ctx, tfeClient, rs.Primary.Attributes["project_id"])
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			ta, err := findTeamAccess(ctx, tfeClient, rs.Primary.Attributes["team_id"], id)
+			if err != nil {
+				return err
+			}
+			if ta == nil || ta.Access != access {
+				return fmt.Errorf("Team has no %s access to workspace %s", access, id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTFETeamAccessProjectDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_team_access_project" {
+			continue
+		}
+
+		for k, id := range rs.Primary.Attributes {
+			if k == "workspace_ids.#" || !strings.HasPrefix(k, "workspace_ids.") {
+				continue
+			}
+
+			ta, err := findTeamAccess(ctx, tfeClient, rs.Primary.Attributes["team_id"], id)
+			if err != nil && !isErrResourceNotFound(err) {
+				return err
+			}
+			if ta != nil {
+				return fmt.Errorf("Team access %s still exists", ta.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccTFETeamAccessProject_basic(organization, access string, workspaces int) string {
+	return fmt.Sprintf(`
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = "%s"
+}
+
+resource "tfe_project" "foobar" {
+  name         = "project-test"
+  organization = "%[1]s"
+}
+
+resource "tfe_workspace" "foobar" {
+  count        = %d
+  name         = "workspace-test-${count.index}"
+  organization = "%[1]s"
+  project_id   = tfe_project.foobar.id
+}
+
+resource "tfe_team_access_project" "foobar" {
+  team_id    = tfe_team.foobar.id
+  project_id = tfe_project.foobar.id
+  access     = "%[3]s"
+
+  depends_on = [tfe_workspace.foobar]
+}`, organization, workspaces, access)
+}
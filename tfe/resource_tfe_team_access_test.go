@@ -126,6 +126,36 @@ func TestAccTFETeamAccess_custom(t *testing.T) {
 	})
 }
 
+func TestAccTFETeamAccess_policyOverrides(t *testing.T) {
+	tmAccess := &tfe.TeamAccess{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamAccess_policyOverrides(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamAccessExists(
+						"tfe_team_access.foobar", tmAccess),
+					resource.TestCheckResourceAttr("tfe_team_access.foobar", "access", "custom"),
+					resource.TestCheckResourceAttr("tfe_team_access.foobar", "permissions.0.policy_overrides", "true"),
+				),
+			},
+			{
+				Config: testAccTFETeamAccess_policyOverrides(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamAccessExists(
+						"tfe_team_access.foobar", tmAccess),
+					resource.TestCheckResourceAttr("tfe_team_access.foobar", "permissions.0.policy_overrides", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFETeamAccess_updateToCustom(t *testing.T) {
 	tmAccess := &tfe.TeamAccess{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -281,7 +311,7 @@ func TestAccTFETeamAccess_import(t *testing.T) {
 func testAccCheckTFETeamAccessExists(
 	n string, tmAccess *tfe.TeamAccess) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -341,7 +371,7 @@ func testAccCheckTFETeamAccessAttributesPermissionsAre(tmAccess *tfe.TeamAccess,
 }
 
 func testAccCheckTFETeamAccessDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_team_access" {
@@ -463,3 +493,35 @@ resource "tfe_team_access" "foobar" {
   workspace_id = tfe_workspace.foobar.id
 }`, rInt)
 }
+
+func testAccTFETeamAccess_policyOverrides(rInt int, policyOverrides bool) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_team_access" "foobar" {
+  permissions {
+    runs = "apply"
+    variables = "read"
+    state_versions = "read-outputs"
+    sentinel_mocks = "none"
+    workspace_locking = false
+    run_tasks = false
+    policy_overrides = %t
+  }
+  team_id      = tfe_team.foobar.id
+  workspace_id = tfe_workspace.foobar.id
+}`, rInt, policyOverrides)
+}
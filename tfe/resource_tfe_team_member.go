@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,9 +12,9 @@ import (
 
 func resourceTFETeamMember() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamMemberCreate,
-		Read:   resourceTFETeamMemberRead,
-		Delete: resourceTFETeamMemberDelete,
+		CreateContext: crudContext(resourceTFETeamMemberCreate),
+		ReadContext:   crudContext(resourceTFETeamMemberRead),
+		DeleteContext: crudContext(resourceTFETeamMemberDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -34,8 +35,8 @@ func resourceTFETeamMember() *schema.Resource {
 	}
 }
 
-func resourceTFETeamMemberCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the team ID and username..
 	teamID := d.Get("team_id").(string)
@@ -57,8 +58,8 @@ func resourceTFETeamMemberCreate(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTFETeamMemberRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the team ID and username.
 	teamID, username, err := unpackTeamMemberID(d.Id())
@@ -99,8 +100,8 @@ func resourceTFETeamMemberRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFETeamMemberDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the team ID and username.
 	teamID, username, err := unpackTeamMemberID(d.Id())
@@ -11,10 +11,10 @@ import (
 
 func resourceTFETeamMembers() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamMembersCreate,
-		Read:   resourceTFETeamMembersRead,
-		Update: resourceTFETeamMembersUpdate,
-		Delete: resourceTFETeamMembersDelete,
+		CreateContext: crudContext(resourceTFETeamMembersCreate),
+		ReadContext:   crudContext(resourceTFETeamMembersRead),
+		UpdateContext: crudContext(resourceTFETeamMembersUpdate),
+		DeleteContext: crudContext(resourceTFETeamMembersDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETeamMembersImporter,
 		},
@@ -35,8 +35,8 @@ func resourceTFETeamMembers() *schema.Resource {
 	}
 }
 
-func resourceTFETeamMembersCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the team ID.
 	teamID := d.Get("team_id").(string)
@@ -60,8 +60,8 @@ func resourceTFETeamMembersCreate(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceTFETeamMembersRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read users from team: %s", d.Id())
 	users, err := tfeClient.TeamMembers.List(ctx, d.Id())
@@ -89,8 +89,8 @@ func resourceTFETeamMembersRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	if d.HasChange("usernames") {
 		oldUsernames, newUsernames := d.GetChange("usernames")
@@ -135,8 +135,8 @@ func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceTFETeamMembersDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Retrieve users to remove from team: %s", d.Id())
 	users, err := tfeClient.TeamMembers.List(ctx, d.Id())
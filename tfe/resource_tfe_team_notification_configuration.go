@@ -0,0 +1,215 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFETeamNotificationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFETeamNotificationConfigurationCreate),
+		ReadContext:   crudContext(resourceTFETeamNotificationConfigurationRead),
+		UpdateContext: crudContext(resourceTFETeamNotificationConfigurationUpdate),
+		DeleteContext: crudContext(resourceTFETeamNotificationConfigurationDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: validateNotificationConfigurationDestination,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"destination_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.NotificationDestinationTypeEmail),
+						string(tfe.NotificationDestinationTypeGeneric),
+						string(tfe.NotificationDestinationTypeSlack),
+						string(tfe.NotificationDestinationTypeMicrosoftTeams),
+					},
+					false,
+				),
+			},
+
+			"email_addresses": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"token", "url"},
+			},
+
+			"email_user_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"token", "url"},
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"triggers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateTeamNotificationTrigger,
+				},
+			},
+
+			"url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"email_addresses", "email_user_ids"},
+			},
+
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func expandTeamNotificationConfigurationOptions(d *schema.ResourceData) *teamNotificationConfigurationOptions {
+	options := &teamNotificationConfigurationOptions{
+		Enabled:  tfe.Bool(d.Get("enabled").(bool)),
+		Name:     tfe.String(d.Get("name").(string)),
+		Token:    tfe.String(d.Get("token").(string)),
+		Triggers: []string{},
+	}
+
+	if v, ok := d.GetOk("url"); ok {
+		options.URL = tfe.String(v.(string))
+	}
+
+	for _, trigger := range d.Get("triggers").(*schema.Set).List() {
+		options.Triggers = append(options.Triggers, trigger.(string))
+	}
+
+	if emailAddresses, ok := d.GetOk("email_addresses"); ok {
+		for _, emailAddress := range emailAddresses.(*schema.Set).List() {
+			options.EmailAddresses = append(options.EmailAddresses, emailAddress.(string))
+		}
+	}
+
+	if emailUserIDs, ok := d.GetOk("email_user_ids"); ok {
+		for _, emailUserID := range emailUserIDs.(*schema.Set).List() {
+			options.EmailUsers = append(options.EmailUsers, &tfe.User{ID: emailUserID.(string)})
+		}
+	}
+
+	return options
+}
+
+func resourceTFETeamNotificationConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	teamID := d.Get("team_id").(string)
+	name := d.Get("name").(string)
+
+	options := expandTeamNotificationConfigurationOptions(d)
+	options.DestinationType = tfe.String(d.Get("destination_type").(string))
+
+	log.Printf("[DEBUG] Create notification configuration %s for team: %s", name, teamID)
+	nc, err := createTeamNotificationConfiguration(ctx, tfeClient, teamID, options)
+	if err != nil {
+		return fmt.Errorf("Error creating notification configuration %s for team %s: %w", name, teamID, err)
+	}
+
+	d.SetId(nc.ID)
+
+	return resourceTFETeamNotificationConfigurationRead(ctx, d, meta)
+}
+
+func resourceTFETeamNotificationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read team notification configuration: %s", d.Id())
+	nc, err := readTeamNotificationConfiguration(ctx, tfeClient, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Team notification configuration %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading team notification configuration %s: %w", d.Id(), err)
+	}
+
+	d.Set("name", nc.Name)
+	d.Set("destination_type", nc.DestinationType)
+	d.Set("enabled", nc.Enabled)
+	d.Set("email_addresses", nc.EmailAddresses)
+
+	var emailUserIDs []interface{}
+	for _, emailUser := range nc.EmailUsers {
+		emailUserIDs = append(emailUserIDs, emailUser.ID)
+	}
+	d.Set("email_user_ids", emailUserIDs)
+
+	// Don't set token here, as it is write only
+	// and setting it here would make it blank
+	d.Set("triggers", nc.Triggers)
+
+	if nc.URL != "" {
+		d.Set("url", nc.URL)
+	}
+
+	if nc.Subscribable != nil {
+		d.Set("team_id", nc.Subscribable.ID)
+	}
+
+	return nil
+}
+
+func resourceTFETeamNotificationConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	options := expandTeamNotificationConfigurationOptions(d)
+
+	log.Printf("[DEBUG] Update team notification configuration: %s", d.Id())
+	if err := updateTeamNotificationConfiguration(ctx, tfeClient, d.Id(), options); err != nil {
+		return fmt.Errorf("Error updating team notification configuration %s: %w", d.Id(), err)
+	}
+
+	return resourceTFETeamNotificationConfigurationRead(ctx, d, meta)
+}
+
+func resourceTFETeamNotificationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Delete team notification configuration: %s", d.Id())
+	err := tfeClient.NotificationConfigurations.Delete(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting team notification configuration %s: %w", d.Id(), err)
+	}
+
+	return nil
+}
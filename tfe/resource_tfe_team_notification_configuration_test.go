@@ -0,0 +1,93 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFETeamNotificationConfiguration_basic(t *testing.T) {
+	skipIfEnterprise(t)
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamNotificationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamNotificationConfiguration_basic(org.Name, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"tfe_team_notification_configuration.foobar", "team_id", "tfe_team.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"tfe_team_notification_configuration.foobar", "destination_type", "generic"),
+					resource.TestCheckResourceAttr(
+						"tfe_team_notification_configuration.foobar", "enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_team_notification_configuration.foobar", "triggers.#", "1"),
+				),
+			},
+			{
+				Config: testAccTFETeamNotificationConfiguration_basic(org.Name, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_team_notification_configuration.foobar", "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            "tfe_team_notification_configuration.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func testAccCheckTFETeamNotificationConfigurationDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_team_notification_configuration" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := readTeamNotificationConfiguration(ctx, tfeClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Team notification configuration %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFETeamNotificationConfiguration_basic(organization, enabled string) string {
+	return fmt.Sprintf(`
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = "%s"
+}
+
+resource "tfe_team_notification_configuration" "foobar" {
+  name             = "change-requests"
+  enabled          = %s
+  destination_type = "generic"
+  url              = "http://example.com"
+  triggers         = ["change_request:created"]
+  team_id          = tfe_team.foobar.id
+}`, organization, enabled)
+}
@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,9 +12,9 @@ import (
 
 func resourceTFETeamOrganizationMember() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamOrganizationMemberCreate,
-		Read:   resourceTFETeamOrganizationMemberRead,
-		Delete: resourceTFETeamOrganizationMemberDelete,
+		CreateContext: crudContext(resourceTFETeamOrganizationMemberCreate),
+		ReadContext:   crudContext(resourceTFETeamOrganizationMemberRead),
+		DeleteContext: crudContext(resourceTFETeamOrganizationMemberDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -34,8 +35,8 @@ func resourceTFETeamOrganizationMember() *schema.Resource {
 	}
 }
 
-func resourceTFETeamOrganizationMemberCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamOrganizationMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the team ID and username..
 	teamID := d.Get("team_id").(string)
@@ -57,8 +58,8 @@ func resourceTFETeamOrganizationMemberCreate(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTFETeamOrganizationMemberRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamOrganizationMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the team ID and organization membership id.
 	teamID, organizationMembershipID, err := unpackTeamOrganizationMemberID(d.Id())
@@ -96,8 +97,8 @@ func resourceTFETeamOrganizationMemberRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTFETeamOrganizationMemberDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamOrganizationMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the team ID and organization membership id.
 	teamID, organizationMembershipID, err := unpackTeamOrganizationMemberID(d.Id())
@@ -115,7 +115,7 @@ func TestAccTFETeamOrganizationMember_import(t *testing.T) {
 func testAccCheckTFETeamOrganizationMemberExists(
 	n string, organizationMembership *tfe.OrganizationMembership) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -165,7 +165,7 @@ func testAccCheckTFETeamOrganizationMemberAttributes(
 }
 
 func testAccCheckTFETeamOrganizationMemberDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_team_organization_member" {
@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,10 +12,10 @@ import (
 
 func resourceTFETeamOrganizationMembers() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamOrganizationMembersCreate,
-		Read:   resourceTFETeamOrganizationMembersRead,
-		Update: resourceTFETeamOrganizationMembersUpdate,
-		Delete: resourceTFETeamOrganizationMembersDelete,
+		CreateContext: crudContext(resourceTFETeamOrganizationMembersCreate),
+		ReadContext:   crudContext(resourceTFETeamOrganizationMembersRead),
+		UpdateContext: crudContext(resourceTFETeamOrganizationMembersUpdate),
+		DeleteContext: crudContext(resourceTFETeamOrganizationMembersDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -31,40 +32,34 @@ func resourceTFETeamOrganizationMembers() *schema.Resource {
 				Required: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"authoritative": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
-func resourceTFETeamOrganizationMembersCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamOrganizationMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the team ID.
 	teamID := d.Get("team_id").(string)
 
-	var organizationMembershipIDs []string
-	// Get all organization membership IDs
-	for _, id := range d.Get("organization_membership_ids").(*schema.Set).List() {
-		organizationMembershipIDs = append(organizationMembershipIDs, id.(string))
-	}
-
-	// Create a new options struct.
-	options := tfe.TeamMemberAddOptions{
-		OrganizationMembershipIDs: organizationMembershipIDs,
-	}
-
-	log.Printf("[DEBUG] Add organization memberships %v to team: %s", organizationMembershipIDs, teamID)
-	err := tfeClient.TeamMembers.Add(ctx, teamID, options)
-	if err != nil {
-		return fmt.Errorf("Error adding organization memberships %v to team %s: %w", organizationMembershipIDs, teamID, err)
+	membershipIDs := d.Get("organization_membership_ids").(*schema.Set)
+	if err := syncTeamOrganizationMembers(ctx, tfeClient, teamID, membershipIDs, managedTeamOrganizationMembers(d, membershipIDs)); err != nil {
+		return err
 	}
 
 	d.SetId(teamID)
 
-	return nil
+	return resourceTFETeamOrganizationMembersRead(ctx, d, meta)
 }
 
-func resourceTFETeamOrganizationMembersRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamOrganizationMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read organization memberships from team: %s", d.Id())
 	organizationMemberships, err := tfeClient.TeamMembers.ListOrganizationMemberships(ctx, d.Id())
@@ -77,104 +72,101 @@ func resourceTFETeamOrganizationMembersRead(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("Error reading organization memberships from team %s: %w", d.Id(), err)
 	}
 
-	// Get all organization memberships and add them to object
+	// Without authoritative only the members already in the state are read,
+	// so members added elsewhere do not show up as changes. All members are
+	// read when nothing is managed yet, like on import.
+	managed := d.Get("organization_membership_ids").(*schema.Set)
+	if d.Get("authoritative").(bool) || managed.Len() == 0 {
+		managed = nil
+	}
+
 	var organizationMembershipIDs []interface{}
 	for _, membership := range organizationMemberships {
-		organizationMembershipIDs = append(organizationMembershipIDs, membership.ID)
+		if managed == nil || managed.Contains(membership.ID) {
+			organizationMembershipIDs = append(organizationMembershipIDs, membership.ID)
+		}
 	}
 
-	// Check if organization memberships were added at all
-	if len(organizationMembershipIDs) > 0 {
-		d.Set("team_id", d.Id())
-		d.Set("organization_membership_ids", organizationMembershipIDs)
-	} else {
+	// A team whose members were all removed outside of Terraform is kept when
+	// the members are authoritative, so they are added again. Otherwise the
+	// resource is created again.
+	if len(organizationMemberships) == 0 && !d.Get("authoritative").(bool) {
 		log.Printf("[DEBUG] Organization memberships for team %s do no longer exist", d.Id())
 		d.SetId("")
+		return nil
 	}
 
+	d.Set("team_id", d.Id())
+	d.Set("organization_membership_ids", organizationMembershipIDs)
+
 	return nil
 }
 
-func fetchExistingTeamMembershipIds(tfeClient *tfe.Client, teamID string) (map[string]interface{}, error) {
-	teamMembers, err := tfeClient.TeamMembers.ListOrganizationMemberships(ctx, teamID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch existing organization memberships for team %s: %w", teamID, err)
-	}
-
-	teamMembersIDSet := make(map[string]interface{})
-	for _, m := range teamMembers {
-		teamMembersIDSet[m.ID] = nil
+// managedTeamOrganizationMembers returns the organization memberships managed
+// by the resource, or nil when it manages all members of the team. Without
+// authoritative, these are the configured members and the members configured
+// before, so members added elsewhere are kept.
+func managedTeamOrganizationMembers(d *schema.ResourceData, membershipIDs *schema.Set) *schema.Set {
+	if d.Get("authoritative").(bool) {
+		return nil
 	}
-
-	return teamMembersIDSet, nil
+	previous, _ := d.GetChange("organization_membership_ids")
+	return previous.(*schema.Set).Union(membershipIDs)
 }
 
-func resourceTFETeamOrganizationMembersUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
-
-	var membershipIDsToDelete *schema.Set
-	var membershipIDsToAdd *schema.Set
-
-	if d.HasChange("organization_membership_ids") {
-		oldMembershipIDs, newMembershipIDs := d.GetChange("organization_membership_ids")
-		membershipIDsToDelete = oldMembershipIDs.(*schema.Set).Difference(newMembershipIDs.(*schema.Set))
-		membershipIDsToAdd = newMembershipIDs.(*schema.Set).Difference(oldMembershipIDs.(*schema.Set))
+// syncTeamOrganizationMembers makes the given organization memberships the
+// members of the team, removing the other members in managedIDs, or all other
+// members when managedIDs is nil. New members are added before other members
+// are removed, so the team never lacks the configured members. Memberships
+// are used instead of usernames, so users who did not accept their invitation
+// yet can be added as well.
+func syncTeamOrganizationMembers(ctx context.Context, tfeClient *tfe.Client, teamID string, membershipIDs, managedIDs *schema.Set) error {
+	existing, err := tfeClient.TeamMembers.ListOrganizationMemberships(ctx, teamID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch existing organization memberships for team %s: %w", teamID, err)
 	}
 
-	// First add the new organization memberships.
-	if membershipIDsToAdd.Len() > 0 {
-		// Create a new options struct.
-		options := tfe.TeamMemberAddOptions{}
-
-		// Add all the organization memberships that need to be added.
-		for _, id := range membershipIDsToAdd.List() {
-			options.OrganizationMembershipIDs = append(options.OrganizationMembershipIDs, id.(string))
+	var existingIDs []string
+	for _, m := range existing {
+		if managedIDs == nil || managedIDs.Contains(m.ID) {
+			existingIDs = append(existingIDs, m.ID)
 		}
+	}
 
-		log.Printf("[DEBUG] Add organization memberships %v to team: %s", options.OrganizationMembershipIDs, d.Id())
-		err := tfeClient.TeamMembers.Add(ctx, d.Id(), options)
+	return syncIDs(existingIDs, membershipIDs, func(ids []string) error {
+		log.Printf("[DEBUG] Add organization memberships %v to team: %s", ids, teamID)
+		err := tfeClient.TeamMembers.Add(ctx, teamID, tfe.TeamMemberAddOptions{OrganizationMembershipIDs: ids})
 		if err != nil {
-			return fmt.Errorf("Error adding organization memberships to team %s: %w", d.Id(), err)
+			return fmt.Errorf("Error adding organization memberships %v to team %s: %w", ids, teamID, err)
 		}
-	}
-
-	if membershipIDsToDelete.Len() == 0 {
 		return nil
-	}
-
-	// Then delete all the old users.
-	existingIDs, err := fetchExistingTeamMembershipIds(tfeClient, d.Id())
-	if err != nil {
-		return err
-	}
-
-	// Create a new options struct.
-	options := tfe.TeamMemberRemoveOptions{}
-
-	// Add all the organization memberships that need to be removed, except the
-	// ones that already don't exist. Those may have been removed by another
-	// destroy operation, such as a membership resource.
-	for _, id := range membershipIDsToDelete.List() {
-		if _, exists := existingIDs[id.(string)]; exists {
-			options.OrganizationMembershipIDs = append(options.OrganizationMembershipIDs, id.(string))
+	}, func(ids []string) error {
+		// Memberships removed by another destroy operation, such as a
+		// membership resource, are already gone.
+		log.Printf("[DEBUG] Remove organization memberships %v from team: %s", ids, teamID)
+		err := tfeClient.TeamMembers.Remove(ctx, teamID, tfe.TeamMemberRemoveOptions{OrganizationMembershipIDs: ids})
+		if err != nil {
+			return fmt.Errorf("Error removing organization memberships from team %s: %w", teamID, err)
 		}
-	}
+		return nil
+	})
+}
 
-	if len(options.OrganizationMembershipIDs) > 0 {
-		log.Printf("[DEBUG] Remove organization memberships %v from team: %s", options.OrganizationMembershipIDs, d.Id())
-		err = tfeClient.TeamMembers.Remove(ctx, d.Id(), options)
-		if err != nil {
-			return fmt.Errorf("Error removing organization memberships from team %s: %w", d.Id(), err)
+func resourceTFETeamOrganizationMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	if d.HasChanges("organization_membership_ids", "authoritative") {
+		membershipIDs := d.Get("organization_membership_ids").(*schema.Set)
+		if err := syncTeamOrganizationMembers(ctx, tfeClient, d.Id(), membershipIDs, managedTeamOrganizationMembers(d, membershipIDs)); err != nil {
+			return err
 		}
-	} else {
-		log.Printf("[DEBUG] All members planned to be removed from this team were already removed from team %s", d.Id())
 	}
 
-	return nil
+	return resourceTFETeamOrganizationMembersRead(ctx, d, meta)
 }
 
-func resourceTFETeamOrganizationMembersDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamOrganizationMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read organization memberships from team: %s", d.Id())
 	organizationMemberships, err := tfeClient.TeamMembers.ListOrganizationMemberships(ctx, d.Id())
@@ -190,9 +182,19 @@ func resourceTFETeamOrganizationMembersDelete(d *schema.ResourceData, meta inter
 	// Create a new options struct.
 	options := tfe.TeamMemberRemoveOptions{}
 
-	// Add all the users that need to be removed.
-	for _, memberships := range organizationMemberships {
-		options.OrganizationMembershipIDs = append(options.OrganizationMembershipIDs, memberships.ID)
+	// Add the users that need to be removed. Without authoritative, members
+	// added elsewhere are kept.
+	managed := d.Get("organization_membership_ids").(*schema.Set)
+	for _, membership := range organizationMemberships {
+		if d.Get("authoritative").(bool) || managed.Contains(membership.ID) {
+			options.OrganizationMembershipIDs = append(options.OrganizationMembershipIDs, membership.ID)
+		}
+	}
+
+	// The team may already be empty, e.g. when all members were removed
+	// outside of Terraform.
+	if len(options.OrganizationMembershipIDs) == 0 {
+		return nil
 	}
 
 	log.Printf("[DEBUG] Remove organization memberships %v from team: %s", options.OrganizationMembershipIDs, d.Id())
@@ -3,14 +3,19 @@ package tfe
 import (
 	"errors"
 	"fmt"
+	"io"
 	"math/rand"
+	"net/http"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
 )
 
 func TestAccTFETeamOrganizationMembers_create_update(t *testing.T) {
@@ -62,9 +67,142 @@ func TestAccTFETeamOrganizationMembers_import(t *testing.T) {
 	})
 }
 
+func TestSyncTeamOrganizationMembers(t *testing.T) {
+	var requests []string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, strings.TrimSpace(r.Method+" "+r.URL.Path+" "+string(b)))
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/teams/team-123":
+			fmt.Fprint(w, `{"data":{"id":"team-123","type":"teams","relationships":{"organization-memberships":{"data":[
+				{"id":"ou-keep","type":"organization-memberships"},
+				{"id":"ou-remove","type":"organization-memberships"}
+			]}}}}`)
+		case r.URL.Path == "/api/v2/teams/team-123/relationships/organization-memberships":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	// The invited membership is added by its ID, before the membership which
+	// is not configured is removed.
+	err := syncTeamOrganizationMembers(ctx, client, "team-123", schema.NewSet(schema.HashString, []interface{}{"ou-keep", "ou-invited"}), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"GET /api/v2/teams/team-123",
+		`POST /api/v2/teams/team-123/relationships/organization-memberships {"data":[{"type":"organization-memberships","id":"ou-invited"}]}`,
+		`DELETE /api/v2/teams/team-123/relationships/organization-memberships {"data":[{"type":"organization-memberships","id":"ou-remove"}]}`,
+	}
+	if len(requests) != len(want) {
+		t.Fatalf("wrong requests\ngot: %v\nwant: %v", requests, want)
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Fatalf("wrong request %d\ngot: %s\nwant: %s", i, requests[i], want[i])
+		}
+	}
+}
+
+func TestResourceTFETeamOrganizationMembersCreate_additive(t *testing.T) {
+	var requests []string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, strings.TrimSpace(r.Method+" "+r.URL.Path+" "+string(b)))
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/teams/team-123":
+			fmt.Fprint(w, `{"data":{"id":"team-123","type":"teams","relationships":{"organization-memberships":{"data":[
+				{"id":"ou-other","type":"organization-memberships"}
+			]}}}}`)
+		case r.URL.Path == "/api/v2/teams/team-123/relationships/organization-memberships":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	d := resourceTFETeamOrganizationMembers().TestResourceData()
+	d.Set("team_id", "team-123")
+	d.Set("organization_membership_ids", []interface{}{"ou-new"})
+
+	// Members added elsewhere are kept unless the members are authoritative.
+	if err := resourceTFETeamOrganizationMembersCreate(ctx, d, ConfiguredClient{Client: server.Client}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, r := range requests {
+		if strings.HasPrefix(r, "DELETE") {
+			t.Fatalf("expected no members to be removed, got %s", r)
+		}
+	}
+	want := `POST /api/v2/teams/team-123/relationships/organization-memberships {"data":[{"type":"organization-memberships","id":"ou-new"}]}`
+	if len(requests) < 2 || requests[1] != want {
+		t.Fatalf("wrong requests\ngot: %v\nwant: %s", requests, want)
+	}
+}
+
+func TestResourceTFETeamOrganizationMembersReadDelete_additive(t *testing.T) {
+	var requests []string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, strings.TrimSpace(r.Method+" "+r.URL.Path+" "+string(b)))
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/teams/team-123":
+			fmt.Fprint(w, `{"data":{"id":"team-123","type":"teams","relationships":{"organization-memberships":{"data":[
+				{"id":"ou-managed","type":"organization-memberships"},
+				{"id":"ou-other","type":"organization-memberships"}
+			]}}}}`)
+		case r.URL.Path == "/api/v2/teams/team-123/relationships/organization-memberships":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	meta := ConfiguredClient{Client: server.Client}
+
+	d := resourceTFETeamOrganizationMembers().TestResourceData()
+	d.SetId("team-123")
+	d.Set("organization_membership_ids", []interface{}{"ou-managed"})
+
+	// Members added elsewhere are not read.
+	if err := resourceTFETeamOrganizationMembersRead(ctx, d, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ids := d.Get("organization_membership_ids").(*schema.Set)
+	if ids.Len() != 1 || !ids.Contains("ou-managed") {
+		t.Fatalf("expected only the managed member, got %v", ids.List())
+	}
+
+	// Members added elsewhere are not removed.
+	requests = nil
+	if err := resourceTFETeamOrganizationMembersDelete(ctx, d, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `DELETE /api/v2/teams/team-123/relationships/organization-memberships {"data":[{"type":"organization-memberships","id":"ou-managed"}]}`
+	if len(requests) != 2 || requests[1] != want {
+		t.Fatalf("wrong requests\ngot: %v\nwant: %s", requests, want)
+	}
+
+	// All members are read on import.
+	d = resourceTFETeamOrganizationMembers().TestResourceData()
+	d.SetId("team-123")
+	if err := resourceTFETeamOrganizationMembersRead(ctx, d, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ids := d.Get("organization_membership_ids").(*schema.Set); ids.Len() != 2 {
+		t.Fatalf("expected all members, got %v", ids.List())
+	}
+}
+
 func testAccCheckTFETeamOrganizationMembersExists(resourceName string, organizationMemberships *[]tfe.OrganizationMembership) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 		*organizationMemberships = []tfe.OrganizationMembership{}
 
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -126,7 +264,7 @@ func testAccCheckTFETeamOrganizationMembersCount(expected int, organizationMembe
 }
 
 func testAccCheckTFETeamOrganizationMembersDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		// Continue if current resource is not a "tfe_team_organization_members" resource
@@ -4,20 +4,26 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
+	"strings"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceTFETeamToken() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFETeamTokenCreate,
-		Read:   resourceTFETeamTokenRead,
-		Delete: resourceTFETeamTokenDelete,
+		CreateContext: crudContext(resourceTFETeamTokenCreate),
+		ReadContext:   crudContext(resourceTFETeamTokenRead),
+		DeleteContext: crudContext(resourceTFETeamTokenDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETeamTokenImporter,
 		},
 
+		CustomizeDiff: customizeDiffTokenExpiration,
+
 		Schema: map[string]*schema.Schema{
 			"team_id": {
 				Type:     schema.TypeString,
@@ -26,26 +32,89 @@ func resourceTFETeamToken() *schema.Resource {
 			},
 
 			"force_regenerate": {
-				Type:     schema.TypeBool,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"description"},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
 
+			"expired_at": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
 			"token": {
 				Type:      schema.TypeString,
 				Computed:  true,
 				Sensitive: true,
 			},
+
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"serial": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func resourceTFETeamTokenCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(ConfiguredClient)
+	tfeClient := config.Client
 
 	// Get the team ID.
 	teamID := d.Get("team_id").(string)
 
+	_, hasExpiredAt := d.GetOk("expired_at")
+	if err := validateOwnersTeamToken(ctx, config, teamID, hasExpiredAt); err != nil {
+		return err
+	}
+
+	// Parse the expiration date of the token if one is configured.
+	var expiredAt *time.Time
+	if v, ok := d.GetOk("expired_at"); ok {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing expired_at %s: %w", v.(string), err)
+		}
+		expiredAt = &t
+	}
+
+	// Tokens with a description are created next to the other tokens of the
+	// team, where multiple team tokens are supported.
+	if description, ok := d.GetOk("description"); ok {
+		log.Printf("[DEBUG] Create new token %q for team: %s", description.(string), teamID)
+		token, err := createTeamAuthenticationToken(ctx, tfeClient, teamID, &teamAuthenticationTokenCreateOptions{
+			Description: tfe.String(description.(string)),
+			ExpiredAt:   expiredAt,
+		})
+		if err != nil {
+			return fmt.Errorf(
+				"Error creating new token %q for team %s: %w", description.(string), teamID, err)
+		}
+
+		d.SetId(token.ID)
+
+		// We need to set this here in the create function as this value will
+		// only be returned once during the creation of the token.
+		d.Set("token", token.Token)
+
+		return resourceTFETeamTokenRead(ctx, d, meta)
+	}
+
 	log.Printf("[DEBUG] Check if a token already exists for team: %s", teamID)
 	_, err := tfeClient.TeamTokens.Read(ctx, teamID)
 	if err != nil && err != tfe.ErrResourceNotFound {
@@ -61,7 +130,11 @@ func resourceTFETeamTokenCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Create new token for team: %s", teamID)
-	token, err := tfeClient.TeamTokens.Create(ctx, teamID)
+	options := tfe.TeamTokenCreateOptions{
+		ExpiredAt: expiredAt,
+	}
+
+	token, err := tfeClient.TeamTokens.CreateWithOptions(ctx, teamID, options)
 	if err != nil {
 		return fmt.Errorf(
 			"Error creating new token for team %s: %w", teamID, err)
@@ -73,16 +146,20 @@ func resourceTFETeamTokenCreate(d *schema.ResourceData, meta interface{}) error
 	// only be returned once during the creation of the token.
 	d.Set("token", token.Token)
 
-	return resourceTFETeamTokenRead(d, meta)
+	return resourceTFETeamTokenRead(ctx, d, meta)
 }
 
-func resourceTFETeamTokenRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	if isTeamAuthenticationTokenID(d.Id()) {
+		return resourceTFETeamAuthenticationTokenRead(ctx, d, tfeClient)
+	}
 
 	log.Printf("[DEBUG] Read the token from team: %s", d.Id())
-	_, err := tfeClient.TeamTokens.Read(ctx, d.Id())
+	token, err := readTeamToken(ctx, tfeClient, fmt.Sprintf("teams/%s/authentication-token", url.QueryEscape(d.Id())))
 	if err != nil {
-		if err == tfe.ErrResourceNotFound {
+		if isErrResourceNotFound(err) {
 			log.Printf("[DEBUG] Token for team %s no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -90,11 +167,59 @@ func resourceTFETeamTokenRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error reading token from team %s: %w", d.Id(), err)
 	}
 
+	// The serial changes every time the token is regenerated.
+	d.Set("serial", token.Data.ID)
+	d.Set("created_by", token.createdBy())
+
 	return nil
 }
 
-func resourceTFETeamTokenDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFETeamAuthenticationTokenRead(ctx context.Context, d *schema.ResourceData, tfeClient *tfe.Client) error {
+	log.Printf("[DEBUG] Read team token: %s", d.Id())
+	token, err := readTeamAuthenticationToken(ctx, tfeClient, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Team token %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading team token %s: %w", d.Id(), err)
+	}
+
+	d.Set("serial", token.Data.ID)
+	d.Set("description", token.Data.Attributes.Description)
+	d.Set("created_by", token.createdBy())
+
+	return nil
+}
+
+func resourceTFETeamTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	if isTeamAuthenticationTokenID(d.Id()) {
+		log.Printf("[DEBUG] Delete team token: %s", d.Id())
+		err := deleteTeamAuthenticationToken(ctx, tfeClient, d.Id())
+		if err != nil && !isErrResourceNotFound(err) {
+			return fmt.Errorf("Error deleting team token %s: %w", d.Id(), err)
+		}
+		return nil
+	}
+
+	// When the token is rotated with create_before_destroy, the replacement
+	// has already regenerated the token of the team, which must be kept.
+	if serial := d.Get("serial").(string); serial != "" {
+		token, err := tfeClient.TeamTokens.Read(ctx, d.Id())
+		if err != nil {
+			if err == tfe.ErrResourceNotFound {
+				return nil
+			}
+			return fmt.Errorf("Error reading token from team %s: %w", d.Id(), err)
+		}
+		if token.ID != serial {
+			log.Printf("[DEBUG] Token from team %s was regenerated, keeping token %s", d.Id(), token.ID)
+			return nil
+		}
+	}
 
 	log.Printf("[DEBUG] Delete token from team: %s", d.Id())
 	err := tfeClient.TeamTokens.Delete(ctx, d.Id())
@@ -109,8 +234,44 @@ func resourceTFETeamTokenDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceTFETeamTokenImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// One of multiple team tokens is imported with <TEAM ID>/<TOKEN ID>.
+	if s := strings.SplitN(d.Id(), "/", 2); len(s) == 2 {
+		if !isTeamAuthenticationTokenID(s[1]) {
+			return nil, fmt.Errorf(
+				"invalid team token import format: %s (expected <TEAM ID> or <TEAM ID>/<TOKEN ID>)", d.Id())
+		}
+		d.Set("team_id", s[0])
+		d.SetId(s[1])
+		return []*schema.ResourceData{d}, nil
+	}
+
 	// Set the team ID field.
 	d.Set("team_id", d.Id())
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// validateOwnersTeamToken makes sure a token for the owners team is only
+// generated when explicitly allowed by the provider configuration, and that
+// such a token always expires.
+func validateOwnersTeamToken(ctx context.Context, config ConfiguredClient, teamID string, hasExpiredAt bool) error {
+	team, err := config.Client.Teams.Read(ctx, teamID)
+	if err != nil {
+		return fmt.Errorf("Error reading team %s: %w", teamID, err)
+	}
+
+	if team.Name != "owners" {
+		return nil
+	}
+
+	if !config.AllowOwnersToken {
+		return fmt.Errorf(
+			"Refusing to generate a token for the owners team %s: set allow_owners_token = true in the provider configuration to allow it", teamID)
+	}
+
+	if !hasExpiredAt {
+		return fmt.Errorf("expired_at must be set when generating a token for the owners team %s", teamID)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package tfe
 import (
 	"fmt"
 	"math/rand"
+	"net/http"
 	"regexp"
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
 )
 
 func TestAccTFETeamToken_basic(t *testing.T) {
@@ -85,6 +87,74 @@ func TestAccTFETeamToken_existsWithForce(t *testing.T) {
 	})
 }
 
+func TestAccTFETeamToken_expiredAt(t *testing.T) {
+	token := &tfe.TeamToken{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	expiredAt := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	rotatedExpiredAt := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamToken_expiredAt(rInt, expiredAt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamTokenExists(
+						"tfe_team_token.foobar", token),
+					resource.TestCheckResourceAttr(
+						"tfe_team_token.foobar", "expired_at", expiredAt),
+				),
+			},
+
+			{
+				// Changing the expiration date rotates the token.
+				Config: testAccTFETeamToken_expiredAt(rInt, rotatedExpiredAt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamTokenExists(
+						"tfe_team_token.foobar", token),
+					resource.TestCheckResourceAttr(
+						"tfe_team_token.foobar", "expired_at", rotatedExpiredAt),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFETeamToken_ownersTeam(t *testing.T) {
+	token := &tfe.TeamToken{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	expiredAt := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFETeamToken_ownersTeam(rInt, false, expiredAt),
+				ExpectError: regexp.MustCompile(`set allow_owners_token = true`),
+			},
+			{
+				Config:      testAccTFETeamToken_ownersTeam(rInt, true, ""),
+				ExpectError: regexp.MustCompile(`expired_at must be set when generating a token for the owners team`),
+			},
+			{
+				Config: testAccTFETeamToken_ownersTeam(rInt, true, expiredAt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamTokenExists(
+						"tfe_team_token.foobar", token),
+					resource.TestCheckResourceAttrSet(
+						"tfe_team_token.foobar", "created_by"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_team_token.foobar", "serial"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFETeamToken_import(t *testing.T) {
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
 
@@ -107,10 +177,163 @@ func TestAccTFETeamToken_import(t *testing.T) {
 	})
 }
 
+func TestAccTFETeamToken_multiple(t *testing.T) {
+	skipIfEnterprise(t)
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETeamToken_multiple(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_team_token.ci", "description", "ci"),
+					resource.TestCheckResourceAttr(
+						"tfe_team_token.deploy", "description", "deploy"),
+					resource.TestMatchResourceAttr(
+						"tfe_team_token.ci", "id", regexp.MustCompile(`^at-`)),
+					resource.TestCheckResourceAttrSet(
+						"tfe_team_token.deploy", "token"),
+				),
+			},
+			{
+				ResourceName:            "tfe_team_token.ci",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTFETeamTokenImportStateIDFunc("tfe_team_token.ci"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func TestResourceTFETeamTokenCreate_description(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/teams/team-123":
+			fmt.Fprint(w, `{"data":{"id":"team-123","type":"teams","attributes":{"name":"platform"}}}`)
+		case r.Method == "POST" && r.URL.Path == "/api/v2/teams/team-123/authentication-tokens":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data":{"id":"at-123","type":"authentication-tokens","attributes":{"description":"ci","token":"secret"}}}`)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/authentication-tokens/at-123":
+			fmt.Fprint(w, `{"data":{"id":"at-123","type":"authentication-tokens","attributes":{"description":"ci"},"relationships":{"created-by":{"data":{"id":"user-123","type":"users"}}}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	d := resourceTFETeamToken().TestResourceData()
+	d.Set("team_id", "team-123")
+	d.Set("description", "ci")
+
+	// The single team token is never checked or replaced.
+	if err := resourceTFETeamTokenCreate(ctx, d, ConfiguredClient{Client: client}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"token":       "secret",
+		"serial":      "at-123",
+		"description": "ci",
+		"created_by":  "user-123",
+	}
+	if d.Id() != "at-123" {
+		t.Fatalf("expected ID at-123, got %s", d.Id())
+	}
+	for key, want := range expected {
+		if got := d.Get(key).(string); got != want {
+			t.Fatalf("expected %s to be %q, got %q", key, want, got)
+		}
+	}
+}
+
+func TestResourceTFETeamTokenRead(t *testing.T) {
+	var requests []string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/teams/team-123/authentication-token":
+			fmt.Fprint(w, `{"data":{"id":"at-456","type":"authentication-tokens","relationships":{"created-by":{"data":{"id":"team-123","type":"teams"}}}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	d := resourceTFETeamToken().TestResourceData()
+	d.SetId("team-123")
+	d.Set("team_id", "team-123")
+
+	if err := resourceTFETeamTokenRead(ctx, d, ConfiguredClient{Client: server.Client}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if d.Get("serial").(string) != "at-456" || d.Get("created_by").(string) != "team-123" {
+		t.Fatalf("expected serial at-456 created by team-123, got %q created by %q", d.Get("serial"), d.Get("created_by"))
+	}
+
+	// The creator is read together with the token.
+	if len(requests) != 1 {
+		t.Fatalf("expected a single request, got %v", requests)
+	}
+}
+
+func TestResourceTFETeamTokenDelete_regenerated(t *testing.T) {
+	var deleted bool
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/teams/team-123/authentication-token":
+			fmt.Fprint(w, `{"data":{"id":"at-new","type":"authentication-tokens"}}`)
+		case r.Method == "DELETE" && r.URL.Path == "/api/v2/teams/team-123/authentication-token":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	meta := ConfiguredClient{Client: server.Client}
+
+	// The token was regenerated by the replacement of the resource.
+	d := resourceTFETeamToken().TestResourceData()
+	d.SetId("team-123")
+	d.Set("serial", "at-old")
+
+	if err := resourceTFETeamTokenDelete(ctx, d, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deleted {
+		t.Fatal("expected the regenerated token to be kept")
+	}
+
+	d.Set("serial", "at-new")
+
+	if err := resourceTFETeamTokenDelete(ctx, d, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !deleted {
+		t.Fatal("expected the token to be deleted")
+	}
+}
+
+func testAccTFETeamTokenImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["team_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckTFETeamTokenExists(
 	n string, token *tfe.TeamToken) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -137,7 +360,7 @@ func testAccCheckTFETeamTokenExists(
 }
 
 func testAccCheckTFETeamTokenDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_team_token" {
@@ -148,7 +371,12 @@ func testAccCheckTFETeamTokenDestroy(s *terraform.State) error {
 			return fmt.Errorf("No instance ID is set")
 		}
 
-		_, err := tfeClient.TeamTokens.Read(ctx, rs.Primary.ID)
+		var err error
+		if isTeamAuthenticationTokenID(rs.Primary.ID) {
+			_, err = readTeamAuthenticationToken(ctx, tfeClient, rs.Primary.ID)
+		} else {
+			_, err = tfeClient.TeamTokens.Read(ctx, rs.Primary.ID)
+		}
 		if err == nil {
 			return fmt.Errorf("Team token %s still exists", rs.Primary.ID)
 		}
@@ -216,3 +444,71 @@ resource "tfe_team_token" "regenerated" {
   force_regenerate = true
 }`, rInt)
 }
+
+func testAccTFETeamToken_expiredAt(rInt int, expiredAt string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_team_token" "foobar" {
+  team_id    = tfe_team.foobar.id
+  expired_at = "%s"
+}`, rInt, expiredAt)
+}
+
+func testAccTFETeamToken_ownersTeam(rInt int, allowOwnersToken bool, expiredAt string) string {
+	expiredAtConfig := ""
+	if expiredAt != "" {
+		expiredAtConfig = fmt.Sprintf("expired_at = %q", expiredAt)
+	}
+
+	return fmt.Sprintf(`
+provider "tfe" {
+  allow_owners_token = %t
+}
+
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+data "tfe_team" "owners" {
+  name         = "owners"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_team_token" "foobar" {
+  team_id = data.tfe_team.owners.id
+  %s
+}`, allowOwnersToken, rInt, expiredAtConfig)
+}
+
+func testAccTFETeamToken_multiple(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name         = "team-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_team_token" "ci" {
+  team_id     = tfe_team.foobar.id
+  description = "ci"
+}
+
+resource "tfe_team_token" "deploy" {
+  team_id     = tfe_team.foobar.id
+  description = "deploy"
+}`, rInt)
+}
@@ -111,8 +111,46 @@ func TestAccTFETerraformVersion_full(t *testing.T) {
 	})
 }
 
+func TestAccTFETerraformVersion_archs(t *testing.T) {
+	skipIfCloud(t)
+
+	sha := genSha(t, "secret", "data")
+	version := genSafeRandomTerraformVersion()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETerraformVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFETerraformVersion_archs(version, sha),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_terraform_version.foobar", "archs.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"tfe_terraform_version.foobar", "archs.*", map[string]string{
+							"url":  "https://www.hashicorp.com/arm64",
+							"sha":  sha,
+							"os":   "linux",
+							"arch": "arm64",
+						}),
+					resource.TestCheckResourceAttr(
+						"tfe_terraform_version.foobar", "url", "https://www.hashicorp.com/amd64"),
+					resource.TestCheckResourceAttr(
+						"tfe_terraform_version.foobar", "sha", sha),
+				),
+			},
+			{
+				ResourceName:      "tfe_terraform_version.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckTFETerraformVersionDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_terraform_version" {
@@ -134,7 +172,7 @@ func testAccCheckTFETerraformVersionDestroy(s *terraform.State) error {
 
 func testAccCheckTFETerraformVersionExists(n string, tfVersion *tfe.AdminTerraformVersion) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -239,6 +277,25 @@ resource "tfe_terraform_version" "foobar" {
 }`, version, sha)
 }
 
+func testAccTFETerraformVersion_archs(version string, sha string) string {
+	return fmt.Sprintf(`
+resource "tfe_terraform_version" "foobar" {
+  version = "%[1]s"
+
+  archs {
+    url  = "https://www.hashicorp.com/amd64"
+    sha  = "%[2]s"
+    arch = "amd64"
+  }
+
+  archs {
+    url  = "https://www.hashicorp.com/arm64"
+    sha  = "%[2]s"
+    arch = "arm64"
+  }
+}`, version, sha)
+}
+
 // Helper functions
 func genSha(t *testing.T, secret, data string) string {
 	h := hmac.New(sha256.New, []byte(secret))
@@ -13,10 +13,10 @@ import (
 
 func resourceTFEVariable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEVariableCreate,
-		Read:   resourceTFEVariableRead,
-		Update: resourceTFEVariableUpdate,
-		Delete: resourceTFEVariableDelete,
+		CreateContext: crudContext(resourceTFEVariableCreate),
+		ReadContext:   crudContext(resourceTFEVariableRead),
+		UpdateContext: crudContext(resourceTFEVariableUpdate),
+		DeleteContext: crudContext(resourceTFEVariableDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEVariableImporter,
 		},
@@ -36,10 +36,26 @@ func resourceTFEVariable() *schema.Resource {
 			},
 
 			"value": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Default:   "",
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				Sensitive:     true,
+				ConflictsWith: []string{"value_wo"},
+			},
+
+			"value_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"value"},
+				RequiredWith:  []string{"value_wo_version"},
+			},
+
+			"value_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"value_wo"},
 			},
 
 			"category": {
@@ -73,6 +89,11 @@ func resourceTFEVariable() *schema.Resource {
 				Default:  false,
 			},
 
+			"readable_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"workspace_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -98,8 +119,23 @@ func resourceTFEVariable() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: forceRecreateResourceIf(),
+		CustomizeDiff: func(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := forceRecreateResourceIf()(c, d, meta); err != nil {
+				return err
+			}
+
+			return setReadableValueComputed(d)
+		},
+	}
+}
+
+// setReadableValueComputed marks readable_value as unknown when the value or
+// sensitivity of the variable changes, so it is not planned with the old value.
+func setReadableValueComputed(d *schema.ResourceDiff) error {
+	if d.Id() == "" || d.HasChanges("value", "value_wo_version", "sensitive") {
+		return d.SetNewComputed("readable_value")
 	}
+	return nil
 }
 
 func forceRecreateResourceIf() schema.CustomizeDiffFunc {
@@ -120,14 +156,32 @@ func forceRecreateResourceIf() schema.CustomizeDiffFunc {
 	}
 }
 
-func resourceTFEVariableCreate(d *schema.ResourceData, meta interface{}) error {
+// variableValue returns the value to send when creating or updating a
+// variable. The write-only value_wo is only sent when the variable is created
+// or value_wo_version changes, otherwise nil is returned and the API keeps
+// the value.
+func variableValue(d *schema.ResourceData) (*string, error) {
+	if _, ok := d.GetOkExists("value_wo_version"); !ok {
+		return tfe.String(d.Get("value").(string)), nil
+	}
+	if d.Id() != "" && !d.HasChange("value_wo_version") {
+		return nil, nil
+	}
+	v, err := writeOnlyValue(d, "value_wo")
+	if err != nil {
+		return nil, err
+	}
+	return tfe.String(v), nil
+}
+
+func resourceTFEVariableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// Switch to variable set variable logic if we need to
 	_, variableSetIdProvided := d.GetOk("variable_set_id")
 	if variableSetIdProvided {
-		return resourceTFEVariableSetVariableCreate(d, meta)
+		return resourceTFEVariableSetVariableCreate(ctx, d, meta)
 	}
 
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get key and category.
 	key := d.Get("key").(string)
@@ -141,10 +195,15 @@ func resourceTFEVariableCreate(d *schema.ResourceData, meta interface{}) error {
 			"Error retrieving workspace %s: %w", workspaceID, err)
 	}
 
+	value, err := variableValue(d)
+	if err != nil {
+		return err
+	}
+
 	// Create a new options struct.
 	options := tfe.VariableCreateOptions{
 		Key:         tfe.String(key),
-		Value:       tfe.String(d.Get("value").(string)),
+		Value:       value,
 		Category:    tfe.Category(tfe.CategoryType(category)),
 		HCL:         tfe.Bool(d.Get("hcl").(bool)),
 		Sensitive:   tfe.Bool(d.Get("sensitive").(bool)),
@@ -159,11 +218,11 @@ func resourceTFEVariableCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(variable.ID)
 
-	return resourceTFEVariableRead(d, meta)
+	return resourceTFEVariableRead(ctx, d, meta)
 }
 
-func resourceTFEVariableSetVariableCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEVariableSetVariableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get key and category.
 	key := d.Get("key").(string)
@@ -177,10 +236,15 @@ func resourceTFEVariableSetVariableCreate(d *schema.ResourceData, meta interface
 			"Error retrieving variable set %s: %w", variableSetID, err)
 	}
 
+	value, err := variableValue(d)
+	if err != nil {
+		return err
+	}
+
 	// Create a new options struct.
 	options := tfe.VariableSetVariableCreateOptions{
 		Key:         tfe.String(key),
-		Value:       tfe.String(d.Get("value").(string)),
+		Value:       value,
 		Category:    tfe.Category(tfe.CategoryType(category)),
 		HCL:         tfe.Bool(d.Get("hcl").(bool)),
 		Sensitive:   tfe.Bool(d.Get("sensitive").(bool)),
@@ -195,17 +259,17 @@ func resourceTFEVariableSetVariableCreate(d *schema.ResourceData, meta interface
 
 	d.SetId(variable.ID)
 
-	return resourceTFEVariableRead(d, meta)
+	return resourceTFEVariableRead(ctx, d, meta)
 }
 
-func resourceTFEVariableRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// Switch to variable set variable logic if we need to
 	_, variableSetIdProvided := d.GetOk("variable_set_id")
 	if variableSetIdProvided {
-		return resourceTFEVariableSetVariableRead(d, meta)
+		return resourceTFEVariableSetVariableRead(ctx, d, meta)
 	}
 
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the workspace.
 	workspaceID := d.Get("workspace_id").(string)
@@ -238,16 +302,20 @@ func resourceTFEVariableRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("hcl", variable.HCL)
 	d.Set("sensitive", variable.Sensitive)
 
-	// Only set the value if its not sensitive, as otherwise it will be empty.
-	if !variable.Sensitive {
+	// Only set the value if its not sensitive, as otherwise it will be empty,
+	// and never when it is configured with the write-only value_wo.
+	if _, writeOnly := d.GetOkExists("value_wo_version"); !variable.Sensitive && !writeOnly {
 		d.Set("value", variable.Value)
+		d.Set("readable_value", variable.Value)
+	} else {
+		d.Set("readable_value", "")
 	}
 
 	return nil
 }
 
-func resourceTFEVariableSetVariableRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEVariableSetVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the variable set
 	variableSetID := d.Get("variable_set_id").(string)
@@ -280,22 +348,26 @@ func resourceTFEVariableSetVariableRead(d *schema.ResourceData, meta interface{}
 	d.Set("hcl", variable.HCL)
 	d.Set("sensitive", variable.Sensitive)
 
-	// Only set the value if its not sensitive, as otherwise it will be empty.
-	if !variable.Sensitive {
+	// Only set the value if its not sensitive, as otherwise it will be empty,
+	// and never when it is configured with the write-only value_wo.
+	if _, writeOnly := d.GetOkExists("value_wo_version"); !variable.Sensitive && !writeOnly {
 		d.Set("value", variable.Value)
+		d.Set("readable_value", variable.Value)
+	} else {
+		d.Set("readable_value", "")
 	}
 
 	return nil
 }
 
-func resourceTFEVariableUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEVariableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// Switch to variable set variable logic if we need to
 	_, variableSetIdProvided := d.GetOk("variable_set_id")
 	if variableSetIdProvided {
-		return resourceTFEVariableSetVariableUpdate(d, meta)
+		return resourceTFEVariableSetVariableUpdate(ctx, d, meta)
 	}
 
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the workspace.
 	workspaceID := d.Get("workspace_id").(string)
@@ -305,10 +377,15 @@ func resourceTFEVariableUpdate(d *schema.ResourceData, meta interface{}) error {
 			"Error retrieving workspace %s: %w", workspaceID, err)
 	}
 
+	value, err := variableValue(d)
+	if err != nil {
+		return err
+	}
+
 	// Create a new options struct.
 	options := tfe.VariableUpdateOptions{
 		Key:         tfe.String(d.Get("key").(string)),
-		Value:       tfe.String(d.Get("value").(string)),
+		Value:       value,
 		HCL:         tfe.Bool(d.Get("hcl").(bool)),
 		Sensitive:   tfe.Bool(d.Get("sensitive").(bool)),
 		Description: tfe.String(d.Get("description").(string)),
@@ -320,11 +397,11 @@ func resourceTFEVariableUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error updating variable %s: %w", d.Id(), err)
 	}
 
-	return resourceTFEVariableRead(d, meta)
+	return resourceTFEVariableRead(ctx, d, meta)
 }
 
-func resourceTFEVariableSetVariableUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEVariableSetVariableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the variable set.
 	variableSetID := d.Get("variable_set_id").(string)
@@ -334,10 +411,15 @@ func resourceTFEVariableSetVariableUpdate(d *schema.ResourceData, meta interface
 			"Error retrieving variable set %s: %w", variableSetID, err)
 	}
 
+	value, err := variableValue(d)
+	if err != nil {
+		return err
+	}
+
 	// Create a new options struct.
 	options := tfe.VariableSetVariableUpdateOptions{
 		Key:         tfe.String(d.Get("key").(string)),
-		Value:       tfe.String(d.Get("value").(string)),
+		Value:       value,
 		HCL:         tfe.Bool(d.Get("hcl").(bool)),
 		Sensitive:   tfe.Bool(d.Get("sensitive").(bool)),
 		Description: tfe.String(d.Get("description").(string)),
@@ -349,17 +431,17 @@ func resourceTFEVariableSetVariableUpdate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error updating variable %s: %w", d.Id(), err)
 	}
 
-	return resourceTFEVariableRead(d, meta)
+	return resourceTFEVariableRead(ctx, d, meta)
 }
 
-func resourceTFEVariableDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEVariableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// Switch to variable set variable logic if we need to
 	_, variableSetIdProvided := d.GetOk("variable_set_id")
 	if variableSetIdProvided {
-		return resourceTFEVariableSetVariableDelete(d, meta)
+		return resourceTFEVariableSetVariableDelete(ctx, d, meta)
 	}
 
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the workspace.
 	workspaceID := d.Get("workspace_id").(string)
@@ -381,8 +463,8 @@ func resourceTFEVariableDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFEVariableSetVariableDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEVariableSetVariableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the variable set.
 	variableSetID := d.Get("variable_set_id").(string)
@@ -405,30 +487,124 @@ func resourceTFEVariableSetVariableDelete(d *schema.ResourceData, meta interface
 }
 
 func resourceTFEVariableImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	s := strings.SplitN(d.Id(), "/", 3)
 	if len(s) != 3 {
 		return nil, fmt.Errorf(
-			"invalid variable import format: %s (expected <ORGANIZATION>/<WORKSPACE|VARIABLE SET>/<VARIABLE ID>)",
+			"invalid variable import format: %s (expected <ORGANIZATION>/<WORKSPACE|VARIABLE SET>/<VARIABLE ID|KEY>)",
 			d.Id(),
 		)
 	}
 
+	// The last part of the import ID is either the ID or the key of the
+	// variable.
+	variableID := s[2]
+	byKey := !strings.HasPrefix(variableID, "var-")
+
 	varsetIDUsed := variableSetIdRegexp.MatchString(s[1])
 	if varsetIDUsed {
 		d.Set("variable_set_id", s[1])
+
+		if byKey {
+			id, err := fetchVariableSetVariableIDByKey(ctx, tfeClient, s[1], s[2])
+			if err != nil {
+				return nil, err
+			}
+			variableID = id
+		}
 	} else {
 		// Set the fields that are part of the import ID.
-		workspaceID, err := fetchWorkspaceExternalID(s[0]+"/"+s[1], tfeClient)
+		workspaceID, err := fetchWorkspaceExternalID(ctx, s[0]+"/"+s[1], tfeClient)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error retrieving workspace %s from organization %s: %w", s[1], s[0], err)
 		}
 		d.Set("workspace_id", workspaceID)
+
+		if byKey {
+			id, err := fetchVariableIDByKey(ctx, tfeClient, workspaceID, s[2])
+			if err != nil {
+				return nil, err
+			}
+			variableID = id
+		}
 	}
 
-	d.SetId(s[2])
+	d.SetId(variableID)
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// fetchVariableIDByKey returns the ID of the variable of a workspace with the
+// given key. Keys are only unique per category, so an error is returned when
+// both a Terraform and an environment variable have the key.
+func fetchVariableIDByKey(ctx context.Context, client *tfe.Client, workspaceID, key string) (string, error) {
+	var ids []string
+
+	options := &tfe.VariableListOptions{}
+	for {
+		l, err := client.Variables.List(ctx, workspaceID, options)
+		if err != nil {
+			return "", fmt.Errorf("Error retrieving variables of workspace %s: %w", workspaceID, err)
+		}
+
+		for _, v := range l.Items {
+			if v.Key == key {
+				ids = append(ids, v.ID)
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return uniqueVariableID(ids, key, workspaceID)
+}
+
+// fetchVariableSetVariableIDByKey returns the ID of the variable of a
+// variable set with the given key.
+func fetchVariableSetVariableIDByKey(ctx context.Context, client *tfe.Client, variableSetID, key string) (string, error) {
+	var ids []string
+
+	options := &tfe.VariableSetVariableListOptions{}
+	for {
+		l, err := client.VariableSetVariables.List(ctx, variableSetID, options)
+		if err != nil {
+			return "", fmt.Errorf("Error retrieving variables of variable set %s: %w", variableSetID, err)
+		}
+
+		for _, v := range l.Items {
+			if v.Key == key {
+				ids = append(ids, v.ID)
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return uniqueVariableID(ids, key, variableSetID)
+}
+
+func uniqueVariableID(ids []string, key, parentID string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("could not find variable %s in %s", key, parentID)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf(
+			"found %d variables with key %s in %s, import the variable by its ID instead", len(ids), key, parentID)
+	}
+}
@@ -29,7 +29,7 @@ func TestResourceTfeVariableStateUpgradeV0(t *testing.T) {
 	})
 
 	expected := testResourceTfeVariableStateDataV1()
-	actual, err := resourceTfeVariableStateUpgradeV0(context.Background(), testResourceTfeVariableStateDataV0(), client)
+	actual, err := resourceTfeVariableStateUpgradeV0(context.Background(), testResourceTfeVariableStateDataV0(), ConfiguredClient{Client: client})
 	if err != nil {
 		t.Fatalf("error migrating state: %s", err)
 	}
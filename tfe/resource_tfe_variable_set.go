@@ -1,6 +1,7 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -13,14 +14,16 @@ var variableSetIdRegexp = regexp.MustCompile("varset-[a-zA-Z0-9]{16}$")
 
 func resourceTFEVariableSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEVariableSetCreate,
-		Read:   resourceTFEVariableSetRead,
-		Update: resourceTFEVariableSetUpdate,
-		Delete: resourceTFEVariableSetDelete,
+		CreateContext: crudContext(resourceTFEVariableSetCreate),
+		ReadContext:   crudContext(resourceTFEVariableSetRead),
+		UpdateContext: crudContext(resourceTFEVariableSetUpdate),
+		DeleteContext: crudContext(resourceTFEVariableSetDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeDiffDefaultOrganization,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -39,9 +42,16 @@ func resourceTFEVariableSet() *schema.Resource {
 				ConflictsWith: []string{"workspace_ids"},
 			},
 
+			"retain_assignments_on_unglobal": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -55,12 +65,15 @@ func resourceTFEVariableSet() *schema.Resource {
 	}
 }
 
-func resourceTFEVariableSetCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEVariableSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	// Create a new options struct.
 	options := tfe.VariableSetCreateOptions{
@@ -98,11 +111,11 @@ func resourceTFEVariableSetCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return resourceTFEVariableSetRead(d, meta)
+	return resourceTFEVariableSetRead(ctx, d, meta)
 }
 
-func resourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEVariableSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Read configuration of variable set: %s", d.Id())
 	variableSet, err := tfeClient.VariableSets.Read(ctx, d.Id(), &tfe.VariableSetReadOptions{
@@ -132,8 +145,22 @@ func resourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTFEVariableSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEVariableSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(ConfiguredClient)
+	tfeClient := config.Client
+
+	// When global is unset and the workspaces are not configured, the
+	// assignments are reconciled explicitly. By default the assignments are
+	// retained, and they are made before global is unset, so the workspaces
+	// never lose the variables.
+	unglobal := d.HasChange("global") && !d.Get("global").(bool) &&
+		d.GetRawConfig().GetAttr("workspace_ids").IsNull()
+	retain := d.Get("retain_assignments_on_unglobal").(bool)
+	if unglobal && retain {
+		if err := retainVariableSetAssignments(ctx, tfeClient, d.Id(), d.Get("organization").(string)); err != nil {
+			return err
+		}
+	}
 
 	if d.HasChange("name") || d.HasChange("description") || d.HasChange("global") {
 		options := tfe.VariableSetUpdateOptions{
@@ -149,7 +176,17 @@ func resourceTFEVariableSetUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	if d.HasChanges("workspace_ids") {
+	if unglobal && !retain {
+		log.Printf("[DEBUG] Remove variable set %s from all workspaces", d.Id())
+		_, err := tfeClient.VariableSets.UpdateWorkspaces(ctx, d.Id(), &tfe.VariableSetUpdateWorkspacesOptions{
+			Workspaces: []*tfe.Workspace{},
+		})
+		if err != nil {
+			return fmt.Errorf("Error removing variable set %s from workspaces: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("workspace_ids") && config.shouldWrite(d.GetRawConfig(), "workspace_ids") {
 		workspaceIDs := d.Get("workspace_ids")
 		applyOptions := tfe.VariableSetUpdateWorkspacesOptions{}
 		applyOptions.Workspaces = []*tfe.Workspace{}
@@ -168,11 +205,11 @@ func resourceTFEVariableSetUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return resourceTFEVariableSetRead(d, meta)
+	return resourceTFEVariableSetRead(ctx, d, meta)
 }
 
-func resourceTFEVariableSetDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEVariableSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	log.Printf("[DEBUG] Delete variable set: %s", d.Id())
 	err := tfeClient.VariableSets.Delete(ctx, d.Id())
@@ -186,6 +223,30 @@ func resourceTFEVariableSetDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// retainVariableSetAssignments applies a global variable set explicitly to
+// all workspaces of its organization, which also unsets global.
+func retainVariableSetAssignments(ctx context.Context, client *tfe.Client, variableSetID, organization string) error {
+	workspaceIDs, err := listOrganizationWorkspaceIDs(ctx, client, organization)
+	if err != nil {
+		return err
+	}
+
+	options := tfe.VariableSetUpdateWorkspacesOptions{
+		Workspaces: []*tfe.Workspace{},
+	}
+	for _, id := range workspaceIDs {
+		options.Workspaces = append(options.Workspaces, &tfe.Workspace{ID: id})
+	}
+
+	log.Printf("[DEBUG] Apply variable set %s to all %d workspaces of organization %s", variableSetID, len(workspaceIDs), organization)
+	_, err = client.VariableSets.UpdateWorkspaces(ctx, variableSetID, &options)
+	if err != nil {
+		return fmt.Errorf("Error applying variable set %s to the workspaces of organization %s: %w", variableSetID, organization, err)
+	}
+
+	return nil
+}
+
 func warnWorkspaceIdsDeprecation() {
 	log.Printf("[WARN] The workspace_ids field of tfe_variable_set is deprecated as of release 0.33.0 and may be removed in a future version. The preferred method of associating a variable set to a workspace is by using the tfe_workspace_variable_set resource.")
 }
@@ -2,13 +2,18 @@ package tfe
 
 import (
 	"fmt"
+	"io"
 	"math/rand"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
 )
 
 func TestAccTFEVariableSet_basic(t *testing.T) {
@@ -128,15 +133,213 @@ func TestAccTFEVariableSet_import(t *testing.T) {
 				ImportState:         true,
 				ImportStateIdPrefix: "",
 				ImportStateVerify:   true,
+				ImportStateVerifyIgnore: []string{
+					"retain_assignments_on_unglobal",
+				},
 			},
 		},
 	})
 }
 
+func TestRetainVariableSetAssignments(t *testing.T) {
+	var body string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/organizations/hashicorp/workspaces":
+			if r.URL.Query().Get("page[number]") == "2" {
+				fmt.Fprint(w, `{"data":[{"id":"ws-2","type":"workspaces"}],"meta":{"pagination":{"current-page":2,"total-pages":2}}}`)
+				return
+			}
+			fmt.Fprint(w, `{"data":[{"id":"ws-1","type":"workspaces"}],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2}}}`)
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/varsets/varset-123":
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+			fmt.Fprint(w, `{"data":{"id":"varset-123","type":"varsets","attributes":{"global":false}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	if err := retainVariableSetAssignments(ctx, client, "varset-123", "hashicorp"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{`"global":false`, `"id":"ws-1"`, `"id":"ws-2"`} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("expected request body to contain %s, got: %s", want, body)
+		}
+	}
+}
+
+func TestTFEVariableSetUpdate_reconcileServerDefaults(t *testing.T) {
+	cases := map[string]struct {
+		reconcile bool
+		requests  []string
+	}{
+		"reconcile": {
+			reconcile: true,
+			requests: []string{
+				"PATCH /api/v2/varsets/varset-123",
+				"GET /api/v2/varsets/varset-123",
+			},
+		},
+		"no reconcile": {
+			reconcile: false,
+			requests: []string{
+				"GET /api/v2/varsets/varset-123",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		var requests []string
+		server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+
+			switch r.Method {
+			case "PATCH":
+				fmt.Fprint(w, `{"data":{"id":"varset-123","type":"varsets"}}`)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		// The workspaces changed, but they are not configured.
+		r := resourceTFEVariableSet()
+		rawConfig := testRawConfig(r, map[string]cty.Value{
+			"name": cty.StringVal("varset"),
+		})
+		state := &terraform.InstanceState{
+			ID: "varset-123",
+			Attributes: map[string]string{
+				"id":                                     "varset-123",
+				"name":                                   "varset",
+				"organization":                           "hashicorp",
+				"workspace_ids.#":                        "1",
+				setElementKey("workspace_ids", "ws-old"): "ws-old",
+			},
+			RawConfig: rawConfig,
+		}
+		diff := &terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{
+				"workspace_ids.#":                        {Old: "1", New: "1"},
+				setElementKey("workspace_ids", "ws-old"): {Old: "ws-old", New: "", NewRemoved: true},
+				setElementKey("workspace_ids", "ws-new"): {Old: "", New: "ws-new"},
+			},
+			RawConfig: rawConfig,
+		}
+
+		meta := ConfiguredClient{Client: server.Client, ReconcileServerDefaults: tc.reconcile}
+		if _, diags := r.Apply(ctx, state, diff, meta); diags.HasError() {
+			t.Fatalf("%s: unexpected error: %v", name, diags)
+		}
+
+		if fmt.Sprint(requests) != fmt.Sprint(tc.requests) {
+			t.Fatalf("%s: wrong requests\ngot: %v\nwant: %v", name, requests, tc.requests)
+		}
+	}
+}
+
+func TestTFEVariableSetUpdate_unglobal(t *testing.T) {
+	cases := map[string]struct {
+		config   map[string]cty.Value
+		retain   string
+		requests []string
+	}{
+		"retain by default": {
+			retain: "true",
+			requests: []string{
+				"GET /api/v2/organizations/hashicorp/workspaces",
+				"PATCH /api/v2/varsets/varset-123",
+				"PATCH /api/v2/varsets/varset-123",
+				"GET /api/v2/varsets/varset-123",
+			},
+		},
+		"detach": {
+			config: map[string]cty.Value{
+				"retain_assignments_on_unglobal": cty.False,
+			},
+			retain: "false",
+			requests: []string{
+				"PATCH /api/v2/varsets/varset-123",
+				"PATCH /api/v2/varsets/varset-123",
+				"GET /api/v2/varsets/varset-123",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		var requests, bodies []string
+		server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+
+			switch {
+			case r.Method == "GET" && r.URL.Path == "/api/v2/organizations/hashicorp/workspaces":
+				fmt.Fprint(w, `{"data":[{"id":"ws-1","type":"workspaces"}],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+			case r.Method == "PATCH":
+				b, _ := io.ReadAll(r.Body)
+				bodies = append(bodies, string(b))
+				fmt.Fprint(w, `{"data":{"id":"varset-123","type":"varsets"}}`)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		config := map[string]cty.Value{
+			"name":   cty.StringVal("varset"),
+			"global": cty.False,
+		}
+		for k, v := range tc.config {
+			config[k] = v
+		}
+
+		r := resourceTFEVariableSet()
+		rawConfig := testRawConfig(r, config)
+		state := &terraform.InstanceState{
+			ID: "varset-123",
+			Attributes: map[string]string{
+				"id":                             "varset-123",
+				"name":                           "varset",
+				"organization":                   "hashicorp",
+				"global":                         "true",
+				"retain_assignments_on_unglobal": tc.retain,
+			},
+			RawConfig: rawConfig,
+		}
+		diff := &terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{
+				"global": {Old: "true", New: "false"},
+			},
+			RawConfig: rawConfig,
+		}
+
+		meta := ConfiguredClient{Client: server.Client}
+		if _, diags := r.Apply(ctx, state, diff, meta); diags.HasError() {
+			t.Fatalf("%s: unexpected error: %v", name, diags)
+		}
+
+		if fmt.Sprint(requests) != fmt.Sprint(tc.requests) {
+			t.Fatalf("%s: wrong requests\ngot: %v\nwant: %v", name, requests, tc.requests)
+		}
+
+		// The workspaces are applied before global is unset when retained,
+		// and removed after global is unset when detached.
+		workspaces := bodies[0]
+		if tc.retain == "false" {
+			workspaces = bodies[1]
+		}
+		if retained := strings.Contains(workspaces, `"id":"ws-1"`); retained != (tc.retain == "true") {
+			t.Fatalf("%s: unexpected workspaces: %s", name, workspaces)
+		}
+	}
+}
+
 func testAccCheckTFEVariableSetExists(
 	n string, variableSet *tfe.VariableSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -217,7 +420,7 @@ func testAccCheckTFEVariableSetApplicationUpdate(variableSet *tfe.VariableSet) r
 }
 
 func testAccCheckTFEVariableSetDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_variable_set" {
@@ -2,13 +2,19 @@ package tfe
 
 import (
 	"fmt"
+	"io"
 	"math/rand"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
 )
 
 func TestAccTFEVariable_basic(t *testing.T) {
@@ -38,12 +44,169 @@ func TestAccTFEVariable_basic(t *testing.T) {
 						"tfe_variable.foobar", "hcl", "false"),
 					resource.TestCheckResourceAttr(
 						"tfe_variable.foobar", "sensitive", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "readable_value", "value_test"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccTFEVariable_writeOnly(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEVariableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEVariable_writeOnly(rInt, "value_1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "value", ""),
+					resource.TestCheckNoResourceAttr(
+						"tfe_variable.foobar", "value_wo"),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "value_wo_version", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "readable_value", ""),
+				),
+			},
+			{
+				Config: testAccTFEVariable_writeOnly(rInt, "value_2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(
+						"tfe_variable.foobar", "value_wo"),
+					resource.TestCheckResourceAttr(
+						"tfe_variable.foobar", "value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceTFEVariable_writeOnly(t *testing.T) {
+	var bodies []string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/workspaces/ws-123":
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"workspace-test"}}}`)
+		case "POST /api/v2/workspaces/ws-123/vars", "PATCH /api/v2/workspaces/ws-123/vars/var-123":
+			bodies = append(bodies, string(body))
+			fallthrough
+		case "GET /api/v2/workspaces/ws-123/vars/var-123":
+			fmt.Fprint(w, `{"data":{"id":"var-123","type":"vars","attributes":{"key":"key_test","value":"secret","category":"env","sensitive":false}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	t.Setenv("TFE_API_URL", server.URL)
+	t.Setenv("TFE_TOKEN", "not-a-token")
+
+	provider := testMuxedProviderServer(t)
+	schemas, err := provider.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	configureResp, err := provider.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		Config: testDynamicValue(t, schemas.Provider.Block, map[string]tftypes.Value{
+			"hostname": tftypes.NewValue(tftypes.String, "tfe.invalid"),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range configureResp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	block := schemas.ResourceSchemas["tfe_variable"].Block
+	nullState, err := tfprotov5.NewDynamicValue(block.ValueType(), tftypes.NewValue(block.ValueType(), nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// apply plans and applies a configuration, and returns the new state.
+	apply := func(prior *tfprotov5.DynamicValue, description, value string, version int) *tfprotov5.DynamicValue {
+		config := testDynamicValue(t, block, map[string]tftypes.Value{
+			"key":              tftypes.NewValue(tftypes.String, "key_test"),
+			"description":      tftypes.NewValue(tftypes.String, description),
+			"category":         tftypes.NewValue(tftypes.String, "env"),
+			"workspace_id":     tftypes.NewValue(tftypes.String, "ws-123"),
+			"value_wo":         tftypes.NewValue(tftypes.String, value),
+			"value_wo_version": tftypes.NewValue(tftypes.Number, version),
+		})
+
+		planResp, err := provider.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+			TypeName:         "tfe_variable",
+			PriorState:       prior,
+			ProposedNewState: config,
+			Config:           config,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, d := range planResp.Diagnostics {
+			t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+		}
+
+		applyResp, err := provider.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+			TypeName:       "tfe_variable",
+			PriorState:     prior,
+			PlannedState:   planResp.PlannedState,
+			PlannedPrivate: planResp.PlannedPrivate,
+			Config:         config,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, d := range applyResp.Diagnostics {
+			t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+		}
+
+		state, err := applyResp.NewState.Unmarshal(block.ValueType())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var attributes map[string]tftypes.Value
+		if err := state.As(&attributes); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !attributes["value_wo"].IsNull() {
+			t.Fatalf("expected no value_wo in the state, got %s", attributes["value_wo"])
+		}
+		if attributes["value"].Equal(tftypes.NewValue(tftypes.String, "secret")) {
+			t.Fatalf("expected the value not to be read into the state, got %s", attributes["value"])
+		}
+		return applyResp.NewState
+	}
+
+	// The value is only sent again when value_wo_version changes.
+	state := apply(&nullState, "", "secret", 1)
+	state = apply(state, "changed", "rotated", 1)
+	apply(state, "changed", "rotated", 2)
+
+	want := []string{
+		`"value":"secret"`,
+		"",
+		`"value":"rotated"`,
+	}
+	if len(bodies) != len(want) {
+		t.Fatalf("expected %d requests with a body, got %v", len(want), bodies)
+	}
+	for i, body := range bodies {
+		if want[i] == "" && strings.Contains(body, `"value"`) {
+			t.Fatalf("request %d: expected no value, got %s", i, body)
+		}
+		if want[i] != "" && !strings.Contains(body, want[i]) {
+			t.Fatalf("request %d: expected %s, got %s", i, want[i], body)
+		}
+	}
+}
+
 func TestAccTFEVariable_basic_variable_set(t *testing.T) {
 	variable := &tfe.VariableSetVariable{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -204,14 +367,53 @@ func TestAccTFEVariable_import(t *testing.T) {
 				ImportStateIdPrefix: fmt.Sprintf("tst-terraform-%d/workspace-test/", rInt),
 				ImportStateVerify:   true,
 			},
+
+			{
+				ResourceName:      "tfe_variable.foobar",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("tst-terraform-%d/workspace-test/key_test", rInt),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func TestFetchVariableIDByKey(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-123/vars":
+			fmt.Fprint(w, `{"data":[
+				{"id":"var-1","type":"vars","attributes":{"key":"region","category":"terraform"}},
+				{"id":"var-2","type":"vars","attributes":{"key":"token","category":"terraform"}},
+				{"id":"var-3","type":"vars","attributes":{"key":"token","category":"env"}}
+			],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	id, err := fetchVariableIDByKey(ctx, client, "ws-123", "region")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "var-1" {
+		t.Fatalf("expected var-1, got %s", id)
+	}
+
+	if _, err := fetchVariableIDByKey(ctx, client, "ws-123", "token"); err == nil {
+		t.Fatal("expected an error for a key used by several variables")
+	}
+	if _, err := fetchVariableIDByKey(ctx, client, "ws-123", "missing"); err == nil {
+		t.Fatal("expected an error for a missing key")
+	}
+}
+
 func testAccCheckTFEVariableExists(
 	n string, variable *tfe.Variable) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -243,7 +445,7 @@ func testAccCheckTFEVariableExists(
 func testAccCheckTFEVariableSetVariableExists(
 	n string, variable *tfe.VariableSetVariable) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -408,7 +610,7 @@ func testAccCheckTFEVariableIDsNotEqual(
 }
 
 func testAccCheckTFEVariableDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_variable" {
@@ -515,3 +717,25 @@ resource "tfe_variable" "foobar" {
   workspace_id = tfe_workspace.foobar.id
 }`, rInt)
 }
+
+func testAccTFEVariable_writeOnly(rInt int, value string, version int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_variable" "foobar" {
+  key              = "key_test"
+  value_wo         = "%s"
+  value_wo_version = %d
+  category         = "env"
+  sensitive        = true
+  workspace_id     = tfe_workspace.foobar.id
+}`, rInt, value, version)
+}
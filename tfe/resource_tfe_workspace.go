@@ -2,10 +2,15 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"os"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,12 +19,14 @@ import (
 
 var workspaceIdRegexp = regexp.MustCompile("^ws-[a-zA-Z0-9]{16}$")
 
+var autoDestroyActivityDurationRegexp = regexp.MustCompile(`^\d{1,4}[dh]$`)
+
 func resourceTFEWorkspace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEWorkspaceCreate,
-		Read:   resourceTFEWorkspaceRead,
-		Update: resourceTFEWorkspaceUpdate,
-		Delete: resourceTFEWorkspaceDelete,
+		CreateContext: crudContext(resourceTFEWorkspaceCreate),
+		ReadContext:   crudContext(resourceTFEWorkspaceRead),
+		UpdateContext: crudContext(resourceTFEWorkspaceUpdate),
+		DeleteContext: crudContext(resourceTFEWorkspaceDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEWorkspaceImporter,
 		},
@@ -35,8 +42,10 @@ func resourceTFEWorkspace() *schema.Resource {
 
 		CustomizeDiff: func(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			// NOTE: execution mode must be set to default first before calling the validation functions
-			if err := setExecutionModeDefault(c, d); err != nil {
-				return err
+			if meta.(ConfiguredClient).ReconcileServerDefaults {
+				if err := setExecutionModeDefault(c, d); err != nil {
+					return err
+				}
 			}
 
 			if err := validateAgentExecution(c, d); err != nil {
@@ -51,6 +60,22 @@ func resourceTFEWorkspace() *schema.Resource {
 				return err
 			}
 
+			if err := validateTriggers(c, d); err != nil {
+				return err
+			}
+
+			if err := validateWorkspaceNamePattern(d, meta); err != nil {
+				return err
+			}
+
+			if err := customizeDiffDefaultOrganization(c, d, meta); err != nil {
+				return err
+			}
+
+			if err := resolveTerraformVersion(c, d, meta); err != nil {
+				return err
+			}
+
 			return nil
 		},
 
@@ -62,7 +87,8 @@ func resourceTFEWorkspace() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -90,6 +116,12 @@ func resourceTFEWorkspace() *schema.Resource {
 				Default:  false,
 			},
 
+			"auto_apply_run_trigger": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"execution_mode": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -143,6 +175,12 @@ func resourceTFEWorkspace() *schema.Resource {
 				Computed: true,
 			},
 
+			"ignore_project_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"queue_all_runs": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -174,24 +212,45 @@ func resourceTFEWorkspace() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"effective_tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"terraform_version": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
 
+			"resolve_terraform_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"resolved_terraform_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"trigger_prefixes": {
-				Type:          schema.TypeList,
-				Optional:      true,
-				Elem:          &schema.Schema{Type: schema.TypeString},
-				ConflictsWith: []string{"trigger_patterns"},
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
 			"trigger_patterns": {
-				Type:          schema.TypeList,
-				Optional:      true,
-				Elem:          &schema.Schema{Type: schema.TypeString},
-				ConflictsWith: []string{"trigger_prefixes"},
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
 			"working_directory": {
@@ -241,26 +300,49 @@ func resourceTFEWorkspace() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"auto_destroy_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTimestamps,
+				ConflictsWith:    []string{"auto_destroy_activity_duration"},
+			},
+			"auto_destroy_activity_duration": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(
+					autoDestroyActivityDurationRegexp,
+					"must be a number of days or hours, for example 14d or 24h",
+				),
+			},
 			"resource_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
-	organization := d.Get("organization").(string)
+	organization, err := meta.(ConfiguredClient).organizationName(d)
+	if err != nil {
+		return err
+	}
 
 	// Create a new options struct.
 	options := tfe.WorkspaceCreateOptions{
 		Name:                       tfe.String(name),
 		AllowDestroyPlan:           tfe.Bool(d.Get("allow_destroy_plan").(bool)),
 		AutoApply:                  tfe.Bool(d.Get("auto_apply").(bool)),
+		AutoApplyRunTrigger:        tfe.Bool(d.Get("auto_apply_run_trigger").(bool)),
 		Description:                tfe.String(d.Get("description").(string)),
 		AssessmentsEnabled:         tfe.Bool(d.Get("assessments_enabled").(bool)),
 		FileTriggersEnabled:        tfe.Bool(d.Get("file_triggers_enabled").(bool)),
@@ -289,7 +371,9 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Process all configured options.
-	if tfVersion, ok := d.GetOk("terraform_version"); ok {
+	if resolved, ok := d.GetOk("resolved_terraform_version"); ok {
+		options.TerraformVersion = tfe.String(resolved.(string))
+	} else if tfVersion, ok := d.GetOk("terraform_version"); ok {
 		options.TerraformVersion = tfe.String(tfVersion.(string))
 	}
 
@@ -367,11 +451,26 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return resourceTFEWorkspaceRead(d, meta)
+	if tags := expandTagBindings(d); len(tags) > 0 {
+		log.Printf("[DEBUG] Set tag bindings of workspace: %s", workspace.ID)
+		if err := addTagBindings(ctx, tfeClient, "workspaces", workspace.ID, tags); err != nil {
+			return fmt.Errorf("Error setting tag bindings of workspace %s: %w", name, err)
+		}
+	}
+
+	autoDestroy := expandWorkspaceAutoDestroy(d)
+	if autoDestroy != (workspaceAutoDestroy{}) {
+		log.Printf("[DEBUG] Schedule auto-destroy of workspace: %s", workspace.ID)
+		if err := updateWorkspaceAutoDestroy(ctx, tfeClient, workspace.ID, autoDestroy); err != nil {
+			return fmt.Errorf("Error scheduling auto-destroy of workspace %s: %w", name, err)
+		}
+	}
+
+	return resourceTFEWorkspaceRead(ctx, d, meta)
 }
 
-func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	id := d.Id()
 	log.Printf("[DEBUG] Read configuration of workspace: %s", id)
@@ -394,6 +493,7 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("assessments_enabled", workspace.AssessmentsEnabled)
 
 	d.Set("auto_apply", workspace.AutoApply)
+	d.Set("auto_apply_run_trigger", workspace.AutoApplyRunTrigger)
 	d.Set("description", workspace.Description)
 	d.Set("file_triggers_enabled", workspace.FileTriggersEnabled)
 	d.Set("operations", workspace.Operations)
@@ -401,15 +501,23 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("queue_all_runs", workspace.QueueAllRuns)
 	d.Set("speculative_enabled", workspace.SpeculativeEnabled)
 	d.Set("structured_run_output_enabled", workspace.StructuredRunOutputEnabled)
-	d.Set("terraform_version", workspace.TerraformVersion)
+	// A version constraint is kept as configured as long as the workspace
+	// uses the version it was resolved to.
+	if resolved := d.Get("resolved_terraform_version").(string); resolved == "" || resolved != workspace.TerraformVersion {
+		d.Set("terraform_version", workspace.TerraformVersion)
+	}
 	d.Set("trigger_prefixes", workspace.TriggerPrefixes)
 	d.Set("trigger_patterns", workspace.TriggerPatterns)
 	d.Set("working_directory", workspace.WorkingDirectory)
 	d.Set("organization", workspace.Organization.Name)
 	d.Set("resource_count", workspace.ResourceCount)
+	d.Set("html_url", workspaceHTMLURL(meta.(ConfiguredClient), workspace))
 
-	// Project will be nil for versions of TFE that predate projects
-	if workspace.Project != nil {
+	// Project will be nil for versions of TFE that predate projects. With
+	// ignore_project_changes, the project in the state is kept, so a move
+	// outside of Terraform is not reverted while project_id is unchanged.
+	ignoreProjectChanges := d.Get("ignore_project_changes").(bool) && d.Get("project_id").(string) != ""
+	if workspace.Project != nil && !ignoreProjectChanges {
 		d.Set("project_id", workspace.Project.ID)
 	}
 
@@ -431,6 +539,10 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("tag_names", tagNames)
 
+	if err := readWorkspaceTagBindings(ctx, tfeClient, d); err != nil {
+		return err
+	}
+
 	var vcsRepo []interface{}
 	if workspace.VCSRepo != nil {
 		vcsConfig := map[string]interface{}{
@@ -448,7 +560,7 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	if workspace.GlobalRemoteState {
 		d.Set("global_remote_state", true)
 	} else {
-		globalRemoteState, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(id, tfeClient)
+		globalRemoteState, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(ctx, id, tfeClient)
 		if err != nil {
 			return fmt.Errorf(
 				"Error reading remote state consumers for workspace %s: %w", id, err)
@@ -458,15 +570,51 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("remote_state_consumer_ids", remoteStateConsumerIDs)
 	}
 
+	// The auto-destroy settings are not available on all releases of
+	// Terraform Enterprise and are not exposed by tfe.Workspace.
+	autoDestroy, supported, err := readWorkspaceAutoDestroy(ctx, tfeClient, id)
+	if err != nil {
+		return fmt.Errorf("Error reading auto-destroy settings of workspace %s: %w", id, err)
+	}
+	if supported {
+		d.Set("auto_destroy_at", autoDestroy.At)
+		d.Set("auto_destroy_activity_duration", autoDestroy.ActivityDuration)
+	}
+
 	return nil
 }
 
-func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func expandWorkspaceAutoDestroy(d *schema.ResourceData) workspaceAutoDestroy {
+	return workspaceAutoDestroy{
+		At:               d.Get("auto_destroy_at").(string),
+		ActivityDuration: d.Get("auto_destroy_activity_duration").(string),
+	}
+}
+
+// suppressEquivalentTimestamps ignores differences in the formatting of
+// RFC3339 timestamps, such as fractional seconds or time zones.
+func suppressEquivalentTimestamps(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
+func resourceTFEWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(ConfiguredClient)
+	tfeClient := config.Client
 	id := d.Id()
 
-	if d.HasChange("name") || d.HasChange("auto_apply") || d.HasChange("queue_all_runs") ||
-		d.HasChange("terraform_version") || d.HasChange("working_directory") ||
+	if d.HasChange("name") || d.HasChange("auto_apply") || d.HasChange("auto_apply_run_trigger") ||
+		d.HasChange("queue_all_runs") ||
+		d.HasChange("terraform_version") || d.HasChange("resolved_terraform_version") ||
+		d.HasChange("working_directory") ||
 		d.HasChange("vcs_repo") || d.HasChange("file_triggers_enabled") ||
 		d.HasChange("trigger_prefixes") || d.HasChange("trigger_patterns") ||
 		d.HasChange("allow_destroy_plan") || d.HasChange("speculative_enabled") ||
@@ -479,16 +627,22 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			Name:                       tfe.String(d.Get("name").(string)),
 			AllowDestroyPlan:           tfe.Bool(d.Get("allow_destroy_plan").(bool)),
 			AutoApply:                  tfe.Bool(d.Get("auto_apply").(bool)),
+			AutoApplyRunTrigger:        tfe.Bool(d.Get("auto_apply_run_trigger").(bool)),
 			Description:                tfe.String(d.Get("description").(string)),
 			FileTriggersEnabled:        tfe.Bool(d.Get("file_triggers_enabled").(bool)),
-			GlobalRemoteState:          tfe.Bool(d.Get("global_remote_state").(bool)),
 			QueueAllRuns:               tfe.Bool(d.Get("queue_all_runs").(bool)),
 			SpeculativeEnabled:         tfe.Bool(d.Get("speculative_enabled").(bool)),
 			StructuredRunOutputEnabled: tfe.Bool(d.Get("structured_run_output_enabled").(bool)),
 			WorkingDirectory:           tfe.String(d.Get("working_directory").(string)),
 		}
 
-		if d.HasChange("project_id") {
+		// Values which were changed outside of Terraform are only written
+		// back when server defaults are reconciled or they are configured.
+		if config.shouldWrite(d.GetRawConfig(), "global_remote_state") {
+			options.GlobalRemoteState = tfe.Bool(d.Get("global_remote_state").(bool))
+		}
+
+		if d.HasChange("project_id") && config.shouldWrite(d.GetRawConfig(), "project_id") {
 			if v, ok := d.GetOk("project_id"); ok && v.(string) != "" {
 				options.Project = &tfe.Project{ID: *tfe.String(v.(string))}
 			}
@@ -519,7 +673,9 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		// Process all configured options.
-		if tfVersion, ok := d.GetOk("terraform_version"); ok {
+		if resolved, ok := d.GetOk("resolved_terraform_version"); ok {
+			options.TerraformVersion = tfe.String(resolved.(string))
+		} else if tfVersion, ok := d.GetOk("terraform_version"); ok && config.shouldWrite(d.GetRawConfig(), "terraform_version") {
 			options.TerraformVersion = tfe.String(tfVersion.(string))
 		}
 
@@ -609,7 +765,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tag_names") {
+	if d.HasChange("tag_names") && config.shouldWrite(d.GetRawConfig(), "tag_names") {
 		oldTagNameValues, newTagNameValues := d.GetChange("tag_names")
 		newTagNamesSet := newTagNameValues.(*schema.Set)
 		oldTagNamesSet := oldTagNameValues.(*schema.Set)
@@ -649,8 +805,15 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		if err := updateTagBindings(ctx, tfeClient, "workspaces", d.Id(), oldTags.(map[string]interface{}), newTags.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
 	globalRemoteState := d.Get("global_remote_state").(bool)
-	if !globalRemoteState && d.HasChange("remote_state_consumer_ids") {
+	if !globalRemoteState && d.HasChange("remote_state_consumer_ids") && config.shouldWrite(d.GetRawConfig(), "remote_state_consumer_ids") {
 		oldWorkspaceIDValues, newWorkspaceIDValues := d.GetChange("remote_state_consumer_ids")
 		newWorkspaceIDsSet := newWorkspaceIDValues.(*schema.Set)
 		oldWorkspaceIDsSet := oldWorkspaceIDValues.(*schema.Set)
@@ -689,11 +852,18 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return resourceTFEWorkspaceRead(d, meta)
+	if d.HasChange("auto_destroy_at") || d.HasChange("auto_destroy_activity_duration") {
+		log.Printf("[DEBUG] Update auto-destroy of workspace: %s", d.Id())
+		if err := updateWorkspaceAutoDestroy(ctx, tfeClient, d.Id(), expandWorkspaceAutoDestroy(d)); err != nil {
+			return fmt.Errorf("Error updating auto-destroy of workspace %s: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTFEWorkspaceRead(ctx, d, meta)
 }
 
-func resourceTFEWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete workspace %s", id)
@@ -806,6 +976,43 @@ func validTagName(tag string) bool {
 	return tagPattern.MatchString(tag)
 }
 
+// resolveTerraformVersion resolves a terraform_version constraint to the
+// latest matching Terraform version when resolve_terraform_version is set, so
+// new versions are picked up by the next plan. Otherwise the constraint is
+// sent as is. Listing Terraform versions requires a site admin token, so the
+// resolution fails without one.
+func resolveTerraformVersion(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("terraform_version") || !d.NewValueKnown("resolve_terraform_version") {
+		return nil
+	}
+
+	constraint := d.Get("terraform_version").(string)
+	resolved := d.Get("resolved_terraform_version").(string)
+	if !isTerraformVersionConstraint(constraint) || !d.Get("resolve_terraform_version").(bool) {
+		if resolved != "" {
+			return d.SetNew("resolved_terraform_version", "")
+		}
+		return nil
+	}
+
+	latest, err := resolveTerraformVersionConstraint(ctx, meta.(ConfiguredClient).Client, constraint)
+	switch {
+	case errors.Is(err, tfe.ErrUnauthorized) || errors.Is(err, tfe.ErrResourceNotFound):
+		return fmt.Errorf(
+			"Error resolving Terraform version constraint %s: resolve_terraform_version requires a token "+
+				"which can list the Terraform versions, like a site admin token of Terraform Enterprise. "+
+				"Unset resolve_terraform_version to send the constraint as is instead", constraint)
+	case err != nil:
+		return err
+	}
+
+	if latest != resolved {
+		return d.SetNew("resolved_terraform_version", latest)
+	}
+
+	return nil
+}
+
 func validateTagNames(_ context.Context, d *schema.ResourceDiff) error {
 	names, ok := d.GetOk("tag_names")
 	if !ok {
@@ -821,6 +1028,33 @@ func validateTagNames(_ context.Context, d *schema.ResourceDiff) error {
 	return nil
 }
 
+// Trigger prefixes and trigger patterns are mutually exclusive and both only
+// filter runs when file triggers are enabled. These are validated at plan time,
+// because the API only rejects the combination of prefixes and patterns on
+// apply and silently ignores both when file triggers are disabled.
+func validateTriggers(_ context.Context, d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("trigger_prefixes") || !d.NewValueKnown("trigger_patterns") {
+		return nil
+	}
+
+	prefixes := d.Get("trigger_prefixes").([]interface{})
+	patterns := d.Get("trigger_patterns").([]interface{})
+	if len(prefixes) > 0 && len(patterns) > 0 {
+		return fmt.Errorf("trigger_patterns and trigger_prefixes are mutually exclusive, only one of them can be set")
+	}
+
+	if d.NewValueKnown("file_triggers_enabled") && !d.Get("file_triggers_enabled").(bool) {
+		if len(patterns) > 0 {
+			return fmt.Errorf("file_triggers_enabled must be 'true' when setting trigger_patterns")
+		}
+		if len(prefixes) > 0 {
+			return fmt.Errorf("file_triggers_enabled must be 'true' when setting trigger_prefixes")
+		}
+	}
+
+	return nil
+}
+
 func validateRemoteState(_ context.Context, d *schema.ResourceDiff) error {
 	// If remote state consumers aren't set, the global setting can be either value and it
 	// doesn't matter.
@@ -839,7 +1073,7 @@ func validateRemoteState(_ context.Context, d *schema.ResourceDiff) error {
 }
 
 func resourceTFEWorkspaceImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	s := strings.Split(d.Id(), "/")
 	if len(s) >= 3 {
@@ -848,7 +1082,7 @@ func resourceTFEWorkspaceImporter(ctx context.Context, d *schema.ResourceData, m
 			d.Id(),
 		)
 	} else if len(s) == 2 {
-		workspaceID, err := fetchWorkspaceExternalID(s[0]+"/"+s[1], tfeClient)
+		workspaceID, err := fetchWorkspaceExternalID(ctx, s[0]+"/"+s[1], tfeClient)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error retrieving workspace with name %s from organization %s %w", s[1], s[0], err)
@@ -857,14 +1091,72 @@ func resourceTFEWorkspaceImporter(ctx context.Context, d *schema.ResourceData, m
 		d.SetId(workspaceID)
 	}
 
+	// Report the objects attached to the workspace which are not imported
+	// with it, so that they can be imported into their own resources. This
+	// needs a request per type of object, so it is only done when asked for.
+	if reportWorkspaceAssociationsOnImport() {
+		reportWorkspaceAssociations(ctx, tfeClient, d.Id())
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
+// reportWorkspaceAssociationsOnImport reports whether the objects attached to
+// an imported workspace are logged, which is enabled by setting the
+// TFE_IMPORT_REPORT_ASSOCIATIONS environment variable to true.
+func reportWorkspaceAssociationsOnImport() bool {
+	v := os.Getenv("TFE_IMPORT_REPORT_ASSOCIATIONS")
+	if v == "" {
+		return false
+	}
+
+	enabled, err := strconv.ParseBool(v)
+	if err != nil {
+		log.Printf("[WARN] Ignoring invalid TFE_IMPORT_REPORT_ASSOCIATIONS value %q: %s", v, err)
+		return false
+	}
+	return enabled
+}
+
+// reportWorkspaceAssociations logs the import IDs of the variables,
+// notification configurations, team accesses and run tasks of a workspace.
+// Failing to list them does not fail the import.
+func reportWorkspaceAssociations(ctx context.Context, client *tfe.Client, workspaceID string) {
+	ws, err := client.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		log.Printf("[WARN] Error reading workspace %s: %s", workspaceID, err)
+		return
+	}
+
+	associations, err := fetchWorkspaceAssociations(ctx, client, workspaceID)
+	if err != nil {
+		log.Printf("[WARN] Error listing objects attached to workspace %s: %s", workspaceID, err)
+		return
+	}
+
+	ids := associations.importIDs(ws.Organization.Name, ws.Name)
+	resourceTypes := make([]string, 0, len(ids))
+	for resourceType := range ids {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	for _, resourceType := range resourceTypes {
+		log.Printf(
+			"[INFO] Workspace %s/%s has %d objects which can be imported as %s: %s",
+			ws.Organization.Name, ws.Name, len(ids[resourceType]), resourceType, strings.Join(ids[resourceType], ", "))
+	}
+}
+
 func errWorkspaceSafeDeleteWithPermission(workspaceID string, err error) error {
 	if err != nil {
 		if strings.HasPrefix(err.Error(), "conflict") {
 			return fmt.Errorf("error deleting workspace %s: %w\nTo delete this workspace without destroying the managed resources, add force_delete = true to the resource config", workspaceID, err)
 		}
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting workspace %s: %w", workspaceID, err)
 	}
 	return nil
 }
@@ -876,3 +1168,10 @@ func errWorkspaceResourceCountCheck(workspaceID string, resourceCount int) error
 	}
 	return nil
 }
+
+func validateWorkspaceNamePattern(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("name") {
+		return nil
+	}
+	return validateNamePattern(meta.(ConfiguredClient).WorkspaceNamePattern, "Workspace", d.Get("name").(string))
+}
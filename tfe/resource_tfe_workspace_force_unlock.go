@@ -0,0 +1,102 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFEWorkspaceForceUnlock() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEWorkspaceForceUnlockCreate),
+		ReadContext:   crudContext(resourceTFEWorkspaceForceUnlockRead),
+		DeleteContext: crudContext(resourceTFEWorkspaceForceUnlockDelete),
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"locked_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTFEWorkspaceForceUnlockCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+
+	log.Printf("[DEBUG] Read lock of workspace: %s", workspaceID)
+	locked, lockedBy, err := fetchWorkspaceLock(ctx, tfeClient, workspaceID)
+	if err != nil {
+		return fmt.Errorf("Error reading lock of workspace %s: %w", workspaceID, err)
+	}
+
+	d.SetId(workspaceID)
+
+	// Unlocking a workspace which is not locked is not an error, so the
+	// resource can be applied unconditionally by a pipeline.
+	if !locked {
+		log.Printf("[DEBUG] Workspace %s is not locked", workspaceID)
+		d.Set("locked_by", "")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Force unlock workspace %s locked by %s", workspaceID, lockedBy)
+	_, err = tfeClient.Workspaces.ForceUnlock(ctx, workspaceID)
+	if err != nil && !errors.Is(err, tfe.ErrWorkspaceNotLocked) {
+		d.SetId("")
+		return fmt.Errorf("Error force unlocking workspace %s: %w", workspaceID, err)
+	}
+
+	d.Set("locked_by", lockedBy)
+
+	return nil
+}
+
+func resourceTFEWorkspaceForceUnlockRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read workspace: %s", d.Id())
+	_, err := tfeClient.Workspaces.ReadByID(ctx, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Workspace %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading workspace %s: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// Unlocking a workspace can not be undone, so deleting the resource only
+// removes it from the state.
+func resourceTFEWorkspaceForceUnlockDelete(_ context.Context, d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Remove force unlock of workspace %s from state", d.Id())
+
+	return nil
+}
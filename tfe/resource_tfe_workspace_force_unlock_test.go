@@ -0,0 +1,88 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEWorkspaceForceUnlock_basic(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	ws, err := tfeClient.Workspaces.Create(ctx, org.Name, tfe.WorkspaceCreateOptions{
+		Name: tfe.String("workspace-test"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = tfeClient.Workspaces.Lock(ctx, ws.ID, tfe.WorkspaceLockOptions{
+		Reason: tfe.String("Stuck lock"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceForceUnlock_basic(ws.ID, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTFEWorkspaceUnlocked(ws.ID),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_force_unlock.foobar", "id", ws.ID),
+					resource.TestCheckResourceAttrSet(
+						"tfe_workspace_force_unlock.foobar", "locked_by"),
+				),
+			},
+			{
+				// Unlocking a workspace which is not locked succeeds.
+				Config: testAccTFEWorkspaceForceUnlock_basic(ws.ID, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTFEWorkspaceUnlocked(ws.ID),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_force_unlock.foobar", "locked_by", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTFEWorkspaceUnlocked(workspaceID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+		ws, err := tfeClient.Workspaces.ReadByID(ctx, workspaceID)
+		if err != nil {
+			return err
+		}
+
+		if ws.Locked {
+			return fmt.Errorf("Workspace %s is still locked", workspaceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTFEWorkspaceForceUnlock_basic(workspaceID, trigger string) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace_force_unlock" "foobar" {
+  workspace_id = "%s"
+
+  triggers = {
+    attempt = "%s"
+  }
+}`, workspaceID, trigger)
+}
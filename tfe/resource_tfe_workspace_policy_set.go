@@ -13,9 +13,9 @@ import (
 
 func resourceTFEWorkspacePolicySet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEWorkspacePolicySetCreate,
-		Read:   resourceTFEWorkspacePolicySetRead,
-		Delete: resourceTFEWorkspacePolicySetDelete,
+		CreateContext: crudContext(resourceTFEWorkspacePolicySetCreate),
+		ReadContext:   crudContext(resourceTFEWorkspacePolicySetRead),
+		DeleteContext: crudContext(resourceTFEWorkspacePolicySetDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEWorkspacePolicySetImporter,
 		},
@@ -36,8 +36,8 @@ func resourceTFEWorkspacePolicySet() *schema.Resource {
 	}
 }
 
-func resourceTFEWorkspacePolicySetCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEWorkspacePolicySetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	policySetID := d.Get("policy_set_id").(string)
 	workspaceID := d.Get("workspace_id").(string)
@@ -53,11 +53,11 @@ func resourceTFEWorkspacePolicySetCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(fmt.Sprintf("%s_%s", workspaceID, policySetID))
 
-	return resourceTFEWorkspacePolicySetRead(d, meta)
+	return resourceTFEWorkspacePolicySetRead(ctx, d, meta)
 }
 
-func resourceTFEWorkspacePolicySetRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEWorkspacePolicySetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	policySetID := d.Get("policy_set_id").(string)
 	workspaceID := d.Get("workspace_id").(string)
@@ -94,8 +94,8 @@ func resourceTFEWorkspacePolicySetRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTFEWorkspacePolicySetDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEWorkspacePolicySetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	policySetID := d.Get("policy_set_id").(string)
 	workspaceID := d.Get("workspace_id").(string)
@@ -125,7 +125,7 @@ func resourceTFEWorkspacePolicySetImporter(ctx context.Context, d *schema.Resour
 
 	organization, wsName, pSName := splitID[0], splitID[1], splitID[2]
 
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Ensure the named workspace exists before fetching all the policy sets in the org
 	_, err := tfeClient.Workspaces.Read(ctx, organization, wsName)
@@ -0,0 +1,165 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTFEWorkspacePolicySetExclusion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEWorkspacePolicySetExclusionCreate),
+		ReadContext:   crudContext(resourceTFEWorkspacePolicySetExclusionRead),
+		DeleteContext: crudContext(resourceTFEWorkspacePolicySetExclusionDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFEWorkspacePolicySetExclusionImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_set_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTFEWorkspacePolicySetExclusionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	policySetID := d.Get("policy_set_id").(string)
+	workspaceID := d.Get("workspace_id").(string)
+
+	log.Printf("[DEBUG] Exclude workspace %s from policy set %s", workspaceID, policySetID)
+	err := tfeClient.PolicySets.AddWorkspaceExclusions(ctx, policySetID, tfe.PolicySetAddWorkspaceExclusionsOptions{
+		WorkspaceExclusions: []*tfe.Workspace{{ID: workspaceID}},
+	})
+	if err != nil {
+		return fmt.Errorf(
+			"Error excluding workspace %s from policy set %s: %w", workspaceID, policySetID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", workspaceID, policySetID))
+
+	return resourceTFEWorkspacePolicySetExclusionRead(ctx, d, meta)
+}
+
+func resourceTFEWorkspacePolicySetExclusionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	policySetID := d.Get("policy_set_id").(string)
+	workspaceID := d.Get("workspace_id").(string)
+
+	log.Printf("[DEBUG] Read workspace exclusions of policy set: %s", policySetID)
+	policySet, err := tfeClient.PolicySets.ReadWithOptions(ctx, policySetID, &tfe.PolicySetReadOptions{
+		Include: []tfe.PolicySetIncludeOpt{tfe.PolicySetWorkspaceExclusions},
+	})
+	if err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			log.Printf("[DEBUG] Policy set %s no longer exists", policySetID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of policy set %s: %w", policySetID, err)
+	}
+
+	for _, workspace := range policySet.WorkspaceExclusions {
+		if workspace.ID == workspaceID {
+			d.Set("workspace_id", workspaceID)
+			d.Set("policy_set_id", policySetID)
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] Workspace %s not excluded from policy set %s. Removing from state.", workspaceID, policySetID)
+	d.SetId("")
+	return nil
+}
+
+func resourceTFEWorkspacePolicySetExclusionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	policySetID := d.Get("policy_set_id").(string)
+	workspaceID := d.Get("workspace_id").(string)
+
+	log.Printf("[DEBUG] Remove exclusion of workspace %s from policy set %s", workspaceID, policySetID)
+	err := tfeClient.PolicySets.RemoveWorkspaceExclusions(ctx, policySetID, tfe.PolicySetRemoveWorkspaceExclusionsOptions{
+		WorkspaceExclusions: []*tfe.Workspace{{ID: workspaceID}},
+	})
+	if err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			return nil
+		}
+		return fmt.Errorf(
+			"Error removing exclusion of workspace %s from policy set %s: %w", workspaceID, policySetID, err)
+	}
+
+	return nil
+}
+
+func resourceTFEWorkspacePolicySetExclusionImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The format of the import ID is <ORGANIZATION/WORKSPACE NAME/POLICYSET NAME>
+	splitID := strings.SplitN(d.Id(), "/", 3)
+	if len(splitID) != 3 {
+		return nil, fmt.Errorf(
+			"invalid workspace policy set exclusion input format: %s (expected <ORGANIZATION>/<WORKSPACE NAME>/<POLICYSET NAME>)",
+			splitID,
+		)
+	}
+
+	organization, wsName, pSName := splitID[0], splitID[1], splitID[2]
+
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, organization, wsName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading configuration of workspace %s in organization %s: %w", wsName, organization, err)
+	}
+
+	options := &tfe.PolicySetListOptions{Include: []tfe.PolicySetIncludeOpt{tfe.PolicySetWorkspaceExclusions}}
+	for {
+		list, err := tfeClient.PolicySets.List(ctx, organization, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving policy sets: %w", err)
+		}
+		for _, policySet := range list.Items {
+			if policySet.Name != pSName {
+				continue
+			}
+
+			for _, ws := range policySet.WorkspaceExclusions {
+				if ws.ID != workspace.ID {
+					continue
+				}
+
+				d.Set("workspace_id", ws.ID)
+				d.Set("policy_set_id", policySet.ID)
+				d.SetId(fmt.Sprintf("%s_%s", ws.ID, policySet.ID))
+
+				return []*schema.ResourceData{d}, nil
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if list.CurrentPage >= list.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = list.NextPage
+	}
+
+	return nil, fmt.Errorf("workspace %s has not been excluded from policy set %s", wsName, pSName)
+}
@@ -0,0 +1,104 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEWorkspacePolicySetExclusion_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspacePolicySetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspacePolicySetExclusion_basic(org.Name, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspacePolicySetExclusionExists(
+						"tfe_workspace_policy_set_exclusion.test"),
+				),
+			},
+			{
+				ResourceName:      "tfe_workspace_policy_set_exclusion.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/tst-terraform-%d/tst-policy-set-%d", org.Name, rInt, rInt),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "tfe_workspace_policy_set_exclusion.test",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s/tst-terraform-%d", org.Name, rInt),
+				ExpectError:   regexp.MustCompile(`Error: invalid workspace policy set exclusion input format`),
+			},
+		},
+	})
+}
+
+func testAccCheckTFEWorkspacePolicySetExclusionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		policySetID := rs.Primary.Attributes["policy_set_id"]
+		workspaceID := rs.Primary.Attributes["workspace_id"]
+
+		policySet, err := tfeClient.PolicySets.ReadWithOptions(ctx, policySetID, &tfe.PolicySetReadOptions{
+			Include: []tfe.PolicySetIncludeOpt{tfe.PolicySetWorkspaceExclusions},
+		})
+		if err != nil {
+			return fmt.Errorf("error reading policy set %s: %w", policySetID, err)
+		}
+		for _, workspace := range policySet.WorkspaceExclusions {
+			if workspace.ID == workspaceID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Workspace (%s) is not excluded from policy set (%s).", workspaceID, policySetID)
+	}
+}
+
+func testAccTFEWorkspacePolicySetExclusion_basic(orgName string, rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "test" {
+  name         = "tst-terraform-%d"
+  organization = "%s"
+}
+
+resource "tfe_policy_set" "test" {
+  name         = "tst-policy-set-%d"
+  description  = "Policy Set"
+  organization = "%s"
+  global       = true
+}
+
+resource "tfe_workspace_policy_set_exclusion" "test" {
+  policy_set_id = tfe_policy_set.test.id
+  workspace_id  = tfe_workspace.test.id
+}`, rInt, orgName, rInt, orgName)
+}
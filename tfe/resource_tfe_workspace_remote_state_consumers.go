@@ -0,0 +1,172 @@
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFEWorkspaceRemoteStateConsumers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEWorkspaceRemoteStateConsumersCreate),
+		ReadContext:   crudContext(resourceTFEWorkspaceRemoteStateConsumersRead),
+		UpdateContext: crudContext(resourceTFEWorkspaceRemoteStateConsumersUpdate),
+		DeleteContext: crudContext(resourceTFEWorkspaceRemoteStateConsumersDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFEWorkspaceRemoteStateConsumersImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"consumer_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceTFEWorkspaceRemoteStateConsumersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+
+	// Remote state consumers are ignored while the state of the workspace is
+	// shared with the whole organization.
+	log.Printf("[DEBUG] Read workspace: %s", workspaceID)
+	workspace, err := tfeClient.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("Error reading workspace %s: %w", workspaceID, err)
+	}
+	if workspace.GlobalRemoteState {
+		return fmt.Errorf("global_remote_state of workspace %s must be 'false' when setting remote state consumers", workspaceID)
+	}
+
+	if err := syncWorkspaceRemoteStateConsumers(ctx, tfeClient, workspaceID, d.Get("consumer_ids").(*schema.Set)); err != nil {
+		return err
+	}
+
+	d.SetId(workspaceID)
+
+	return resourceTFEWorkspaceRemoteStateConsumersRead(ctx, d, meta)
+}
+
+func resourceTFEWorkspaceRemoteStateConsumersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// The remote state consumers endpoint also responds with a not found
+	// error on releases of Terraform Enterprise which do not support it, so
+	// the workspace is read first to find out whether it still exists.
+	log.Printf("[DEBUG] Read workspace: %s", d.Id())
+	_, err := tfeClient.Workspaces.ReadByID(ctx, d.Id())
+	if err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			log.Printf("[DEBUG] Workspace %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading workspace %s: %w", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Read remote state consumers of workspace: %s", d.Id())
+	_, consumerIDs, err := readWorkspaceStateConsumers(ctx, d.Id(), tfeClient)
+	if err != nil {
+		return fmt.Errorf("Error reading remote state consumers of workspace %s: %w", d.Id(), err)
+	}
+
+	d.Set("workspace_id", d.Id())
+	d.Set("consumer_ids", schema.NewSet(schema.HashString, consumerIDs))
+
+	return nil
+}
+
+func resourceTFEWorkspaceRemoteStateConsumersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	if d.HasChange("consumer_ids") {
+		if err := syncWorkspaceRemoteStateConsumers(ctx, tfeClient, d.Id(), d.Get("consumer_ids").(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	return resourceTFEWorkspaceRemoteStateConsumersRead(ctx, d, meta)
+}
+
+// Deleting the remote state consumers does not delete the workspace, it only
+// stops sharing its state with all consumers.
+func resourceTFEWorkspaceRemoteStateConsumersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Delete remote state consumers of workspace: %s", d.Id())
+	err := syncWorkspaceRemoteStateConsumers(ctx, tfeClient, d.Id(), schema.NewSet(schema.HashString, nil))
+	if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// syncWorkspaceRemoteStateConsumers makes the given workspaces the only remote
+// state consumers of the workspace. New consumers are added before other
+// consumers are removed, so the configured consumers never lose access.
+func syncWorkspaceRemoteStateConsumers(ctx context.Context, tfeClient *tfe.Client, workspaceID string, consumerIDs *schema.Set) error {
+	_, existing, err := readWorkspaceStateConsumers(ctx, workspaceID, tfeClient)
+	if err != nil {
+		return fmt.Errorf("Error reading remote state consumers of workspace %s: %w", workspaceID, err)
+	}
+
+	var existingIDs []string
+	for _, id := range existing {
+		existingIDs = append(existingIDs, id.(string))
+	}
+
+	return syncIDs(existingIDs, consumerIDs, func(ids []string) error {
+		log.Printf("[DEBUG] Add remote state consumers to workspace: %s", workspaceID)
+		err := tfeClient.Workspaces.AddRemoteStateConsumers(ctx, workspaceID, tfe.WorkspaceAddRemoteStateConsumersOptions{
+			Workspaces: workspacesFromIDs(ids),
+		})
+		if err != nil {
+			return fmt.Errorf("Error adding remote state consumers to workspace %s: %w", workspaceID, err)
+		}
+		return nil
+	}, func(ids []string) error {
+		log.Printf("[DEBUG] Remove remote state consumers from workspace: %s", workspaceID)
+		err := tfeClient.Workspaces.RemoveRemoteStateConsumers(ctx, workspaceID, tfe.WorkspaceRemoveRemoteStateConsumersOptions{
+			Workspaces: workspacesFromIDs(ids),
+		})
+		if err != nil {
+			return fmt.Errorf("Error removing remote state consumers from workspace %s: %w", workspaceID, err)
+		}
+		return nil
+	})
+}
+
+func workspacesFromIDs(ids []string) []*tfe.Workspace {
+	workspaces := make([]*tfe.Workspace, 0, len(ids))
+	for _, id := range ids {
+		workspaces = append(workspaces, &tfe.Workspace{ID: id})
+	}
+	return workspaces
+}
+
+func resourceTFEWorkspaceRemoteStateConsumersImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The remote state consumers are identified by the workspace ID.
+	d.Set("workspace_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,156 @@
+package tfe
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFEWorkspaceRemoteStateConsumers_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceRemoteStateConsumersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceRemoteStateConsumers_basic(rInt, `[tfe_workspace.consumer_a.id, tfe_workspace.consumer_b.id]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_remote_state_consumers.foobar", "consumer_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace_remote_state_consumers.foobar", "workspace_id", "tfe_workspace.producer", "id"),
+				),
+			},
+			{
+				Config: testAccTFEWorkspaceRemoteStateConsumers_basic(rInt, `[tfe_workspace.consumer_b.id]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_remote_state_consumers.foobar", "consumer_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"tfe_workspace_remote_state_consumers.foobar", "consumer_ids.*", "tfe_workspace.consumer_b", "id"),
+				),
+			},
+			{
+				ResourceName:      "tfe_workspace_remote_state_consumers.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSyncWorkspaceRemoteStateConsumers(t *testing.T) {
+	var requests []string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, strings.TrimSpace(r.Method+" "+r.URL.Path+" "+consumersFromBody(string(b))))
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/workspaces/ws-producer/relationships/remote-state-consumers":
+			fmt.Fprint(w, `{"data":[
+				{"id":"ws-keep","type":"workspaces"},
+				{"id":"ws-remove","type":"workspaces"}
+			],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+		case r.URL.Path == "/api/v2/workspaces/ws-producer/relationships/remote-state-consumers":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	// The new consumer is added before the consumer which is not configured
+	// is removed.
+	err := syncWorkspaceRemoteStateConsumers(ctx, client, "ws-producer", schema.NewSet(schema.HashString, []interface{}{"ws-keep", "ws-add"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"GET /api/v2/workspaces/ws-producer/relationships/remote-state-consumers",
+		"POST /api/v2/workspaces/ws-producer/relationships/remote-state-consumers ws-add",
+		"DELETE /api/v2/workspaces/ws-producer/relationships/remote-state-consumers ws-remove",
+	}
+	if len(requests) != len(want) {
+		t.Fatalf("wrong requests\ngot: %v\nwant: %v", requests, want)
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Fatalf("wrong request %d\ngot: %s\nwant: %s", i, requests[i], want[i])
+		}
+	}
+}
+
+// consumersFromBody returns the IDs of the workspaces of a remote state
+// consumers request.
+func consumersFromBody(body string) string {
+	var ids []string
+	for _, id := range []string{"ws-keep", "ws-add", "ws-remove"} {
+		if strings.Contains(body, `"`+id+`"`) {
+			ids = append(ids, id)
+		}
+	}
+	return strings.Join(ids, ",")
+}
+
+func testAccCheckTFEWorkspaceRemoteStateConsumersDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_workspace_remote_state_consumers" {
+			continue
+		}
+
+		_, consumerIDs, err := readWorkspaceStateConsumers(ctx, rs.Primary.ID, tfeClient)
+		if err != nil {
+			// The workspace is destroyed as well.
+			continue
+		}
+
+		if len(consumerIDs) > 0 {
+			return fmt.Errorf("Workspace %s still has %d remote state consumers", rs.Primary.ID, len(consumerIDs))
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEWorkspaceRemoteStateConsumers_basic(rInt int, consumerIDs string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "producer" {
+  name                = "workspace-producer"
+  organization        = tfe_organization.foobar.id
+  global_remote_state = false
+}
+
+resource "tfe_workspace" "consumer_a" {
+  name         = "workspace-consumer-a"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "consumer_b" {
+  name         = "workspace-consumer-b"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace_remote_state_consumers" "foobar" {
+  workspace_id = tfe_workspace.producer.id
+  consumer_ids = %s
+}`, rInt, consumerIDs)
+}
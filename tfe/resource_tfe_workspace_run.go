@@ -0,0 +1,237 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFEWorkspaceRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEWorkspaceRunCreate),
+		ReadContext:   crudContext(resourceTFEWorkspaceRunRead),
+		UpdateContext: crudContext(resourceTFEWorkspaceRunUpdate),
+		DeleteContext: crudContext(resourceTFEWorkspaceRunDelete),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"apply": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     workspaceRunOptionsSchema(true),
+			},
+
+			"destroy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     workspaceRunOptionsSchema(false),
+			},
+
+			"run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// workspaceRunOptionsSchema returns the schema of the apply and destroy
+// blocks. Changing the apply block queues a new run, so its attributes force
+// a new resource.
+func workspaceRunOptionsSchema(forceNew bool) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"manual_confirm": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: forceNew,
+				Default:  false,
+			},
+
+			"retry": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: forceNew,
+				Default:  true,
+			},
+
+			"retry_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     forceNew,
+				Default:      3,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"retry_backoff_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     forceNew,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"retry_backoff_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     forceNew,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"wait_for_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: forceNew,
+				Default:  true,
+			},
+		},
+	}
+}
+
+// workspaceRun queues a run on a workspace as configured by an apply or
+// destroy block, and returns the last run which was queued.
+func workspaceRun(ctx context.Context, client *tfe.Client, workspaceID string, isDestroy bool, block map[string]interface{}, timeout time.Duration) (*tfe.Run, error) {
+	var run *tfe.Run
+
+	queue := func() error {
+		message := "Applied by Terraform"
+		if isDestroy {
+			message = "Destroyed by Terraform"
+		}
+
+		var err error
+		log.Printf("[DEBUG] Create run for workspace: %s", workspaceID)
+		run, err = client.Runs.Create(ctx, tfe.RunCreateOptions{
+			Workspace: &tfe.Workspace{ID: workspaceID},
+			Message:   tfe.String(message),
+			IsDestroy: tfe.Bool(isDestroy),
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating run for workspace %s: %w", workspaceID, err)
+		}
+
+		if !block["wait_for_run"].(bool) {
+			return nil
+		}
+
+		// Unless confirmed manually, the run is applied right away.
+		confirm := !block["manual_confirm"].(bool)
+		run, err = waitForRun(ctx, client, run.ID, true, confirm, timeout)
+		return err
+	}
+
+	attempts := 1
+	if block["retry"].(bool) {
+		attempts = block["retry_attempts"].(int)
+	}
+
+	err := retryRun(
+		attempts,
+		time.Duration(block["retry_backoff_min"].(int))*time.Second,
+		time.Duration(block["retry_backoff_max"].(int))*time.Second,
+		queue,
+	)
+
+	return run, err
+}
+
+func resourceTFEWorkspaceRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+
+	// Without an apply block no run is queued, and the workspace only keeps
+	// track of the destroy block.
+	v, ok := d.GetOk("apply")
+	if !ok {
+		d.SetId(workspaceID)
+		return nil
+	}
+
+	run, err := workspaceRun(ctx, tfeClient, workspaceID, false, v.([]interface{})[0].(map[string]interface{}), d.Timeout(schema.TimeoutCreate))
+	if run != nil {
+		d.SetId(run.ID)
+		d.Set("run_id", run.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	return resourceTFEWorkspaceRunRead(ctx, d, meta)
+}
+
+func resourceTFEWorkspaceRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	runID := d.Get("run_id").(string)
+	if runID == "" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Read run: %s", runID)
+	run, err := tfeClient.Runs.Read(ctx, runID)
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Run %s no longer exists", runID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading run %s: %w", runID, err)
+	}
+
+	d.Set("status", string(run.Status))
+
+	return nil
+}
+
+// Only the destroy block can be updated, which is kept in the state until the
+// resource is deleted.
+func resourceTFEWorkspaceRunUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceTFEWorkspaceRunRead(ctx, d, meta)
+}
+
+func resourceTFEWorkspaceRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	v, ok := d.GetOk("destroy")
+	if !ok {
+		log.Printf("[DEBUG] Remove workspace run %s from state", d.Id())
+		return nil
+	}
+
+	workspaceID := d.Get("workspace_id").(string)
+	_, err := workspaceRun(ctx, tfeClient, workspaceID, true, v.([]interface{})[0].(map[string]interface{}), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("Error destroying the resources of workspace %s: %w", workspaceID, err)
+	}
+
+	return nil
+}
@@ -45,10 +45,10 @@ func sentenceList(items []string, prefix string, suffix string, conjunction stri
 
 func resourceTFEWorkspaceRunTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEWorkspaceRunTaskCreate,
-		Read:   resourceTFEWorkspaceRunTaskRead,
-		Delete: resourceTFEWorkspaceRunTaskDelete,
-		Update: resourceTFEWorkspaceRunTaskUpdate,
+		CreateContext: crudContext(resourceTFEWorkspaceRunTaskCreate),
+		ReadContext:   crudContext(resourceTFEWorkspaceRunTaskRead),
+		DeleteContext: crudContext(resourceTFEWorkspaceRunTaskDelete),
+		UpdateContext: crudContext(resourceTFEWorkspaceRunTaskUpdate),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEWorkspaceRunTaskImporter,
 		},
@@ -103,8 +103,8 @@ func resourceTFEWorkspaceRunTask() *schema.Resource {
 	}
 }
 
-func resourceTFEWorkspaceRunTaskCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEWorkspaceRunTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	workspaceID := d.Get("workspace_id").(string)
 	taskID := d.Get("task_id").(string)
@@ -136,11 +136,11 @@ func resourceTFEWorkspaceRunTaskCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(wstask.ID)
 
-	return resourceTFEWorkspaceRunTaskRead(d, meta)
+	return resourceTFEWorkspaceRunTaskRead(ctx, d, meta)
 }
 
-func resourceTFEWorkspaceRunTaskDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEWorkspaceRunTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the workspace
 	workspaceID := d.Get("workspace_id").(string)
@@ -154,8 +154,8 @@ func resourceTFEWorkspaceRunTaskDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTFEWorkspaceRunTaskUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEWorkspaceRunTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the workspace
 	workspaceID := d.Get("workspace_id").(string)
@@ -179,8 +179,8 @@ func resourceTFEWorkspaceRunTaskUpdate(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTFEWorkspaceRunTaskRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEWorkspaceRunTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Get the workspace
 	workspaceID := d.Get("workspace_id").(string)
@@ -205,7 +205,7 @@ func resourceTFEWorkspaceRunTaskRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceTFEWorkspaceRunTaskImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	s := strings.Split(d.Id(), "/")
 	if len(s) != 3 {
@@ -215,7 +215,7 @@ func resourceTFEWorkspaceRunTaskImporter(ctx context.Context, d *schema.Resource
 		)
 	}
 
-	wstask, err := fetchWorkspaceRunTask(s[2], s[1], s[0], tfeClient)
+	wstask, err := fetchWorkspaceRunTask(ctx, s[2], s[1], s[0], tfeClient)
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +113,7 @@ func TestAccTFEWorkspaceRunTask_import(t *testing.T) {
 
 func testAccCheckTFEWorkspaceRunTaskExists(n string, runTask *tfe.WorkspaceRunTask) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -144,7 +144,7 @@ func testAccCheckTFEWorkspaceRunTaskExists(n string, runTask *tfe.WorkspaceRunTa
 }
 
 func testAccCheckTFEWorkspaceRunTaskDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_workspace_run_task" {
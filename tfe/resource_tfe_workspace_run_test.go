@@ -0,0 +1,93 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTFEWorkspaceRun_applyAndDestroy(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	workspace := &tfe.Workspace{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceRunDestroyed(workspace),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceRun_applyAndDestroy(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttrSet(
+						"tfe_workspace_run.foobar", "run_id"),
+					// The configuration is applied already, so the run may
+					// finish without changes.
+					resource.TestCheckResourceAttrSet(
+						"tfe_workspace_run.foobar", "status"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckTFEWorkspaceRunDestroyed checks that a destroy run was applied
+// on the workspace, which is deleted itself afterwards.
+func testAccCheckTFEWorkspaceRunDestroyed(workspace *tfe.Workspace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+		runs, err := tfeClient.Runs.List(ctx, workspace.ID, &tfe.RunListOptions{})
+		if err != nil {
+			if isErrResourceNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		for _, run := range runs.Items {
+			if run.IsDestroy && run.Status == tfe.RunApplied {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("No destroy run was applied on workspace %s", workspace.ID)
+	}
+}
+
+func testAccTFEWorkspaceRun_applyAndDestroy(organization string) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = "%s"
+  auto_apply   = true
+}
+
+resource "tfe_api_driven_run" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  source_path  = "test-fixtures/config-version"
+}
+
+resource "tfe_workspace_run" "foobar" {
+  workspace_id = tfe_api_driven_run.foobar.workspace_id
+
+  apply {
+    retry_attempts = 2
+  }
+
+  destroy {
+    retry_attempts = 2
+  }
+}`, organization)
+}
@@ -0,0 +1,168 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFEWorkspaceRunTriggers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEWorkspaceRunTriggersCreate),
+		ReadContext:   crudContext(resourceTFEWorkspaceRunTriggersRead),
+		UpdateContext: crudContext(resourceTFEWorkspaceRunTriggersUpdate),
+		DeleteContext: crudContext(resourceTFEWorkspaceRunTriggersDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFEWorkspaceRunTriggersImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"sourceable_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"run_trigger_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceTFEWorkspaceRunTriggersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	workspaceID := d.Get("workspace_id").(string)
+
+	if err := syncWorkspaceRunTriggers(ctx, d, meta, workspaceID); err != nil {
+		return err
+	}
+
+	d.SetId(workspaceID)
+
+	return resourceTFEWorkspaceRunTriggersRead(ctx, d, meta)
+}
+
+func resourceTFEWorkspaceRunTriggersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read run triggers of workspace: %s", d.Id())
+	runTriggers, err := listRunTriggers(ctx, tfeClient, d.Id(), tfe.RunTriggerInbound)
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Workspace %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading run triggers of workspace %s: %w", d.Id(), err)
+	}
+
+	var sourceableIDs []interface{}
+	runTriggerIDs := make(map[string]interface{})
+	for _, runTrigger := range runTriggers {
+		sourceableIDs = append(sourceableIDs, runTrigger.Sourceable.ID)
+		runTriggerIDs[runTrigger.Sourceable.ID] = runTrigger.ID
+	}
+
+	d.Set("workspace_id", d.Id())
+	d.Set("sourceable_ids", schema.NewSet(schema.HashString, sourceableIDs))
+	d.Set("run_trigger_ids", runTriggerIDs)
+
+	return nil
+}
+
+func resourceTFEWorkspaceRunTriggersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("sourceable_ids") {
+		if err := syncWorkspaceRunTriggers(ctx, d, meta, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceTFEWorkspaceRunTriggersRead(ctx, d, meta)
+}
+
+// Deleting the run triggers does not delete the workspace, it only removes all
+// its inbound run triggers.
+func resourceTFEWorkspaceRunTriggersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Delete run triggers of workspace: %s", d.Id())
+	runTriggers, err := listRunTriggers(ctx, tfeClient, d.Id(), tfe.RunTriggerInbound)
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Error reading run triggers of workspace %s: %w", d.Id(), err)
+	}
+
+	for _, runTrigger := range runTriggers {
+		if err := deleteRunTrigger(ctx, tfeClient, runTrigger.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncWorkspaceRunTriggers creates and deletes the inbound run triggers of a
+// workspace to match the configured sourceable workspaces.
+func syncWorkspaceRunTriggers(ctx context.Context, d *schema.ResourceData, meta interface{}, workspaceID string) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	runTriggers, err := listRunTriggers(ctx, tfeClient, workspaceID, tfe.RunTriggerInbound)
+	if err != nil {
+		return fmt.Errorf("Error reading run triggers of workspace %s: %w", workspaceID, err)
+	}
+
+	configured := make(map[string]bool)
+	for _, id := range d.Get("sourceable_ids").(*schema.Set).List() {
+		configured[id.(string)] = true
+	}
+
+	existing := make(map[string]bool)
+	for _, runTrigger := range runTriggers {
+		existing[runTrigger.Sourceable.ID] = true
+
+		if !configured[runTrigger.Sourceable.ID] {
+			log.Printf("[DEBUG] Delete run trigger %s from workspace %s", runTrigger.ID, workspaceID)
+			if err := deleteRunTrigger(ctx, tfeClient, runTrigger.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	for sourceableID := range configured {
+		if existing[sourceableID] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Create run trigger on workspace %s with sourceable %s", workspaceID, sourceableID)
+		if _, err := createRunTrigger(ctx, tfeClient, workspaceID, sourceableID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceTFEWorkspaceRunTriggersImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The run triggers are identified by the workspace ID.
+	d.Set("workspace_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,176 @@
+package tfe
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFEWorkspaceRunTriggers_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceRunTriggersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceRunTriggers_basic(rInt, `[tfe_workspace.source_a.id, tfe_workspace.source_b.id]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_run_triggers.foobar", "sourceable_ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_run_triggers.foobar", "run_trigger_ids.%", "2"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_run_triggers.outbound", "run_triggers.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_run_triggers.outbound", "run_triggers.0.workspace_id", "tfe_workspace.target", "id"),
+				),
+			},
+			{
+				Config: testAccTFEWorkspaceRunTriggers_basic(rInt, `[tfe_workspace.source_b.id]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_run_triggers.foobar", "sourceable_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"tfe_workspace_run_triggers.foobar", "sourceable_ids.*", "tfe_workspace.source_b", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_run_triggers.outbound", "run_triggers.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "tfe_workspace_run_triggers.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSyncWorkspaceRunTriggers(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		requests = append(requests, strings.TrimSpace(fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, sourceableFromBody(string(body)))))
+		mu.Unlock()
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/workspaces/ws-target/run-triggers":
+			if r.URL.Query().Get("filter[run-trigger][type]") != "inbound" {
+				t.Errorf("expected inbound run triggers to be listed, got %q", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"data":[
+				{"id":"rt-a","type":"run-triggers","relationships":{"sourceable":{"data":{"id":"ws-a","type":"workspaces"}}}},
+				{"id":"rt-b","type":"run-triggers","relationships":{"sourceable":{"data":{"id":"ws-b","type":"workspaces"}}}}
+			],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+		case r.Method == "POST" && r.URL.Path == "/api/v2/workspaces/ws-target/run-triggers":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data":{"id":"rt-c","type":"run-triggers"}}`)
+		case r.Method == "DELETE" && r.URL.Path == "/api/v2/run-triggers/rt-a":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	d := resourceTFEWorkspaceRunTriggers().TestResourceData()
+	d.Set("workspace_id", "ws-target")
+	d.Set("sourceable_ids", []interface{}{"ws-b", "ws-c"})
+
+	if err := syncWorkspaceRunTriggers(ctx, d, ConfiguredClient{Client: client}, "ws-target"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sort.Strings(requests)
+	expected := []string{
+		"DELETE /api/v2/run-triggers/rt-a",
+		"GET /api/v2/workspaces/ws-target/run-triggers",
+		"POST /api/v2/workspaces/ws-target/run-triggers ws-c",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("expected requests:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(requests, "\n"))
+	}
+}
+
+// sourceableFromBody returns the ID of the sourceable workspace of a run
+// trigger create request.
+func sourceableFromBody(body string) string {
+	for _, id := range []string{"ws-a", "ws-b", "ws-c"} {
+		if strings.Contains(body, `"`+id+`"`) {
+			return id
+		}
+	}
+	return ""
+}
+
+func testAccCheckTFEWorkspaceRunTriggersDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_workspace_run_triggers" {
+			continue
+		}
+
+		runTriggers, err := listRunTriggers(ctx, tfeClient, rs.Primary.ID, tfe.RunTriggerInbound)
+		if err != nil {
+			// The workspace is destroyed as well.
+			continue
+		}
+
+		if len(runTriggers) > 0 {
+			return fmt.Errorf("Workspace %s still has %d run triggers", rs.Primary.ID, len(runTriggers))
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEWorkspaceRunTriggers_basic(rInt int, sourceableIDs string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "target" {
+  name         = "workspace-target"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "source_a" {
+  name         = "workspace-source-a"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "source_b" {
+  name         = "workspace-source-b"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace_run_triggers" "foobar" {
+  workspace_id   = tfe_workspace.target.id
+  sourceable_ids = %s
+}
+
+data "tfe_run_triggers" "outbound" {
+  workspace_id = tfe_workspace.source_a.id
+  type         = "outbound"
+  depends_on   = [tfe_workspace_run_triggers.foobar]
+}`, rInt, sourceableIDs)
+}
@@ -0,0 +1,228 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFEWorkspaceSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEWorkspaceSettingsCreate),
+		ReadContext:   crudContext(resourceTFEWorkspaceSettingsRead),
+		UpdateContext: crudContext(resourceTFEWorkspaceSettingsUpdate),
+		DeleteContext: crudContext(resourceTFEWorkspaceSettingsDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFEWorkspaceSettingsImporter,
+		},
+
+		CustomizeDiff: validateWorkspaceSettingsAgentExecution,
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"execution_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						"agent",
+						"local",
+						"remote",
+					},
+					false,
+				),
+			},
+
+			"agent_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateWorkspaceSettingsAgentExecution makes sure an agent pool is only
+// assigned together with the agent execution mode.
+func validateWorkspaceSettingsAgentExecution(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Only validate the execution mode when it is configured, otherwise the
+	// execution mode of the workspace is left as it is.
+	configMap := d.GetRawConfig().AsValueMap()
+	if v, ok := configMap["execution_mode"]; !ok || v.IsNull() || !d.NewValueKnown("execution_mode") {
+		return nil
+	}
+	executionMode := d.Get("execution_mode")
+
+	agentPoolIDConfigured := false
+	if v, ok := configMap["agent_pool_id"]; ok && !v.IsNull() {
+		agentPoolIDConfigured = true
+	}
+
+	executionModeIsAgent := executionMode.(string) == "agent"
+	if !executionModeIsAgent && agentPoolIDConfigured {
+		return fmt.Errorf("execution_mode must be set to 'agent' to assign agent_pool_id")
+	} else if executionModeIsAgent && !agentPoolIDConfigured {
+		return fmt.Errorf("agent_pool_id must be provided when execution_mode is 'agent'")
+	}
+
+	// Switching away from agent execution detaches the agent pool.
+	if !executionModeIsAgent && d.HasChange("execution_mode") {
+		if err := d.SetNew("agent_pool_id", ""); err != nil {
+			return fmt.Errorf("failed to clear agent_pool_id: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceTFEWorkspaceSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	workspaceID := d.Get("workspace_id").(string)
+
+	if err := updateWorkspaceSettings(ctx, d, meta, workspaceID); err != nil {
+		return err
+	}
+
+	d.SetId(workspaceID)
+
+	return resourceTFEWorkspaceSettingsRead(ctx, d, meta)
+}
+
+func resourceTFEWorkspaceSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read settings of workspace: %s", d.Id())
+	workspace, err := tfeClient.Workspaces.ReadByID(ctx, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Workspace %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading settings of workspace %s: %w", d.Id(), err)
+	}
+
+	d.Set("workspace_id", workspace.ID)
+	d.Set("execution_mode", workspace.ExecutionMode)
+
+	var agentPoolID string
+	if workspace.AgentPool != nil {
+		agentPoolID = workspace.AgentPool.ID
+	}
+	d.Set("agent_pool_id", agentPoolID)
+
+	return nil
+}
+
+func resourceTFEWorkspaceSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("execution_mode") || d.HasChange("agent_pool_id") {
+		if err := updateWorkspaceSettings(ctx, d, meta, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceTFEWorkspaceSettingsRead(ctx, d, meta)
+}
+
+// Deleting the settings does not delete the workspace, it only makes the
+// workspace use the default execution mode and agent pool of its
+// organization again.
+func resourceTFEWorkspaceSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Restore default settings of workspace: %s", d.Id())
+	err := restoreWorkspaceDefaultExecutionMode(ctx, tfeClient, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Error restoring default settings of workspace %s: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// restoreWorkspaceDefaultExecutionMode makes a workspace defer to the default
+// execution mode and agent pool of its organization. Servers without setting
+// overwrites do not support deferring, so the current default of the
+// organization is copied to the workspace instead, which is remote when the
+// organization has no default.
+func restoreWorkspaceDefaultExecutionMode(ctx context.Context, client *tfe.Client, workspaceID string) error {
+	ws, err := client.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	options := tfe.WorkspaceUpdateOptions{}
+	if ws.SettingOverwrites != nil {
+		options.SettingOverwrites = &tfe.WorkspaceSettingOverwritesOptions{
+			ExecutionMode: tfe.Bool(false),
+			AgentPool:     tfe.Bool(false),
+		}
+	} else {
+		org, err := client.Organizations.Read(ctx, ws.Organization.Name)
+		if err != nil {
+			return err
+		}
+
+		executionMode := org.DefaultExecutionMode
+		if executionMode == "" {
+			executionMode = "remote"
+		}
+		options.ExecutionMode = tfe.String(executionMode)
+		if executionMode == "agent" && org.DefaultAgentPool != nil {
+			options.AgentPoolID = tfe.String(org.DefaultAgentPool.ID)
+		}
+	}
+
+	_, err = client.Workspaces.UpdateByID(ctx, workspaceID, options)
+	return err
+}
+
+func updateWorkspaceSettings(ctx context.Context, d *schema.ResourceData, meta interface{}, workspaceID string) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	options := tfe.WorkspaceUpdateOptions{}
+
+	// Only send the settings which are configured; everything else is left
+	// as it is on the workspace.
+	if v, ok := d.GetOk("execution_mode"); ok {
+		options.ExecutionMode = tfe.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("agent_pool_id"); ok && v.(string) != "" {
+		options.AgentPoolID = tfe.String(v.(string))
+	}
+
+	if options.ExecutionMode == nil && options.AgentPoolID == nil {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Update settings of workspace: %s", workspaceID)
+	_, err := tfeClient.Workspaces.UpdateByID(ctx, workspaceID, options)
+	if err != nil {
+		return fmt.Errorf("Error updating settings of workspace %s: %w", workspaceID, err)
+	}
+
+	return nil
+}
+
+func resourceTFEWorkspaceSettingsImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The workspace settings are identified by the workspace ID.
+	d.Set("workspace_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,194 @@
+package tfe
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFEWorkspaceSettings_basic(t *testing.T) {
+	skipIfEnterprise(t)
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceSettings_local(org.Name, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace_settings.foobar", "id", "tfe_workspace.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_settings.foobar", "execution_mode", "local"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_settings.foobar", "agent_pool_id", ""),
+				),
+			},
+			{
+				Config: testAccTFEWorkspaceSettings_agent(org.Name, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_settings.foobar", "execution_mode", "agent"),
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace_settings.foobar", "agent_pool_id", "tfe_agent_pool.foobar", "id"),
+				),
+			},
+			{
+				ResourceName:      "tfe_workspace_settings.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTFEWorkspaceSettings_invalidAgentPool(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "tfe_workspace_settings" "foobar" {
+  workspace_id   = "ws-aaaaaaaaaaaaaaaa"
+  execution_mode = "remote"
+  agent_pool_id  = "apool-aaaaaaaaaaaaaaaa"
+}`,
+				ExpectError: regexp.MustCompile(`execution_mode must be set to 'agent' to assign agent_pool_id`),
+			},
+		},
+	})
+}
+
+func TestAccTFEWorkspaceSettings_destroyRestoresDefaultExecutionMode(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceSettings_local(org.Name, rInt),
+			},
+			{
+				Config: testAccTFEWorkspaceSettings_workspaceOnly(org.Name, rInt),
+				Check: func(s *terraform.State) error {
+					rs := s.RootModule().Resources["tfe_workspace.foobar"]
+					ws, err := tfeClient.Workspaces.ReadByID(ctx, rs.Primary.ID)
+					if err != nil {
+						return err
+					}
+					if ws.ExecutionMode != "remote" {
+						return fmt.Errorf("expected execution mode to be the organization default remote, got %s", ws.ExecutionMode)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestRestoreWorkspaceDefaultExecutionMode(t *testing.T) {
+	bodies := map[string]string{}
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-overwrites":
+			if r.Method == "PATCH" {
+				b, _ := io.ReadAll(r.Body)
+				bodies["ws-overwrites"] = string(b)
+			}
+			fmt.Fprint(w, `{"data":{"id":"ws-overwrites","type":"workspaces","attributes":{"execution-mode":"local","setting-overwrites":{"execution-mode":true,"agent-pool":true}},"relationships":{"organization":{"data":{"id":"hashicorp","type":"organizations"}}}}}`)
+		case "/api/v2/workspaces/ws-legacy":
+			if r.Method == "PATCH" {
+				b, _ := io.ReadAll(r.Body)
+				bodies["ws-legacy"] = string(b)
+			}
+			fmt.Fprint(w, `{"data":{"id":"ws-legacy","type":"workspaces","attributes":{"execution-mode":"local"},"relationships":{"organization":{"data":{"id":"hashicorp","type":"organizations"}}}}}`)
+		case "/api/v2/organizations/hashicorp":
+			fmt.Fprint(w, `{"data":{"id":"hashicorp","type":"organizations","attributes":{"default-execution-mode":"agent"},"relationships":{"default-agent-pool":{"data":{"id":"apool-123","type":"agent-pools"}}}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	if err := restoreWorkspaceDefaultExecutionMode(ctx, server.Client, "ws-overwrites"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body := bodies["ws-overwrites"]; !strings.Contains(body, `"setting-overwrites":{"execution-mode":false,"agent-pool":false}`) || strings.Contains(body, `"execution-mode":"`) {
+		t.Fatalf("expected the workspace to defer to the organization defaults, got %s", body)
+	}
+
+	if err := restoreWorkspaceDefaultExecutionMode(ctx, server.Client, "ws-legacy"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body := bodies["ws-legacy"]; !strings.Contains(body, `"execution-mode":"agent"`) || !strings.Contains(body, `"apool-123"`) {
+		t.Fatalf("expected the organization defaults to be copied to the workspace, got %s", body)
+	}
+
+	if err := restoreWorkspaceDefaultExecutionMode(ctx, server.Client, "ws-missing"); !isErrResourceNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func testAccTFEWorkspaceSettings_workspaceOnly(organization string, rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test-%d"
+  organization = "%s"
+
+  lifecycle {
+    ignore_changes = [execution_mode, agent_pool_id, operations]
+  }
+}`, rInt, organization)
+}
+
+func testAccTFEWorkspaceSettings_local(organization string, rInt int) string {
+	return testAccTFEWorkspaceSettings_workspaceOnly(organization, rInt) + `
+
+resource "tfe_workspace_settings" "foobar" {
+  workspace_id   = tfe_workspace.foobar.id
+  execution_mode = "local"
+}`
+}
+
+func testAccTFEWorkspaceSettings_agent(organization string, rInt int) string {
+	return testAccTFEWorkspaceSettings_workspaceOnly(organization, rInt) + fmt.Sprintf(`
+
+resource "tfe_agent_pool" "foobar" {
+  name         = "agent-pool-test-%d"
+  organization = "%s"
+}
+
+resource "tfe_workspace_settings" "foobar" {
+  workspace_id   = tfe_workspace.foobar.id
+  execution_mode = "agent"
+  agent_pool_id  = tfe_agent_pool.foobar.id
+}`, rInt, organization)
+}
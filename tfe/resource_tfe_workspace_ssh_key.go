@@ -0,0 +1,126 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTFEWorkspaceSSHKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEWorkspaceSSHKeyCreate),
+		ReadContext:   crudContext(resourceTFEWorkspaceSSHKeyRead),
+		UpdateContext: crudContext(resourceTFEWorkspaceSSHKeyUpdate),
+		DeleteContext: crudContext(resourceTFEWorkspaceSSHKeyDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFEWorkspaceSSHKeyImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"ssh_key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceTFEWorkspaceSSHKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	workspaceID := d.Get("workspace_id").(string)
+
+	if err := assignWorkspaceSSHKey(ctx, d, meta, workspaceID); err != nil {
+		return err
+	}
+
+	d.SetId(workspaceID)
+
+	return resourceTFEWorkspaceSSHKeyRead(ctx, d, meta)
+}
+
+func resourceTFEWorkspaceSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read SSH key of workspace: %s", d.Id())
+	workspace, err := tfeClient.Workspaces.ReadByID(ctx, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Workspace %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading SSH key of workspace %s: %w", d.Id(), err)
+	}
+
+	if workspace.SSHKey == nil {
+		log.Printf("[DEBUG] Workspace %s no longer has an SSH key", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("workspace_id", workspace.ID)
+	d.Set("ssh_key_id", workspace.SSHKey.ID)
+
+	return nil
+}
+
+func resourceTFEWorkspaceSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("ssh_key_id") {
+		if err := assignWorkspaceSSHKey(ctx, d, meta, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceTFEWorkspaceSSHKeyRead(ctx, d, meta)
+}
+
+func resourceTFEWorkspaceSSHKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Unassign SSH key from workspace: %s", d.Id())
+	_, err := tfeClient.Workspaces.UnassignSSHKey(ctx, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Error unassigning SSH key from workspace %s: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func assignWorkspaceSSHKey(ctx context.Context, d *schema.ResourceData, meta interface{}, workspaceID string) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	sshKeyID := d.Get("ssh_key_id").(string)
+
+	log.Printf("[DEBUG] Assign SSH key %s to workspace: %s", sshKeyID, workspaceID)
+	_, err := tfeClient.Workspaces.AssignSSHKey(ctx, workspaceID, tfe.WorkspaceAssignSSHKeyOptions{
+		SSHKeyID: tfe.String(sshKeyID),
+	})
+	if err != nil {
+		return fmt.Errorf("Error assigning SSH key %s to workspace %s: %w", sshKeyID, workspaceID, err)
+	}
+
+	return nil
+}
+
+func resourceTFEWorkspaceSSHKeyImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The SSH key assignment is identified by the workspace ID.
+	d.Set("workspace_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,131 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFEWorkspaceSSHKey_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceSSHKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceSSHKey_basic(rInt, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace_ssh_key.foobar", "ssh_key_id", "tfe_ssh_key.foo", "id"),
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace_ssh_key.foobar", "workspace_id", "tfe_workspace.foobar", "id"),
+					// The workspace does not manage the SSH key.
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "ssh_key_id", ""),
+				),
+			},
+			{
+				Config: testAccTFEWorkspaceSSHKey_basic(rInt, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace_ssh_key.foobar", "ssh_key_id", "tfe_ssh_key.bar", "id"),
+				),
+			},
+			{
+				ResourceName:      "tfe_workspace_ssh_key.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceTFEWorkspaceSSHKeyRead_unassigned(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-1234567890abcdef":
+			fmt.Fprint(w, `{"data":{"id":"ws-1234567890abcdef","type":"workspaces","attributes":{"name":"test"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	d := resourceTFEWorkspaceSSHKey().TestResourceData()
+	d.SetId("ws-1234567890abcdef")
+	d.Set("workspace_id", "ws-1234567890abcdef")
+	d.Set("ssh_key_id", "sshkey-1")
+
+	if err := resourceTFEWorkspaceSSHKeyRead(ctx, d, ConfiguredClient{Client: client}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected the resource to be removed when no SSH key is assigned, got ID %q", d.Id())
+	}
+}
+
+func testAccCheckTFEWorkspaceSSHKeyDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_workspace_ssh_key" {
+			continue
+		}
+
+		workspace, err := tfeClient.Workspaces.ReadByID(ctx, rs.Primary.ID)
+		if err != nil {
+			// The workspace is destroyed as well.
+			continue
+		}
+
+		if workspace.SSHKey != nil {
+			return fmt.Errorf("SSH key %s is still assigned to workspace %s", workspace.SSHKey.ID, rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEWorkspaceSSHKey_basic(rInt int, sshKey string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+
+  lifecycle {
+    ignore_changes = [ssh_key_id]
+  }
+}
+
+resource "tfe_ssh_key" "foo" {
+  name         = "ssh-key-foo"
+  organization = tfe_organization.foobar.id
+  key          = "SSH-KEY-CONTENT"
+}
+
+resource "tfe_ssh_key" "bar" {
+  name         = "ssh-key-bar"
+  organization = tfe_organization.foobar.id
+  key          = "SSH-KEY-CONTENT"
+}
+
+resource "tfe_workspace_ssh_key" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  ssh_key_id   = tfe_ssh_key.%s.id
+}`, rInt, sshKey)
+}
@@ -0,0 +1,223 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The workspace tags resource attaches tags to a workspace which is managed
+// elsewhere. Only the configured tag names and tag binding keys are managed,
+// other tags of the workspace are kept.
+func resourceTFEWorkspaceTags() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEWorkspaceTagsCreate),
+		ReadContext:   crudContext(resourceTFEWorkspaceTagsRead),
+		UpdateContext: crudContext(resourceTFEWorkspaceTagsUpdate),
+		DeleteContext: crudContext(resourceTFEWorkspaceTagsDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTFEWorkspaceTagsImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"tag_names": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"tag_names", "tags"},
+			},
+
+			"tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"tag_names", "tags"},
+			},
+		},
+
+		CustomizeDiff: func(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return validateTagNames(c, d)
+		},
+	}
+}
+
+func expandWorkspaceTags(names []interface{}) []*tfe.Tag {
+	var tags []*tfe.Tag
+	for _, name := range names {
+		tags = append(tags, &tfe.Tag{Name: name.(string)})
+	}
+	return tags
+}
+
+func resourceTFEWorkspaceTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+
+	if names := d.Get("tag_names").(*schema.Set).List(); len(names) > 0 {
+		log.Printf("[DEBUG] Add tags to workspace: %s", workspaceID)
+		err := tfeClient.Workspaces.AddTags(ctx, workspaceID, tfe.WorkspaceAddTagsOptions{
+			Tags: expandWorkspaceTags(names),
+		})
+		if err != nil {
+			return fmt.Errorf("Error adding tags to workspace %s: %w", workspaceID, err)
+		}
+	}
+
+	d.SetId(workspaceID)
+
+	bindings := make(map[string]string)
+	for key, value := range d.Get("tags").(map[string]interface{}) {
+		bindings[key] = value.(string)
+	}
+
+	log.Printf("[DEBUG] Add tag bindings to workspace: %s", workspaceID)
+	if err := addTagBindings(ctx, tfeClient, "workspaces", workspaceID, bindings); err != nil {
+		return fmt.Errorf("Error adding tag bindings to workspace %s: %w", workspaceID, err)
+	}
+
+	return resourceTFEWorkspaceTagsRead(ctx, d, meta)
+}
+
+func resourceTFEWorkspaceTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read tags of workspace: %s", d.Id())
+	workspace, err := tfeClient.Workspaces.ReadByID(ctx, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Workspace %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading workspace %s: %w", d.Id(), err)
+	}
+
+	// Tags removed outside of Terraform are dropped, so they are added again.
+	current := make(map[string]bool)
+	for _, name := range workspace.TagNames {
+		current[name] = true
+	}
+
+	var names []interface{}
+	for _, name := range d.Get("tag_names").(*schema.Set).List() {
+		if current[name.(string)] {
+			names = append(names, name)
+		}
+	}
+
+	bindings, err := listTagBindings(ctx, tfeClient, "workspaces", d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading tag bindings of workspace %s: %w", d.Id(), err)
+	}
+
+	tags := make(map[string]interface{})
+	for key := range d.Get("tags").(map[string]interface{}) {
+		if value, ok := bindings[key]; ok {
+			tags[key] = value
+		}
+	}
+
+	d.Set("workspace_id", d.Id())
+	d.Set("tag_names", names)
+	d.Set("tags", tags)
+
+	return nil
+}
+
+func resourceTFEWorkspaceTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	if d.HasChange("tag_names") {
+		oldNames, newNames := d.GetChange("tag_names")
+		added := newNames.(*schema.Set).Difference(oldNames.(*schema.Set)).List()
+		removed := oldNames.(*schema.Set).Difference(newNames.(*schema.Set)).List()
+
+		if len(removed) > 0 {
+			log.Printf("[DEBUG] Remove tags from workspace: %s", d.Id())
+			err := tfeClient.Workspaces.RemoveTags(ctx, d.Id(), tfe.WorkspaceRemoveTagsOptions{
+				Tags: expandWorkspaceTags(removed),
+			})
+			if err != nil {
+				return fmt.Errorf("Error removing tags from workspace %s: %w", d.Id(), err)
+			}
+		}
+
+		if len(added) > 0 {
+			log.Printf("[DEBUG] Add tags to workspace: %s", d.Id())
+			err := tfeClient.Workspaces.AddTags(ctx, d.Id(), tfe.WorkspaceAddTagsOptions{
+				Tags: expandWorkspaceTags(added),
+			})
+			if err != nil {
+				return fmt.Errorf("Error adding tags to workspace %s: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		if err := updateTagBindings(ctx, tfeClient, "workspaces", d.Id(), oldTags.(map[string]interface{}), newTags.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return resourceTFEWorkspaceTagsRead(ctx, d, meta)
+}
+
+func resourceTFEWorkspaceTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	if names := d.Get("tag_names").(*schema.Set).List(); len(names) > 0 {
+		log.Printf("[DEBUG] Remove tags from workspace: %s", d.Id())
+		err := tfeClient.Workspaces.RemoveTags(ctx, d.Id(), tfe.WorkspaceRemoveTagsOptions{
+			Tags: expandWorkspaceTags(names),
+		})
+		if err != nil && !isErrResourceNotFound(err) {
+			return fmt.Errorf("Error removing tags from workspace %s: %w", d.Id(), err)
+		}
+	}
+
+	if tags := d.Get("tags").(map[string]interface{}); len(tags) > 0 {
+		err := updateTagBindings(ctx, tfeClient, "workspaces", d.Id(), tags, map[string]interface{}{})
+		if err != nil && !isErrResourceNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceTFEWorkspaceTagsImporter imports all tags of the workspace, which
+// can then be narrowed down in the configuration.
+func resourceTFEWorkspaceTagsImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspace, err := tfeClient.Workspaces.ReadByID(ctx, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Error reading workspace %s: %w", d.Id(), err)
+	}
+
+	bindings, err := listTagBindings(ctx, tfeClient, "workspaces", d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Error reading tag bindings of workspace %s: %w", d.Id(), err)
+	}
+
+	d.Set("tag_names", workspace.TagNames)
+	d.Set("tags", bindings)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,66 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTFEWorkspaceTags_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceTags_basic(rInt, `["team:platform"]`, `{ env = "prod", cost-center = "42" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_tags.foobar", "tag_names.#", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_tags.foobar", "tags.env", "prod"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_tags.foobar", "tags.cost-center", "42"),
+				),
+			},
+			{
+				Config: testAccTFEWorkspaceTags_basic(rInt, `[]`, `{ env = "dev" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_tags.foobar", "tag_names.#", "0"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_tags.foobar", "tags.%", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_tags.foobar", "tags.env", "dev"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEWorkspaceTags_basic(rInt int, tagNames, tags string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+
+  lifecycle {
+    ignore_changes = [tag_names]
+  }
+}
+
+resource "tfe_workspace_tags" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  tag_names    = %s
+  tags         = %s
+}`, rInt, tagNames, tags)
+}
@@ -0,0 +1,245 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The workspace team accesses are authoritative: the grants of all teams
+// which are not configured are removed from the workspace. Use tfe_team_access
+// instead to manage single grants next to grants managed elsewhere.
+func resourceTFEWorkspaceTeamAccesses() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEWorkspaceTeamAccessesCreate),
+		ReadContext:   crudContext(resourceTFEWorkspaceTeamAccessesRead),
+		UpdateContext: crudContext(resourceTFEWorkspaceTeamAccessesUpdate),
+		DeleteContext: crudContext(resourceTFEWorkspaceTeamAccessesDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"team_access": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"team_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"access": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									string(tfe.AccessAdmin),
+									string(tfe.AccessRead),
+									string(tfe.AccessPlan),
+									string(tfe.AccessWrite),
+								},
+								false,
+							),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandWorkspaceTeamAccesses returns the configured access by team ID.
+func expandWorkspaceTeamAccesses(v interface{}) (map[string]tfe.AccessType, error) {
+	accesses := make(map[string]tfe.AccessType)
+	for _, raw := range v.(*schema.Set).List() {
+		ta := raw.(map[string]interface{})
+		teamID := ta["team_id"].(string)
+		if _, ok := accesses[teamID]; ok {
+			return nil, fmt.Errorf("team %s is configured more than once", teamID)
+		}
+		accesses[teamID] = tfe.AccessType(ta["access"].(string))
+	}
+	return accesses, nil
+}
+
+// syncWorkspaceTeamAccesses grants the given access to the teams of the
+// workspace and removes the grants of all other teams. New grants are made
+// before old ones are removed, so the workspace never lacks the configured
+// access.
+func syncWorkspaceTeamAccesses(ctx context.Context, client *tfe.Client, workspaceID string, accesses map[string]tfe.AccessType) error {
+	existing, err := listWorkspaceTeamAccesses(ctx, client, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	var toRemove []*tfe.TeamAccess
+	granted := make(map[string]bool)
+	for _, ta := range existing {
+		if ta.Team == nil {
+			continue
+		}
+
+		access, ok := accesses[ta.Team.ID]
+		if !ok {
+			toRemove = append(toRemove, ta)
+			continue
+		}
+		granted[ta.Team.ID] = true
+
+		if ta.Access == access {
+			continue
+		}
+
+		log.Printf("[DEBUG] Update access of team %s to workspace %s to %s", ta.Team.ID, workspaceID, access)
+		_, err := client.TeamAccess.Update(ctx, ta.ID, tfe.TeamAccessUpdateOptions{
+			Access: tfe.Access(access),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating access of team %s to workspace %s: %w", ta.Team.ID, workspaceID, err)
+		}
+	}
+
+	for teamID, access := range accesses {
+		if granted[teamID] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Give team %s %s access to workspace: %s", teamID, access, workspaceID)
+		_, err := client.TeamAccess.Add(ctx, tfe.TeamAccessAddOptions{
+			Access:    tfe.Access(access),
+			Team:      &tfe.Team{ID: teamID},
+			Workspace: &tfe.Workspace{ID: workspaceID},
+		})
+		if err != nil {
+			return fmt.Errorf("Error giving team %s %s access to workspace %s: %w", teamID, access, workspaceID, err)
+		}
+	}
+
+	for _, ta := range toRemove {
+		log.Printf("[DEBUG] Remove access of team %s to workspace: %s", ta.Team.ID, workspaceID)
+		err := client.TeamAccess.Remove(ctx, ta.ID)
+		if err != nil && err != tfe.ErrResourceNotFound {
+			return fmt.Errorf("Error removing access of team %s to workspace %s: %w", ta.Team.ID, workspaceID, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceTFEWorkspaceTeamAccessesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+
+	accesses, err := expandWorkspaceTeamAccesses(d.Get("team_access"))
+	if err != nil {
+		return err
+	}
+
+	if err := syncWorkspaceTeamAccesses(ctx, tfeClient, workspaceID, accesses); err != nil {
+		return err
+	}
+
+	d.SetId(workspaceID)
+
+	return resourceTFEWorkspaceTeamAccessesRead(ctx, d, meta)
+}
+
+func resourceTFEWorkspaceTeamAccessesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read team access of workspace: %s", d.Id())
+	teamAccesses, err := listWorkspaceTeamAccesses(ctx, tfeClient, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Workspace %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// All grants are read, so grants added outside of Terraform show up as
+	// a difference and are removed by the next apply.
+	var result []interface{}
+	for _, ta := range teamAccesses {
+		if ta.Team == nil {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"team_id": ta.Team.ID,
+			"access":  string(ta.Access),
+		})
+	}
+
+	d.Set("workspace_id", d.Id())
+	d.Set("team_access", result)
+
+	return nil
+}
+
+func resourceTFEWorkspaceTeamAccessesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	accesses, err := expandWorkspaceTeamAccesses(d.Get("team_access"))
+	if err != nil {
+		return err
+	}
+
+	if err := syncWorkspaceTeamAccesses(ctx, tfeClient, d.Id(), accesses); err != nil {
+		return err
+	}
+
+	return resourceTFEWorkspaceTeamAccessesRead(ctx, d, meta)
+}
+
+func resourceTFEWorkspaceTeamAccessesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	// Only the grants known to Terraform are removed.
+	accesses, err := expandWorkspaceTeamAccesses(d.Get("team_access"))
+	if err != nil {
+		return err
+	}
+
+	teamAccesses, err := listWorkspaceTeamAccesses(ctx, tfeClient, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, ta := range teamAccesses {
+		if ta.Team == nil {
+			continue
+		}
+		if _, ok := accesses[ta.Team.ID]; !ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Remove access of team %s to workspace: %s", ta.Team.ID, d.Id())
+		err := tfeClient.TeamAccess.Remove(ctx, ta.ID)
+		if err != nil && err != tfe.ErrResourceNotFound {
+			return fmt.Errorf("Error removing access of team %s to workspace %s: %w", ta.Team.ID, d.Id(), err)
+		}
+	}
+
+	return nil
+}
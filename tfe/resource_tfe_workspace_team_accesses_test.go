@@ -0,0 +1,183 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFEWorkspaceTeamAccesses_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceTeamAccesses_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_team_accesses.foobar", "team_access.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"tfe_workspace_team_accesses.foobar", "team_access.*", map[string]string{
+							"access": "write",
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"tfe_workspace_team_accesses.foobar", "team_access.*", map[string]string{
+							"access": "read",
+						}),
+				),
+			},
+			{
+				Config: testAccTFEWorkspaceTeamAccesses_update(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_team_accesses.foobar", "team_access.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"tfe_workspace_team_accesses.foobar", "team_access.*", map[string]string{
+							"access": "admin",
+						}),
+				),
+			},
+			{
+				ResourceName:      "tfe_workspace_team_accesses.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSyncWorkspaceTeamAccesses(t *testing.T) {
+	var requests []string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/team-workspaces":
+			fmt.Fprint(w, `{"data":[
+				{"id":"tws-1","type":"team-workspaces","attributes":{"access":"read"},"relationships":{"team":{"data":{"id":"team-1","type":"teams"}}}},
+				{"id":"tws-2","type":"team-workspaces","attributes":{"access":"admin"},"relationships":{"team":{"data":{"id":"team-2","type":"teams"}}}},
+				{"id":"tws-3","type":"team-workspaces","attributes":{"access":"plan"},"relationships":{"team":{"data":{"id":"team-3","type":"teams"}}}}
+			],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/team-workspaces/tws-1":
+			fmt.Fprint(w, `{"data":{"id":"tws-1","type":"team-workspaces","attributes":{"access":"write"}}}`)
+		case r.Method == "POST" && r.URL.Path == "/api/v2/team-workspaces":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data":{"id":"tws-4","type":"team-workspaces","attributes":{"access":"plan"}}}`)
+		case r.Method == "DELETE" && r.URL.Path == "/api/v2/team-workspaces/tws-3":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	err := syncWorkspaceTeamAccesses(ctx, client, "ws-123", map[string]tfe.AccessType{
+		"team-1": tfe.AccessWrite,
+		"team-2": tfe.AccessAdmin,
+		"team-4": tfe.AccessPlan,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Grants are made before the other grants are removed.
+	want := []string{
+		"GET /api/v2/team-workspaces",
+		"PATCH /api/v2/team-workspaces/tws-1",
+		"POST /api/v2/team-workspaces",
+		"DELETE /api/v2/team-workspaces/tws-3",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(want) {
+		t.Fatalf("wrong requests\ngot: %v\nwant: %v", requests, want)
+	}
+}
+
+func TestExpandWorkspaceTeamAccesses(t *testing.T) {
+	d := resourceTFEWorkspaceTeamAccesses().TestResourceData()
+	d.Set("team_access", []interface{}{
+		map[string]interface{}{"team_id": "team-1", "access": "read"},
+		map[string]interface{}{"team_id": "team-1", "access": "write"},
+	})
+
+	if _, err := expandWorkspaceTeamAccesses(d.Get("team_access")); err == nil {
+		t.Fatal("expected an error when a team is configured more than once")
+	}
+}
+
+func testAccTFEWorkspaceTeamAccesses_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "writers" {
+  name         = "writers"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_team" "readers" {
+  name         = "readers"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace_team_accesses" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+
+  team_access {
+    team_id = tfe_team.writers.id
+    access  = "write"
+  }
+
+  team_access {
+    team_id = tfe_team.readers.id
+    access  = "read"
+  }
+}`, rInt)
+}
+
+func testAccTFEWorkspaceTeamAccesses_update(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "writers" {
+  name         = "writers"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_team" "readers" {
+  name         = "readers"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace_team_accesses" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+
+  team_access {
+    team_id = tfe_team.writers.id
+    access  = "admin"
+  }
+}`, rInt)
+}
@@ -3,18 +3,22 @@ package tfe
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"math/rand"
+	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
 )
 
 func TestAccTFEWorkspace_basic(t *testing.T) {
@@ -68,6 +72,43 @@ func TestAccTFEWorkspace_basic(t *testing.T) {
 	})
 }
 
+func TestAccTFEWorkspace_terraformVersionConstraint(t *testing.T) {
+	skipIfCloud(t)
+
+	workspace := &tfe.Workspace{}
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_terraformVersion(rInt, "~> 1.5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "terraform_version", "~> 1.5"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_workspace.foobar", "resolved_terraform_version"),
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace.foobar", "resolved_terraform_version", "data.tfe_terraform_versions.all", "latest"),
+				),
+			},
+			{
+				Config: testAccTFEWorkspace_terraformVersion(rInt, "1.5.7"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "terraform_version", "1.5.7"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "resolved_terraform_version", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTFEWorkspace_basicReadProjectId(t *testing.T) {
 	skipUnlessBeta(t)
 
@@ -141,6 +182,123 @@ func TestTagValidation(t *testing.T) {
 	}
 }
 
+func TestValidateTriggers(t *testing.T) {
+	cases := map[string]struct {
+		raw map[string]interface{}
+		err string
+	}{
+		"no triggers": {},
+		"trigger prefixes": {
+			raw: map[string]interface{}{"trigger_prefixes": []interface{}{"/modules"}},
+		},
+		"trigger patterns": {
+			raw: map[string]interface{}{"trigger_patterns": []interface{}{"/modules/**/*"}},
+		},
+		"empty trigger prefixes with trigger patterns": {
+			raw: map[string]interface{}{"trigger_prefixes": []interface{}{}, "trigger_patterns": []interface{}{"/modules/**/*"}},
+		},
+		"trigger prefixes and trigger patterns": {
+			raw: map[string]interface{}{"trigger_prefixes": []interface{}{"/modules"}, "trigger_patterns": []interface{}{"/modules/**/*"}},
+			err: "mutually exclusive",
+		},
+		"file triggers disabled": {
+			raw: map[string]interface{}{"file_triggers_enabled": false},
+		},
+		"trigger patterns with file triggers disabled": {
+			raw: map[string]interface{}{"file_triggers_enabled": false, "trigger_patterns": []interface{}{"/modules/**/*"}},
+			err: "file_triggers_enabled must be 'true' when setting trigger_patterns",
+		},
+		"trigger prefixes with file triggers disabled": {
+			raw: map[string]interface{}{"file_triggers_enabled": false, "trigger_prefixes": []interface{}{"/modules"}},
+			err: "file_triggers_enabled must be 'true' when setting trigger_prefixes",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{"name": "my-workspace", "organization": "my-org"}
+			for k, v := range tc.raw {
+				raw[k] = v
+			}
+
+			_, err := resourceTFEWorkspace().Diff(ctx, nil, terraform.NewResourceConfigRaw(raw), ConfiguredClient{})
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error to contain %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestResolveTerraformVersion(t *testing.T) {
+	cases := map[string]struct {
+		raw      map[string]interface{}
+		status   int
+		resolved string
+		err      string
+	}{
+		"exact version": {
+			raw: map[string]interface{}{"terraform_version": "1.5.7", "resolve_terraform_version": true},
+		},
+		"constraint sent as is": {
+			raw: map[string]interface{}{"terraform_version": "~> 1.5"},
+		},
+		"constraint resolved": {
+			raw:      map[string]interface{}{"terraform_version": "~> 1.5.0", "resolve_terraform_version": true},
+			resolved: "1.5.7",
+		},
+		"versions not accessible": {
+			raw:    map[string]interface{}{"terraform_version": "~> 1.5", "resolve_terraform_version": true},
+			status: http.StatusNotFound,
+			err:    "requires a token which can list the Terraform versions",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tc.status != 0 || r.URL.Path != "/api/v2/admin/terraform-versions" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				fmt.Fprint(w, `{"data":[
+					{"id":"tool-1","type":"terraform-versions","attributes":{"version":"1.5.7","enabled":true}},
+					{"id":"tool-2","type":"terraform-versions","attributes":{"version":"1.6.0","enabled":true}}
+				],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+			}))
+
+			raw := map[string]interface{}{"name": "my-workspace", "organization": "my-org"}
+			for k, v := range tc.raw {
+				raw[k] = v
+			}
+
+			diff, err := resourceTFEWorkspace().Diff(ctx, nil, terraform.NewResourceConfigRaw(raw), ConfiguredClient{Client: server.Client})
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error to contain %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var resolved string
+			if attr, ok := diff.Attributes["resolved_terraform_version"]; ok && !attr.NewComputed {
+				resolved = attr.New
+			}
+			if resolved != tc.resolved {
+				t.Fatalf("expected resolved version %q, got %q", tc.resolved, resolved)
+			}
+		})
+	}
+}
+
 func TestAccTFEWorkspace_panic(t *testing.T) {
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
 
@@ -299,6 +457,8 @@ func TestAccTFEWorkspace_update(t *testing.T) {
 						"tfe_workspace.foobar", "allow_destroy_plan", "true"),
 					resource.TestCheckResourceAttr(
 						"tfe_workspace.foobar", "auto_apply", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "auto_apply_run_trigger", "true"),
 					resource.TestCheckResourceAttr(
 						"tfe_workspace.foobar", "file_triggers_enabled", "true"),
 					resource.TestCheckResourceAttr(
@@ -402,6 +562,48 @@ func TestAccTFEWorkspace_updateProject(t *testing.T) {
 	})
 }
 
+func TestAccTFEWorkspace_ignoreProjectChanges(t *testing.T) {
+	skipUnlessBeta(t)
+	workspace := &tfe.Workspace{}
+	newProjectID := ""
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_ignoreProjectChanges(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttrPair("tfe_workspace.foobar", "project_id", "tfe_project.foobar", "id"),
+					func(s *terraform.State) error {
+						newProjectID = s.RootModule().Resources["tfe_project.new_project"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Move the workspace outside of Terraform, the plan must not
+				// move it back.
+				PreConfig: func() {
+					tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+					_, err := tfeClient.Workspaces.UpdateByID(ctx, workspace.ID, tfe.WorkspaceUpdateOptions{
+						Project: &tfe.Project{ID: newProjectID},
+					})
+					if err != nil {
+						t.Fatalf("error moving workspace %s to project %s: %v", workspace.ID, newProjectID, err)
+					}
+				},
+				Config:   testAccTFEWorkspace_ignoreProjectChanges(rInt),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccTFEWorkspace_updateFileTriggers(t *testing.T) {
 	workspace := &tfe.Workspace{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -1809,6 +2011,169 @@ func TestAccTFEWorkspace_unsetExecutionMode(t *testing.T) {
 	})
 }
 
+func TestAccTFEWorkspace_namePattern(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFEWorkspace_namePattern("my-org"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Workspace name "workspace-test" does not match the naming convention`),
+			},
+		},
+	})
+}
+
+func TestAccTFEWorkspace_reconcileServerDefaultsDisabled(t *testing.T) {
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	workspace := &tfe.Workspace{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_reconcileServerDefaultsDisabled(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+				),
+			},
+			{
+				// Changes made outside of Terraform to attributes which are
+				// not configured must not result in a diff.
+				PreConfig: func() {
+					_, err := tfeClient.Workspaces.UpdateByID(ctx, workspace.ID, tfe.WorkspaceUpdateOptions{
+						ExecutionMode:     tfe.String("local"),
+						GlobalRemoteState: tfe.Bool(true),
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccTFEWorkspace_reconcileServerDefaultsDisabled(org.Name),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccTFEWorkspace_reconcileServerDefaultsDisabledUpdate(org.Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "execution_mode", "local"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "global_remote_state", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "auto_apply", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestTFEWorkspaceUpdate_reconcileServerDefaults(t *testing.T) {
+	cases := map[string]struct {
+		reconcile bool
+		requests  []string
+	}{
+		"reconcile": {
+			reconcile: true,
+			requests: []string{
+				"PATCH /api/v2/workspaces/ws-123 prj-new",
+				"POST /api/v2/workspaces/ws-123/relationships/tags",
+				"DELETE /api/v2/workspaces/ws-123/relationships/tags",
+				"POST /api/v2/workspaces/ws-123/relationships/remote-state-consumers",
+				"DELETE /api/v2/workspaces/ws-123/relationships/remote-state-consumers",
+				"GET /api/v2/workspaces/ws-123",
+			},
+		},
+		"no reconcile": {
+			reconcile: false,
+			requests: []string{
+				"PATCH /api/v2/workspaces/ws-123",
+				"GET /api/v2/workspaces/ws-123",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		var requests []string
+		server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			request := r.Method + " " + r.URL.Path
+			if strings.Contains(string(body), "prj-new") {
+				request += " prj-new"
+			}
+			requests = append(requests, request)
+
+			switch r.Method {
+			case "PATCH":
+				fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces"}}`)
+			case "POST", "DELETE":
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		// The project, tags and remote state consumers changed, but none of
+		// them are configured.
+		r := resourceTFEWorkspace()
+		rawConfig := testRawConfig(r, map[string]cty.Value{
+			"name": cty.StringVal("workspace"),
+		})
+		state := &terraform.InstanceState{
+			ID: "ws-123",
+			Attributes: map[string]string{
+				"id":                            "ws-123",
+				"name":                          "workspace",
+				"organization":                  "hashicorp",
+				"project_id":                    "prj-old",
+				"tag_names.#":                   "1",
+				setElementKey("tag_names", "a"): "a",
+				"remote_state_consumer_ids.#":   "1",
+				setElementKey("remote_state_consumer_ids", "ws-old"): "ws-old",
+			},
+			RawConfig: rawConfig,
+		}
+		diff := &terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{
+				"project_id":                                         {Old: "prj-old", New: "prj-new"},
+				"tag_names.#":                                        {Old: "1", New: "1"},
+				setElementKey("tag_names", "a"):                      {Old: "a", New: "", NewRemoved: true},
+				setElementKey("tag_names", "b"):                      {Old: "", New: "b"},
+				"remote_state_consumer_ids.#":                        {Old: "1", New: "1"},
+				setElementKey("remote_state_consumer_ids", "ws-old"): {Old: "ws-old", New: "", NewRemoved: true},
+				setElementKey("remote_state_consumer_ids", "ws-new"): {Old: "", New: "ws-new"},
+			},
+			RawConfig: rawConfig,
+		}
+
+		meta := ConfiguredClient{Client: server.Client, ReconcileServerDefaults: tc.reconcile}
+		if _, diags := r.Apply(ctx, state, diff, meta); diags.HasError() {
+			t.Fatalf("%s: unexpected error: %v", name, diags)
+		}
+
+		if fmt.Sprint(requests) != fmt.Sprint(tc.requests) {
+			t.Fatalf("%s: wrong requests\ngot: %v\nwant: %v", name, requests, tc.requests)
+		}
+	}
+}
+
+// setElementKey returns the flatmap key of a string in a set attribute.
+func setElementKey(attr, value string) string {
+	return fmt.Sprintf("%s.%d", attr, schema.HashString(value))
+}
+
 func TestAccTFEWorkspace_globalRemoteState(t *testing.T) {
 	workspace := &tfe.Workspace{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -2030,6 +2395,50 @@ func TestAccTFEWorkspace_delete_forceDeleteSettingEnabled(t *testing.T) {
 	})
 }
 
+func TestAccTFEWorkspace_autoDestroy(t *testing.T) {
+	skipIfEnterprise(t)
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org, orgCleanup := createBusinessOrganization(t, tfeClient)
+	t.Cleanup(orgCleanup)
+
+	workspace := &tfe.Workspace{}
+	autoDestroyAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspace_autoDestroyAt(org.Name, autoDestroyAt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists("tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttrSet("tfe_workspace.foobar", "auto_destroy_at"),
+					resource.TestCheckResourceAttr("tfe_workspace.foobar", "auto_destroy_activity_duration", ""),
+				),
+			},
+			{
+				Config: testAccTFEWorkspace_autoDestroyActivityDuration(org.Name, "14d"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tfe_workspace.foobar", "auto_destroy_at", ""),
+					resource.TestCheckResourceAttr("tfe_workspace.foobar", "auto_destroy_activity_duration", "14d"),
+				),
+			},
+			{
+				Config: testAccTFEWorkspace_autoDestroyActivityDuration(org.Name, "24h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tfe_workspace.foobar", "auto_destroy_activity_duration", "24h"),
+				),
+			},
+		},
+	})
+}
+
 func TestTFEWorkspace_delete_withoutCanForceDeletePermission(t *testing.T) {
 	// This test checks that workspace deletion works as expected when communicating with TFE servers which do not send
 	// the CanForceDelete workspace permission. To simulate this we use the mock workspaces client and call the
@@ -2055,14 +2464,14 @@ func TestTFEWorkspace_delete_withoutCanForceDeletePermission(t *testing.T) {
 		t.Fatalf("unexpected err creating configuration state %v", err)
 	}
 
-	err = resourceTFEWorkspaceDelete(rd, client)
+	err = resourceTFEWorkspaceDelete(ctx, rd, ConfiguredClient{Client: client})
 	if err == nil {
 		t.Fatalf("Expected an error deleting workspace with CanForceDelete=nil, force_delete=true, and %v resources", workspace.ResourceCount)
 	}
 
 	workspace.ResourceCount = 0
 
-	err = resourceTFEWorkspaceDelete(rd, client)
+	err = resourceTFEWorkspaceDelete(ctx, rd, ConfiguredClient{Client: client})
 	if err == nil {
 		t.Fatalf("Expected an error deleting workspace with CanForceDelete=nil and force_delete=false")
 	}
@@ -2077,7 +2486,7 @@ func TestTFEWorkspace_delete_withoutCanForceDeletePermission(t *testing.T) {
 		t.Fatalf("Unexpected err creating configuration state %v", err)
 	}
 
-	err = resourceTFEWorkspaceDelete(rd, client)
+	err = resourceTFEWorkspaceDelete(ctx, rd, ConfiguredClient{Client: client})
 	if err != nil {
 		t.Fatalf("Unexpected err deleting mock workspace %v", err)
 	}
@@ -2088,10 +2497,86 @@ func TestTFEWorkspace_delete_withoutCanForceDeletePermission(t *testing.T) {
 	}
 }
 
+func TestResourceTFEWorkspaceImporter_reportAssociations(t *testing.T) {
+	cases := map[string]struct {
+		env    string
+		report bool
+	}{
+		"unset":   {env: "", report: false},
+		"enabled": {env: "true", report: true},
+		"invalid": {env: "yes please", report: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("TFE_IMPORT_REPORT_ASSOCIATIONS", tc.env)
+
+			var requests []string
+			server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}))
+
+			d := resourceTFEWorkspace().Data(nil)
+			d.SetId("ws-123")
+			if _, err := resourceTFEWorkspaceImporter(context.Background(), d, ConfiguredClient{Client: server.Client}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// Importing by ID needs no request unless the associations are
+			// reported, which starts with reading the workspace.
+			if reported := len(requests) > 0; reported != tc.report {
+				t.Fatalf("expected associations to be reported: %t, got requests %v", tc.report, requests)
+			}
+			if tc.report && requests[0] != "GET /api/v2/workspaces/ws-123" {
+				t.Fatalf("expected the workspace to be read, got %v", requests)
+			}
+		})
+	}
+}
+
+func TestErrWorkspaceSafeDeleteWithPermission(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want string
+	}{
+		"deleted": {
+			err: nil,
+		},
+		"already deleted": {
+			err: tfe.ErrResourceNotFound,
+		},
+		"resources under management": {
+			err:  fmt.Errorf("conflict\nWorkspace is currently managing resources"),
+			want: "add force_delete = true",
+		},
+		"unexpected error": {
+			err:  fmt.Errorf("internal server error"),
+			want: "internal server error",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := errWorkspaceSafeDeleteWithPermission("ws-123", test.err)
+			if test.want == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Fatalf("expected error containing %q, got %v", test.want, err)
+			}
+		})
+	}
+}
+
 func testAccCheckTFEWorkspaceExists(
 	n string, workspace *tfe.Workspace) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -2124,7 +2609,7 @@ func testAccCheckTFEWorkspaceExists(
 // resource_tfe_workspace.go:208 resourceTFEWorkspaceRead(...)
 func testAccCheckTFEWorkspacePanic(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		// Grab the resource out of the state and delete it from TFC/E directly.
 		rs, ok := s.RootModule().Resources[n]
@@ -2142,7 +2627,7 @@ func testAccCheckTFEWorkspacePanic(n string) resource.TestCheckFunc {
 		rd := &schema.ResourceData{}
 		rd.SetId(rs.Primary.ID)
 
-		err = resourceTFEWorkspaceRead(rd, testAccProvider.Meta())
+		err = resourceTFEWorkspaceRead(ctx, rd, testAccProvider.Meta())
 		if err != nil && err != tfe.ErrResourceNotFound {
 			return fmt.Errorf("Could not re-read resource directly: %w", err)
 		}
@@ -2255,7 +2740,7 @@ func testAccCheckTFEWorkspaceMonorepoAttributes(
 
 func testAccCheckTFEWorkspaceRename(orgName string) func() {
 	return func() {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		w, err := tfeClient.Workspaces.Update(
 			context.Background(),
@@ -2419,7 +2904,7 @@ func testAccCheckTFEWorkspaceUpdatedRemoveVCSRepoAttributes(
 }
 
 func testAccCheckTFEWorkspaceDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_workspace" {
@@ -2494,6 +2979,43 @@ resource "tfe_workspace" "foobar" {
 }`, rInt)
 }
 
+func testAccTFEWorkspace_terraformVersion(rInt int, terraformVersion string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+data "tfe_terraform_versions" "all" {
+  search = "1."
+}
+
+resource "tfe_workspace" "foobar" {
+  name                      = "workspace-test"
+  organization              = tfe_organization.foobar.id
+  terraform_version         = "%s"
+  resolve_terraform_version = true
+}`, rInt, terraformVersion)
+}
+
+func testAccTFEWorkspace_autoDestroyAt(organization, autoDestroyAt string) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name            = "workspace-test"
+  organization    = "%s"
+  auto_destroy_at = "%s"
+}`, organization, autoDestroyAt)
+}
+
+func testAccTFEWorkspace_autoDestroyActivityDuration(organization, duration string) string {
+	return fmt.Sprintf(`
+resource "tfe_workspace" "foobar" {
+  name                           = "workspace-test"
+  organization                   = "%s"
+  auto_destroy_activity_duration = "%s"
+}`, organization, duration)
+}
+
 func testAccTFEWorkspace_orgProjectWorkspace(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
@@ -2513,6 +3035,31 @@ resource "tfe_workspace" "foobar" {
 }`, rInt)
 }
 
+func testAccTFEWorkspace_ignoreProjectChanges(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "foobar" {
+  name         = "testproject"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_project" "new_project" {
+  name         = "testproject2"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace" "foobar" {
+  name                   = "workspace-test"
+  organization           = tfe_organization.foobar.id
+  project_id             = tfe_project.foobar.id
+  ignore_project_changes = true
+}`, rInt)
+}
+
 func testAccTFEWorkspace_orgProjectWorkspaceOtherProject(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
@@ -2720,6 +3267,43 @@ resource "tfe_workspace" "foobar" {
 }`, organization, organization)
 }
 
+func testAccTFEWorkspace_namePattern(organization string) string {
+	return fmt.Sprintf(`
+provider "tfe" {
+  workspace_name_pattern = "^(dev|prod)-"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = "%s"
+}`, organization)
+}
+
+func testAccTFEWorkspace_reconcileServerDefaultsDisabled(organization string) string {
+	return fmt.Sprintf(`
+provider "tfe" {
+  reconcile_server_defaults = false
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = "%s"
+}`, organization)
+}
+
+func testAccTFEWorkspace_reconcileServerDefaultsDisabledUpdate(organization string) string {
+	return fmt.Sprintf(`
+provider "tfe" {
+  reconcile_server_defaults = false
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = "%s"
+  auto_apply   = true
+}`, organization)
+}
+
 func testAccTFEWorkspace_basicSpeculativeOff(rInt int) string {
 	return fmt.Sprintf(`
 resource "tfe_organization" "foobar" {
@@ -2775,16 +3359,17 @@ resource "tfe_organization" "foobar" {
 }
 
 resource "tfe_workspace" "foobar" {
-  name                  = "workspace-updated"
-  organization          = tfe_organization.foobar.id
-  allow_destroy_plan    = true
-  auto_apply            = false
-  file_triggers_enabled = true
-  queue_all_runs        = false
-  terraform_version     = "0.11.1"
-  trigger_prefixes      = ["/modules", "/shared"]
-  working_directory     = "terraform/test"
-  operations            = false
+  name                   = "workspace-updated"
+  organization           = tfe_organization.foobar.id
+  allow_destroy_plan     = true
+  auto_apply             = false
+  auto_apply_run_trigger = true
+  file_triggers_enabled  = true
+  queue_all_runs         = false
+  terraform_version      = "0.11.1"
+  trigger_prefixes       = ["/modules", "/shared"]
+  working_directory      = "terraform/test"
+  operations             = false
 }`, rInt)
 }
 
@@ -13,9 +13,9 @@ import (
 
 func resourceTFEWorkspaceVariableSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTFEWorkspaceVariableSetCreate,
-		Read:   resourceTFEWorkspaceVariableSetRead,
-		Delete: resourceTFEWorkspaceVariableSetDelete,
+		CreateContext: crudContext(resourceTFEWorkspaceVariableSetCreate),
+		ReadContext:   crudContext(resourceTFEWorkspaceVariableSetRead),
+		DeleteContext: crudContext(resourceTFEWorkspaceVariableSetDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEWorkspaceVariableSetImporter,
 		},
@@ -36,8 +36,8 @@ func resourceTFEWorkspaceVariableSet() *schema.Resource {
 	}
 }
 
-func resourceTFEWorkspaceVariableSetCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEWorkspaceVariableSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	vSID := d.Get("variable_set_id").(string)
 	wID := d.Get("workspace_id").(string)
@@ -54,11 +54,11 @@ func resourceTFEWorkspaceVariableSetCreate(d *schema.ResourceData, meta interfac
 	id := encodeVariableSetWorkspaceAttachment(wID, vSID)
 	d.SetId(id)
 
-	return resourceTFEWorkspaceVariableSetRead(d, meta)
+	return resourceTFEWorkspaceVariableSetRead(ctx, d, meta)
 }
 
-func resourceTFEWorkspaceVariableSetRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEWorkspaceVariableSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	wID := d.Get("workspace_id").(string)
 	vSID := d.Get("variable_set_id").(string)
@@ -94,8 +94,8 @@ func resourceTFEWorkspaceVariableSetRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTFEWorkspaceVariableSetDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+func resourceTFEWorkspaceVariableSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
 
 	wID := d.Get("workspace_id").(string)
 	vSID := d.Get("variable_set_id").(string)
@@ -126,7 +126,7 @@ func resourceTFEWorkspaceVariableSetImporter(ctx context.Context, d *schema.Reso
 		return nil, err
 	}
 
-	tfeClient := meta.(*tfe.Client)
+	tfeClient := meta.(ConfiguredClient).Client
 
 	// Ensure a workspace of this name exists before fetching all the variable sets in the org
 	_, err = tfeClient.Workspaces.Read(ctx, organization, wsName)
@@ -39,7 +39,7 @@ func TestAccTFEWorkspaceVariableSet_basic(t *testing.T) {
 
 func testAccCheckTFEWorkspaceVariableSetExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -73,7 +73,7 @@ func testAccCheckTFEWorkspaceVariableSetExists(n string) resource.TestCheckFunc
 }
 
 func testAccCheckTFEWorkspaceVariableSetDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_variable_set" {
@@ -0,0 +1,373 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The workspace variables resource manages all variables of a workspace from
+// a single resource, which needs one list request to refresh instead of one
+// request per variable. Variables which are not configured are deleted.
+func resourceTFEWorkspaceVariables() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crudContext(resourceTFEWorkspaceVariablesCreate),
+		ReadContext:   crudContext(resourceTFEWorkspaceVariablesRead),
+		UpdateContext: crudContext(resourceTFEWorkspaceVariablesUpdate),
+		DeleteContext: crudContext(resourceTFEWorkspaceVariablesDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					workspaceIdRegexp,
+					"must be a valid workspace ID (ws-<RANDOM STRING>)",
+				),
+			},
+
+			"variable": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"value": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Default:   "",
+							Sensitive: true,
+						},
+
+						"category": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(tfe.CategoryTerraform),
+							ValidateFunc: validation.StringInSlice(
+								[]string{
+									string(tfe.CategoryEnv),
+									string(tfe.CategoryTerraform),
+								},
+								false,
+							),
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+
+						"hcl": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"sensitive": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"variable_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// workspaceVariable is a variable of the variable set of the resource.
+type workspaceVariable struct {
+	Key         string
+	Value       string
+	Category    string
+	Description string
+	HCL         bool
+	Sensitive   bool
+}
+
+// workspaceVariableID returns the key of a variable in variable_ids. Keys are
+// only unique per category, so both are part of it.
+func workspaceVariableID(category, key string) string {
+	return category + "/" + key
+}
+
+// expandWorkspaceVariables returns the variables of a variable set, keyed by
+// their category and key.
+func expandWorkspaceVariables(set *schema.Set) (map[string]workspaceVariable, error) {
+	variables := make(map[string]workspaceVariable)
+
+	for _, raw := range set.List() {
+		m := raw.(map[string]interface{})
+		v := workspaceVariable{
+			Key:         m["key"].(string),
+			Value:       m["value"].(string),
+			Category:    m["category"].(string),
+			Description: m["description"].(string),
+			HCL:         m["hcl"].(bool),
+			Sensitive:   m["sensitive"].(bool),
+		}
+
+		id := workspaceVariableID(v.Category, v.Key)
+		if _, ok := variables[id]; ok {
+			return nil, fmt.Errorf("variable %s is configured more than once", id)
+		}
+		variables[id] = v
+	}
+
+	return variables, nil
+}
+
+// listWorkspaceVariables returns all variables of a workspace, keyed by their
+// category and key.
+func listWorkspaceVariables(ctx context.Context, client *tfe.Client, workspaceID string) (map[string]*tfe.Variable, error) {
+	variables := make(map[string]*tfe.Variable)
+
+	options := &tfe.VariableListOptions{}
+	for {
+		l, err := client.Variables.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range l.Items {
+			variables[workspaceVariableID(string(v.Category), v.Key)] = v
+		}
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return variables, nil
+}
+
+// syncWorkspaceVariables makes the variables of a workspace match the given
+// variables. Existing variables are compared with the old variables, which
+// have the known values of sensitive variables, so only changed variables are
+// updated. The IDs of the resulting variables are returned, also on error.
+func syncWorkspaceVariables(ctx context.Context, client *tfe.Client, workspaceID string, oldVariables, newVariables map[string]workspaceVariable) (map[string]string, error) {
+	existing, err := listWorkspaceVariables(ctx, client, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving variables of workspace %s: %w", workspaceID, err)
+	}
+
+	ids := make(map[string]string)
+	for id, v := range existing {
+		ids[id] = v.ID
+	}
+
+	for id, v := range existing {
+		if _, ok := newVariables[id]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Delete variable %s of workspace: %s", id, workspaceID)
+		err := client.Variables.Delete(ctx, workspaceID, v.ID)
+		if err != nil && err != tfe.ErrResourceNotFound {
+			return ids, fmt.Errorf("Error deleting variable %s of workspace %s: %w", id, workspaceID, err)
+		}
+		delete(ids, id)
+	}
+
+	for id, v := range newVariables {
+		current, ok := existing[id]
+		if !ok {
+			variable, err := createWorkspaceVariable(ctx, client, workspaceID, v)
+			if err != nil {
+				return ids, fmt.Errorf("Error creating variable %s of workspace %s: %w", id, workspaceID, err)
+			}
+			ids[id] = variable.ID
+			continue
+		}
+
+		if old, ok := oldVariables[id]; ok && old == v && !workspaceVariableChanged(current, v) {
+			continue
+		}
+
+		// A sensitive variable cannot be made non-sensitive, so it is
+		// replaced instead.
+		if current.Sensitive && !v.Sensitive {
+			log.Printf("[DEBUG] Replace sensitive variable %s of workspace: %s", id, workspaceID)
+			err := client.Variables.Delete(ctx, workspaceID, current.ID)
+			if err != nil && err != tfe.ErrResourceNotFound {
+				return ids, fmt.Errorf("Error deleting variable %s of workspace %s: %w", id, workspaceID, err)
+			}
+			delete(ids, id)
+
+			variable, err := createWorkspaceVariable(ctx, client, workspaceID, v)
+			if err != nil {
+				return ids, fmt.Errorf("Error creating variable %s of workspace %s: %w", id, workspaceID, err)
+			}
+			ids[id] = variable.ID
+			continue
+		}
+
+		log.Printf("[DEBUG] Update variable %s of workspace: %s", id, workspaceID)
+		_, err := client.Variables.Update(ctx, workspaceID, current.ID, tfe.VariableUpdateOptions{
+			Value:       tfe.String(v.Value),
+			HCL:         tfe.Bool(v.HCL),
+			Sensitive:   tfe.Bool(v.Sensitive),
+			Description: tfe.String(v.Description),
+		})
+		if err != nil {
+			return ids, fmt.Errorf("Error updating variable %s of workspace %s: %w", id, workspaceID, err)
+		}
+	}
+
+	return ids, nil
+}
+
+func createWorkspaceVariable(ctx context.Context, client *tfe.Client, workspaceID string, v workspaceVariable) (*tfe.Variable, error) {
+	log.Printf("[DEBUG] Create variable %s of workspace: %s", workspaceVariableID(v.Category, v.Key), workspaceID)
+	return client.Variables.Create(ctx, workspaceID, tfe.VariableCreateOptions{
+		Key:         tfe.String(v.Key),
+		Value:       tfe.String(v.Value),
+		Category:    tfe.Category(tfe.CategoryType(v.Category)),
+		HCL:         tfe.Bool(v.HCL),
+		Sensitive:   tfe.Bool(v.Sensitive),
+		Description: tfe.String(v.Description),
+	})
+}
+
+// workspaceVariableChanged reports whether a variable of the workspace differs
+// from the configured variable. The values of sensitive variables cannot be
+// compared, as the API does not return them.
+func workspaceVariableChanged(current *tfe.Variable, v workspaceVariable) bool {
+	if current.Description != v.Description || current.HCL != v.HCL || current.Sensitive != v.Sensitive {
+		return true
+	}
+	return !current.Sensitive && current.Value != v.Value
+}
+
+func resourceTFEWorkspaceVariablesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	workspaceID := d.Get("workspace_id").(string)
+
+	variables, err := expandWorkspaceVariables(d.Get("variable").(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	ids, err := syncWorkspaceVariables(ctx, tfeClient, workspaceID, nil, variables)
+	if ids != nil {
+		d.SetId(workspaceID)
+		d.Set("variable_ids", ids)
+	}
+	if err != nil {
+		return err
+	}
+
+	return resourceTFEWorkspaceVariablesRead(ctx, d, meta)
+}
+
+func resourceTFEWorkspaceVariablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	log.Printf("[DEBUG] Read variables of workspace: %s", d.Id())
+	existing, err := listWorkspaceVariables(ctx, tfeClient, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			log.Printf("[DEBUG] Workspace %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving variables of workspace %s: %w", d.Id(), err)
+	}
+
+	// The values of sensitive variables are not returned, so the known values
+	// are kept.
+	known, err := expandWorkspaceVariables(d.Get("variable").(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	var variables []interface{}
+	ids := make(map[string]string)
+	for id, v := range existing {
+		value := v.Value
+		if v.Sensitive {
+			value = known[id].Value
+		}
+
+		variables = append(variables, map[string]interface{}{
+			"key":         v.Key,
+			"value":       value,
+			"category":    string(v.Category),
+			"description": v.Description,
+			"hcl":         v.HCL,
+			"sensitive":   v.Sensitive,
+		})
+		ids[id] = v.ID
+	}
+
+	d.Set("workspace_id", d.Id())
+	d.Set("variable", variables)
+	d.Set("variable_ids", ids)
+
+	return nil
+}
+
+func resourceTFEWorkspaceVariablesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	if d.HasChange("variable") {
+		oldSet, newSet := d.GetChange("variable")
+
+		oldVariables, err := expandWorkspaceVariables(oldSet.(*schema.Set))
+		if err != nil {
+			return err
+		}
+		newVariables, err := expandWorkspaceVariables(newSet.(*schema.Set))
+		if err != nil {
+			return err
+		}
+
+		ids, err := syncWorkspaceVariables(ctx, tfeClient, d.Id(), oldVariables, newVariables)
+		if ids != nil {
+			d.Set("variable_ids", ids)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceTFEWorkspaceVariablesRead(ctx, d, meta)
+}
+
+func resourceTFEWorkspaceVariablesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tfeClient := meta.(ConfiguredClient).Client
+
+	for id, variableID := range d.Get("variable_ids").(map[string]interface{}) {
+		log.Printf("[DEBUG] Delete variable %s of workspace: %s", id, d.Id())
+		err := tfeClient.Variables.Delete(ctx, d.Id(), variableID.(string))
+		if err != nil && err != tfe.ErrResourceNotFound {
+			return fmt.Errorf("Error deleting variable %s of workspace %s: %w", id, d.Id(), err)
+		}
+	}
+
+	return nil
+}
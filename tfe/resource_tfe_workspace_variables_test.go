@@ -0,0 +1,195 @@
+package tfe
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestAccTFEWorkspaceVariables_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceVariablesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceVariables_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_variables.foobar", "variable.#", "2"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_workspace_variables.foobar", "variable_ids.terraform/region"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_workspace_variables.foobar", "variable_ids.env/TOKEN"),
+				),
+			},
+			{
+				Config: testAccTFEWorkspaceVariables_update(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_variables.foobar", "variable.#", "2"),
+					resource.TestCheckNoResourceAttr(
+						"tfe_workspace_variables.foobar", "variable_ids.env/TOKEN"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_workspace_variables.foobar", "variable_ids.terraform/zones"),
+				),
+			},
+		},
+	})
+}
+
+func TestSyncWorkspaceVariables(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/workspaces/ws-123/vars":
+			fmt.Fprint(w, `{"data":[
+				{"id":"var-a","type":"vars","attributes":{"key":"a","value":"1","category":"terraform"}},
+				{"id":"var-b","type":"vars","attributes":{"key":"b","category":"env","sensitive":true}},
+				{"id":"var-c","type":"vars","attributes":{"key":"c","value":"3","category":"terraform"}}
+			],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+		case r.Method == "POST" && r.URL.Path == "/api/v2/workspaces/ws-123/vars":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data":{"id":"var-d","type":"vars","attributes":{"key":"d","value":"4","category":"terraform"}}}`)
+		case r.Method == "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	oldVariables := map[string]workspaceVariable{
+		"terraform/a": {Key: "a", Value: "1", Category: "terraform"},
+		"env/b":       {Key: "b", Value: "secret", Category: "env", Sensitive: true},
+		"terraform/c": {Key: "c", Value: "3", Category: "terraform"},
+	}
+	newVariables := map[string]workspaceVariable{
+		"terraform/a": {Key: "a", Value: "1", Category: "terraform"},
+		"env/b":       {Key: "b", Value: "secret", Category: "env", Sensitive: true},
+		"terraform/d": {Key: "d", Value: "4", Category: "terraform"},
+	}
+
+	ids, err := syncWorkspaceVariables(ctx, client, "ws-123", oldVariables, newVariables)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Unchanged variables are neither updated nor replaced.
+	expectedRequests := []string{
+		"DELETE /api/v2/workspaces/ws-123/vars/var-c",
+		"GET /api/v2/workspaces/ws-123/vars",
+		"POST /api/v2/workspaces/ws-123/vars",
+	}
+	sort.Strings(requests)
+	if fmt.Sprint(requests) != fmt.Sprint(expectedRequests) {
+		t.Fatalf("expected requests %v, got %v", expectedRequests, requests)
+	}
+
+	expectedIDs := map[string]string{
+		"terraform/a": "var-a",
+		"env/b":       "var-b",
+		"terraform/d": "var-d",
+	}
+	if fmt.Sprint(ids) != fmt.Sprint(expectedIDs) {
+		t.Fatalf("expected IDs %v, got %v", expectedIDs, ids)
+	}
+}
+
+func testAccCheckTFEWorkspaceVariablesDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_workspace_variables" {
+			continue
+		}
+
+		variables, err := listWorkspaceVariables(ctx, tfeClient, rs.Primary.ID)
+		if err != nil {
+			if isErrResourceNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if len(variables) > 0 {
+			return fmt.Errorf("Workspace %s still has %d variables", rs.Primary.ID, len(variables))
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEWorkspaceVariables_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace_variables" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+
+  variable {
+    key   = "region"
+    value = "us-east-1"
+  }
+
+  variable {
+    key       = "TOKEN"
+    value     = "secret"
+    category  = "env"
+    sensitive = true
+  }
+}`, rInt)
+}
+
+func testAccTFEWorkspaceVariables_update(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}
+
+resource "tfe_workspace_variables" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+
+  variable {
+    key         = "region"
+    value       = "eu-west-1"
+    description = "The region to deploy to"
+  }
+
+  variable {
+    key   = "zones"
+    value = "[\"a\", \"b\"]"
+    hcl   = true
+  }
+}`, rInt)
+}
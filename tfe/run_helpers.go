@@ -0,0 +1,203 @@
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	runWaitPending = "pending"
+	runWaitDone    = "done"
+)
+
+// Runs in one of these states will not make any more progress.
+var runErrorStatuses = map[tfe.RunStatus]bool{
+	tfe.RunCanceled:                 true,
+	tfe.RunDiscarded:                true,
+	tfe.RunErrored:                  true,
+	tfe.RunStatus("force_canceled"): true,
+}
+
+// uploadConfigurationVersion creates a configuration version for a workspace,
+// uploads the configuration in path and waits until it is processed.
+func uploadConfigurationVersion(ctx context.Context, client *tfe.Client, workspaceID, path string, speculative bool, timeout time.Duration) (*tfe.ConfigurationVersion, error) {
+	log.Printf("[DEBUG] Create configuration version for workspace: %s", workspaceID)
+	cv, err := client.ConfigurationVersions.Create(ctx, workspaceID, tfe.ConfigurationVersionCreateOptions{
+		AutoQueueRuns: tfe.Bool(false),
+		Speculative:   tfe.Bool(speculative),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error creating configuration version for workspace %s: %w", workspaceID, err)
+	}
+
+	log.Printf("[DEBUG] Upload configuration version %s from: %s", cv.ID, path)
+	if err := client.ConfigurationVersions.Upload(ctx, cv.UploadURL, path); err != nil {
+		return nil, fmt.Errorf("Error uploading configuration version %s: %w", cv.ID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{string(tfe.ConfigurationPending)},
+		Target:  []string{string(tfe.ConfigurationUploaded)},
+		Refresh: func() (interface{}, string, error) {
+			cv, err := client.ConfigurationVersions.Read(ctx, cv.ID)
+			if err != nil {
+				return nil, "", err
+			}
+			if cv.Status == tfe.ConfigurationErrored {
+				return nil, "", fmt.Errorf("%s: %s", cv.ErrorMessage, cv.Error)
+			}
+			return cv, string(cv.Status), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return nil, fmt.Errorf("Error waiting for configuration version %s to be uploaded: %w", cv.ID, err)
+	}
+
+	return cv, nil
+}
+
+// runWaitState reports whether waiting for a run is done. A run is done when
+// it finished, or when apply is false and the run is waiting for confirmation.
+func runWaitState(run *tfe.Run, apply bool) (string, error) {
+	if runErrorStatuses[run.Status] {
+		return "", fmt.Errorf("run %s finished with status %s", run.ID, run.Status)
+	}
+
+	switch run.Status {
+	case tfe.RunApplied, tfe.RunPlannedAndFinished:
+		return runWaitDone, nil
+	case tfe.RunPolicySoftFailed:
+		if !apply {
+			return runWaitDone, nil
+		}
+		return "", fmt.Errorf("run %s failed a policy check which must be overridden", run.ID)
+	}
+
+	if !apply && run.Actions != nil && run.Actions.IsConfirmable {
+		return runWaitDone, nil
+	}
+
+	return runWaitPending, nil
+}
+
+// waitForRun waits until a run is done. When apply is true, the run is
+// done once it is applied, and when confirm is true as well it is confirmed
+// as soon as it can be applied.
+func waitForRun(ctx context.Context, client *tfe.Client, runID string, apply, confirm bool, timeout time.Duration) (*tfe.Run, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{runWaitPending},
+		Target:  []string{runWaitDone},
+		Refresh: func() (interface{}, string, error) {
+			run, err := client.Runs.Read(ctx, runID)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if apply && confirm && run.Actions != nil && run.Actions.IsConfirmable {
+				log.Printf("[DEBUG] Apply run: %s", runID)
+				err := client.Runs.Apply(ctx, runID, tfe.RunApplyOptions{
+					Comment: tfe.String("Applied by Terraform"),
+				})
+				if err != nil {
+					return nil, "", fmt.Errorf("Error applying run %s: %w", runID, err)
+				}
+				return run, runWaitPending, nil
+			}
+
+			state, err := runWaitState(run, apply)
+			if err != nil {
+				return nil, "", err
+			}
+			return run, state, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	run, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for run %s: %w", runID, err)
+	}
+
+	return run.(*tfe.Run), nil
+}
+
+// runHTMLURL returns the URL of a run in the UI. It is only known when the
+// workspace of the run is included.
+func runHTMLURL(config ConfiguredClient, run *tfe.Run) string {
+	if run.Workspace == nil || run.Workspace.Name == "" || run.Workspace.Organization == nil {
+		return ""
+	}
+	return config.htmlURL(fmt.Sprintf("/app/%s/workspaces/%s/runs/%s",
+		url.PathEscape(run.Workspace.Organization.Name), url.PathEscape(run.Workspace.Name), run.ID))
+}
+
+// flattenRunOutputs converts the outputs of a workspace to a map of strings.
+// Values which are not strings are JSON encoded, and sensitive outputs are
+// left out as their values are not returned.
+func flattenRunOutputs(outputs []*tfe.StateVersionOutput) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	for _, output := range outputs {
+		if output.Sensitive {
+			continue
+		}
+
+		value, err := flattenOutputValue(output)
+		if err != nil {
+			return nil, err
+		}
+		result[output.Name] = value
+	}
+
+	return result, nil
+}
+
+// flattenOutputValue returns the value of an output as a string, JSON
+// encoding values which are not strings.
+func flattenOutputValue(output *tfe.StateVersionOutput) (string, error) {
+	if s, ok := output.Value.(string); ok {
+		return s, nil
+	}
+
+	value, err := json.Marshal(output.Value)
+	if err != nil {
+		return "", fmt.Errorf("Error encoding output %s: %w", output.Name, err)
+	}
+
+	return string(value), nil
+}
+
+// retryRun calls fn up to attempts times until it succeeds, backing off
+// exponentially from minBackoff to maxBackoff between the attempts.
+func retryRun(attempts int, minBackoff, maxBackoff time.Duration, fn func() error) error {
+	backoff := minBackoff
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+
+		if attempt < attempts {
+			log.Printf("[DEBUG] Attempt %d of %d failed, retrying in %s: %v", attempt, attempts, backoff, err)
+			time.Sleep(backoff)
+
+			backoff *= 2
+			if backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+		}
+	}
+
+	return err
+}
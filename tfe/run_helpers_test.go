@@ -0,0 +1,149 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func TestRunWaitState(t *testing.T) {
+	confirmable := &tfe.RunActions{IsConfirmable: true}
+
+	cases := map[string]struct {
+		run   *tfe.Run
+		apply bool
+		state string
+		err   bool
+	}{
+		"planning": {
+			run:   &tfe.Run{Status: tfe.RunPlanning},
+			state: runWaitPending,
+		},
+		"confirmable without apply": {
+			run:   &tfe.Run{Status: tfe.RunPlanned, Actions: confirmable},
+			state: runWaitDone,
+		},
+		"confirmable with apply": {
+			run:   &tfe.Run{Status: tfe.RunPlanned, Actions: confirmable},
+			apply: true,
+			state: runWaitPending,
+		},
+		"applied": {
+			run:   &tfe.Run{Status: tfe.RunApplied},
+			apply: true,
+			state: runWaitDone,
+		},
+		"planned and finished": {
+			run:   &tfe.Run{Status: tfe.RunPlannedAndFinished},
+			state: runWaitDone,
+		},
+		"policy soft failed without apply": {
+			run:   &tfe.Run{Status: tfe.RunPolicySoftFailed},
+			state: runWaitDone,
+		},
+		"policy soft failed with apply": {
+			run:   &tfe.Run{Status: tfe.RunPolicySoftFailed},
+			apply: true,
+			err:   true,
+		},
+		"errored": {
+			run: &tfe.Run{Status: tfe.RunErrored},
+			err: true,
+		},
+		"discarded": {
+			run:   &tfe.Run{Status: tfe.RunDiscarded},
+			apply: true,
+			err:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		state, err := runWaitState(tc.run, tc.apply)
+		if (err != nil) != tc.err {
+			t.Fatalf("%s: expected error is %t, got %v", name, tc.err, err)
+		}
+		if state != tc.state {
+			t.Fatalf("%s: expected state %q, got %q", name, tc.state, state)
+		}
+	}
+}
+
+func TestFlattenRunOutputs(t *testing.T) {
+	outputs := []*tfe.StateVersionOutput{
+		{Name: "name", Type: "string", Value: "networking"},
+		{Name: "count", Type: "number", Value: float64(3)},
+		{Name: "zones", Type: "array", Value: []interface{}{"a", "b"}},
+		{Name: "password", Type: "string", Sensitive: true},
+	}
+
+	result, err := flattenRunOutputs(outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"name":  "networking",
+		"count": "3",
+		"zones": `["a","b"]`,
+	}
+
+	if len(result) != len(expected) {
+		t.Fatalf("expected %d outputs, got %d: %v", len(expected), len(result), result)
+	}
+	for name, value := range expected {
+		if result[name] != value {
+			t.Fatalf("expected output %s to be %v, got %v", name, value, result[name])
+		}
+	}
+}
+
+func TestRetryRun(t *testing.T) {
+	cases := map[string]struct {
+		attempts int
+		failures int
+		calls    int
+		err      bool
+	}{
+		"succeeds right away": {
+			attempts: 3,
+			calls:    1,
+		},
+		"succeeds after retrying": {
+			attempts: 3,
+			failures: 2,
+			calls:    3,
+		},
+		"fails after all attempts": {
+			attempts: 3,
+			failures: 5,
+			calls:    3,
+			err:      true,
+		},
+		"no retries": {
+			attempts: 1,
+			failures: 1,
+			calls:    1,
+			err:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		calls := 0
+		err := retryRun(tc.attempts, time.Millisecond, 2*time.Millisecond, func() error {
+			calls++
+			if calls <= tc.failures {
+				return fmt.Errorf("run errored")
+			}
+			return nil
+		})
+
+		if (err != nil) != tc.err {
+			t.Fatalf("%s: expected error is %t, got %v", name, tc.err, err)
+		}
+		if calls != tc.calls {
+			t.Fatalf("%s: expected %d calls, got %d", name, tc.calls, calls)
+		}
+	}
+}
@@ -1,13 +1,14 @@
 package tfe
 
 import (
+	"context"
 	"fmt"
 
 	tfe "github.com/hashicorp/go-tfe"
 )
 
 // fetchOrganizationRunTask returns the task in an organization by name
-func fetchOrganizationRunTask(name, organization string, client *tfe.Client) (*tfe.RunTask, error) {
+func fetchOrganizationRunTask(ctx context.Context, name, organization string, client *tfe.Client) (*tfe.RunTask, error) {
 	options := &tfe.RunTaskListOptions{}
 	for {
 		list, err := client.RunTasks.List(ctx, organization, options)
@@ -34,8 +35,8 @@ func fetchOrganizationRunTask(name, organization string, client *tfe.Client) (*t
 }
 
 // fetchWorkspaceRunTask returns the task association in a workspace by name
-func fetchWorkspaceRunTask(name, workspace, organization string, client *tfe.Client) (*tfe.WorkspaceRunTask, error) {
-	task, err := fetchOrganizationRunTask(name, organization, client)
+func fetchWorkspaceRunTask(ctx context.Context, name, workspace, organization string, client *tfe.Client) (*tfe.WorkspaceRunTask, error) {
+	task, err := fetchOrganizationRunTask(ctx, name, organization, client)
 	if err != nil {
 		return nil, fmt.Errorf("Error reading configuration of task %s in organization %s: %w", name, organization, err)
 	}
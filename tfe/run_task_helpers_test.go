@@ -117,7 +117,7 @@ func TestFetchOrganizationRunTask(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			got, err := fetchOrganizationRunTask(test.taskName, test.org, client)
+			got, err := fetchOrganizationRunTask(ctx, test.taskName, test.org, client)
 
 			if (err != nil) != test.err {
 				t.Fatalf("expected error is %t, got %v", test.err, err)
@@ -207,7 +207,7 @@ func TestFetchWorkspaceRunTask(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			got, err := fetchWorkspaceRunTask(test.taskName, test.workspace, test.org, client)
+			got, err := fetchWorkspaceRunTask(ctx, test.taskName, test.workspace, test.org, client)
 
 			if (err != nil) != test.err {
 				t.Fatalf("expected error is %t, got %v", test.err, err)
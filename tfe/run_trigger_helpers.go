@@ -0,0 +1,83 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// createRunTrigger creates a run trigger on a workspace. Run trigger creation
+// is locked while another run trigger of the workspace is created, so it is
+// retried for a minute.
+func createRunTrigger(ctx context.Context, client *tfe.Client, workspaceID, sourceableID string) (*tfe.RunTrigger, error) {
+	options := tfe.RunTriggerCreateOptions{
+		Sourceable: &tfe.Workspace{
+			ID: sourceableID,
+		},
+	}
+
+	var runTrigger *tfe.RunTrigger
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		runTrigger, err = client.RunTriggers.Create(ctx, workspaceID, options)
+		if err == nil {
+			return nil
+		}
+
+		if strings.Contains(err.Error(), "Run Trigger creation locked") {
+			log.Printf("[DEBUG] Run triggers are locked for workspace %s, will retry", workspaceID)
+			return resource.RetryableError(err)
+		}
+
+		return resource.NonRetryableError(err)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error creating run trigger on workspace %s with sourceable %s: %w", workspaceID, sourceableID, err)
+	}
+
+	return runTrigger, nil
+}
+
+// deleteRunTrigger deletes a run trigger, ignoring run triggers which no
+// longer exist.
+func deleteRunTrigger(ctx context.Context, client *tfe.Client, runTriggerID string) error {
+	err := client.RunTriggers.Delete(ctx, runTriggerID)
+	if err != nil && !isErrResourceNotFound(err) {
+		return fmt.Errorf("Error deleting run trigger %s: %w", runTriggerID, err)
+	}
+
+	return nil
+}
+
+// listRunTriggers returns the inbound or outbound run triggers of a
+// workspace.
+func listRunTriggers(ctx context.Context, client *tfe.Client, workspaceID string, filter tfe.RunTriggerFilterOp) ([]*tfe.RunTrigger, error) {
+	var runTriggers []*tfe.RunTrigger
+
+	options := &tfe.RunTriggerListOptions{
+		RunTriggerType: filter,
+	}
+	for {
+		l, err := client.RunTriggers.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		runTriggers = append(runTriggers, l.Items...)
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return runTriggers, nil
+}
@@ -0,0 +1,41 @@
+package tfe
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// syncIDs makes the IDs of a relationship match the configured IDs, given
+// the existing IDs. The missing IDs are added before the other existing IDs
+// are removed, so the configured IDs are never missing in between. add and
+// remove are only called when there are IDs to add or remove.
+func syncIDs(existingIDs []string, configuredIDs *schema.Set, add, remove func(ids []string) error) error {
+	existing := make(map[string]bool, len(existingIDs))
+	var removeIDs []string
+	for _, id := range existingIDs {
+		existing[id] = true
+		if !configuredIDs.Contains(id) {
+			removeIDs = append(removeIDs, id)
+		}
+	}
+
+	var addIDs []string
+	for _, id := range configuredIDs.List() {
+		if !existing[id.(string)] {
+			addIDs = append(addIDs, id.(string))
+		}
+	}
+
+	if len(addIDs) > 0 {
+		if err := add(addIDs); err != nil {
+			return err
+		}
+	}
+
+	if len(removeIDs) > 0 {
+		if err := remove(removeIDs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package tfe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSyncIDs(t *testing.T) {
+	var calls []string
+	add := func(ids []string) error {
+		calls = append(calls, fmt.Sprintf("add %v", ids))
+		return nil
+	}
+	remove := func(ids []string) error {
+		calls = append(calls, fmt.Sprintf("remove %v", ids))
+		return nil
+	}
+
+	configured := schema.NewSet(schema.HashString, []interface{}{"keep", "new"})
+	if err := syncIDs([]string{"keep", "old"}, configured, add, remove); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "[add [new] remove [old]]"; fmt.Sprint(calls) != want {
+		t.Fatalf("expected %s, got %v", want, calls)
+	}
+
+	// Nothing is sent when the IDs already match.
+	calls = nil
+	if err := syncIDs([]string{"keep", "new"}, configured, add, remove); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(calls) != 0 {
+		t.Fatalf("expected no calls, got %v", calls)
+	}
+
+	// IDs are not removed when adding the missing IDs fails.
+	calls = nil
+	failingAdd := func(ids []string) error { return errors.New("add failed") }
+	if err := syncIDs([]string{"old"}, configured, failingAdd, remove); err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(calls) != 0 {
+		t.Fatalf("expected no IDs to be removed, got %v", calls)
+	}
+}
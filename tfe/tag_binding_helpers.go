@@ -0,0 +1,250 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// tagBinding is a key/value tag of a workspace or project, which go-tfe does
+// not know about yet.
+type tagBinding struct {
+	ID    string `jsonapi:"primary,tag-bindings"`
+	Key   string `jsonapi:"attr,key"`
+	Value string `jsonapi:"attr,value,omitempty"`
+}
+
+// tagBindingsUpdateOptions replaces all tag bindings of a workspace or
+// project. The bindings are sent as attributes of the relationship, which
+// jsonapi cannot marshal, so the payload is written as plain JSON.
+type tagBindingsUpdateOptions struct {
+	Data tagBindingsUpdateData `json:"data"`
+}
+
+type tagBindingsUpdateData struct {
+	Type          string                         `json:"type"`
+	Relationships tagBindingsUpdateRelationships `json:"relationships"`
+}
+
+type tagBindingsUpdateRelationships struct {
+	TagBindings tagBindingsUpdateRelationship `json:"tag-bindings"`
+}
+
+type tagBindingsUpdateRelationship struct {
+	Data []tagBindingAttributes `json:"data"`
+}
+
+type tagBindingAttributes struct {
+	Type       string            `json:"type"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// listTagBindings returns the tag bindings set directly on a workspace or
+// project, keyed by the key of the tag. kind is either workspaces or projects.
+func listTagBindings(ctx context.Context, client *tfe.Client, kind, id string) (map[string]string, error) {
+	u := fmt.Sprintf("%s/%s/tag-bindings", kind, url.QueryEscape(id))
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var list struct {
+		*tfe.Pagination
+		Items []*tagBinding
+	}
+	if err := req.Do(ctx, &list); err != nil {
+		return nil, err
+	}
+
+	bindings := make(map[string]string)
+	for _, b := range list.Items {
+		bindings[b.Key] = b.Value
+	}
+
+	return bindings, nil
+}
+
+// addTagBindings adds tag bindings to a workspace or project, or updates the
+// values of existing keys. Other tag bindings are kept. kind is either
+// workspaces or projects.
+func addTagBindings(ctx context.Context, client *tfe.Client, kind, id string, bindings map[string]string) error {
+	if len(bindings) == 0 {
+		return nil
+	}
+
+	var keys []string
+	for key := range bindings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var options []*tagBinding
+	for _, key := range keys {
+		options = append(options, &tagBinding{Key: key, Value: bindings[key]})
+	}
+
+	u := fmt.Sprintf("%s/%s/tag-bindings", kind, url.QueryEscape(id))
+	req, err := client.NewRequest("PATCH", u, options)
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
+
+// replaceTagBindings replaces all tag bindings set directly on a workspace or
+// project. kind is either workspaces or projects.
+func replaceTagBindings(ctx context.Context, client *tfe.Client, kind, id string, bindings map[string]string) error {
+	var keys []string
+	for key := range bindings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	data := []tagBindingAttributes{}
+	for _, key := range keys {
+		attributes := map[string]string{"key": key}
+		if bindings[key] != "" {
+			attributes["value"] = bindings[key]
+		}
+		data = append(data, tagBindingAttributes{Type: "tag-bindings", Attributes: attributes})
+	}
+
+	options := &tagBindingsUpdateOptions{
+		Data: tagBindingsUpdateData{
+			Type: kind,
+			Relationships: tagBindingsUpdateRelationships{
+				TagBindings: tagBindingsUpdateRelationship{Data: data},
+			},
+		},
+	}
+
+	u := fmt.Sprintf("%s/%s", kind, url.QueryEscape(id))
+	req, err := client.NewRequest("PATCH", u, options)
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
+
+// updateTagBindings sets the new tag bindings on a workspace or project, and
+// removes the old keys which are no longer configured. Removing a key
+// replaces all tag bindings, so the unmanaged ones are read first and kept.
+// kind is either workspaces or projects.
+func updateTagBindings(ctx context.Context, client *tfe.Client, kind, id string, oldTags, newTags map[string]interface{}) error {
+	name := strings.TrimSuffix(kind, "s")
+
+	var removed []string
+	for key := range oldTags {
+		if _, ok := newTags[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	if len(removed) == 0 {
+		bindings := make(map[string]string)
+		for key, value := range newTags {
+			bindings[key] = value.(string)
+		}
+
+		log.Printf("[DEBUG] Add tag bindings to %s: %s", name, id)
+		if err := addTagBindings(ctx, client, kind, id, bindings); err != nil {
+			return fmt.Errorf("Error adding tag bindings to %s %s: %w", name, id, err)
+		}
+		return nil
+	}
+
+	bindings, err := listTagBindings(ctx, client, kind, id)
+	if err != nil {
+		return fmt.Errorf("Error reading tag bindings of %s %s: %w", name, id, err)
+	}
+
+	for _, key := range removed {
+		delete(bindings, key)
+	}
+	for key, value := range newTags {
+		bindings[key] = value.(string)
+	}
+
+	log.Printf("[DEBUG] Update tag bindings of %s: %s", name, id)
+	if err := replaceTagBindings(ctx, client, kind, id, bindings); err != nil {
+		return fmt.Errorf("Error updating tag bindings of %s %s: %w", name, id, err)
+	}
+
+	return nil
+}
+
+// expandTagBindings returns the configured tags of a workspace or project.
+func expandTagBindings(d *schema.ResourceData) map[string]string {
+	tags := make(map[string]string)
+	for key, value := range d.Get("tags").(map[string]interface{}) {
+		tags[key] = value.(string)
+	}
+	return tags
+}
+
+// readWorkspaceTagBindings sets the tags and effective_tags of a workspace.
+// Tags inherited from the project are only part of effective_tags, so they
+// are not removed from the workspace. Only the keys which are already managed
+// are read into tags, so tag bindings added elsewhere, like by
+// tfe_workspace_tags, do not show up as changes. Servers without tag bindings
+// are ignored.
+func readWorkspaceTagBindings(ctx context.Context, client *tfe.Client, d *schema.ResourceData) error {
+	bindings, err := fetchEffectiveTagBindings(ctx, client, d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Error reading tag bindings of workspace %s: %w", d.Id(), err)
+	}
+
+	managed := d.Get("tags").(map[string]interface{})
+	tags := make(map[string]interface{})
+	effectiveTags := make(map[string]interface{})
+	for _, binding := range bindings.Data {
+		if _, ok := managed[binding.Attributes.Key]; ok && binding.Links.InheritedFrom == "" {
+			tags[binding.Attributes.Key] = binding.Attributes.Value
+		}
+		effectiveTags[binding.Attributes.Key] = binding.Attributes.Value
+	}
+
+	d.Set("tags", tags)
+	d.Set("effective_tags", effectiveTags)
+
+	return nil
+}
+
+// readProjectTagBindings sets the tags of a project. Only the keys which are
+// already managed are read, so tag bindings added elsewhere do not show up as
+// changes. Servers without tag bindings are ignored.
+func readProjectTagBindings(ctx context.Context, client *tfe.Client, d *schema.ResourceData) error {
+	managed := d.Get("tags").(map[string]interface{})
+	if len(managed) == 0 {
+		return nil
+	}
+
+	bindings, err := listTagBindings(ctx, client, "projects", d.Id())
+	if err != nil {
+		if isErrResourceNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Error reading tag bindings of project %s: %w", d.Id(), err)
+	}
+
+	tags := make(map[string]interface{})
+	for key, value := range bindings {
+		if _, ok := managed[key]; ok {
+			tags[key] = value
+		}
+	}
+	d.Set("tags", tags)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package tfe
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestReadWorkspaceTagBindings(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-123/effective-tag-bindings":
+			fmt.Fprint(w, `{"data":[
+				{"id":"tb-1","type":"effective-tag-bindings","attributes":{"key":"env","value":"prod"},
+				 "links":{"inherited-from":"/api/v2/projects/prj-123"}},
+				{"id":"tb-2","type":"effective-tag-bindings","attributes":{"key":"owner","value":"platform"}},
+				{"id":"tb-3","type":"effective-tag-bindings","attributes":{"key":"tier","value":"1"}}
+			]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	d := resourceTFEWorkspace().TestResourceData()
+	d.SetId("ws-123")
+	d.Set("tags", map[string]interface{}{"owner": "someone"})
+
+	if err := readWorkspaceTagBindings(ctx, client, d); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Inherited tags and tags added elsewhere are not managed by the
+	// workspace.
+	tags := d.Get("tags").(map[string]interface{})
+	if len(tags) != 1 || tags["owner"] != "platform" {
+		t.Fatalf("expected only the owner tag, got %v", tags)
+	}
+
+	effectiveTags := d.Get("effective_tags").(map[string]interface{})
+	if len(effectiveTags) != 3 || effectiveTags["env"] != "prod" {
+		t.Fatalf("expected the inherited env tag in the effective tags, got %v", effectiveTags)
+	}
+
+	// The tags are not read when they are not managed.
+	d = resourceTFEWorkspace().TestResourceData()
+	d.SetId("ws-123")
+
+	if err := readWorkspaceTagBindings(ctx, client, d); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tags := d.Get("tags").(map[string]interface{}); len(tags) != 0 {
+		t.Fatalf("expected no tags, got %v", tags)
+	}
+}
+
+func TestUpdateTagBindings(t *testing.T) {
+	var patched string
+
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/workspaces/ws-123/tag-bindings":
+			fmt.Fprint(w, `{"data":[
+				{"id":"tb-1","type":"tag-bindings","attributes":{"key":"env","value":"prod"}},
+				{"id":"tb-2","type":"tag-bindings","attributes":{"key":"owner","value":"platform"}}
+			]}`)
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/workspaces/ws-123":
+			body, _ := io.ReadAll(r.Body)
+			patched = strings.TrimSpace(string(body))
+			fmt.Fprint(w, `{"data":{"id":"ws-123","type":"workspaces"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	// Removing env keeps the unmanaged owner binding.
+	err := updateTagBindings(ctx, client, "workspaces", "ws-123",
+		map[string]interface{}{"env": "prod"},
+		map[string]interface{}{"tier": "1"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `{"data":{"type":"workspaces","relationships":{"tag-bindings":{"data":[` +
+		`{"type":"tag-bindings","attributes":{"key":"owner","value":"platform"}},` +
+		`{"type":"tag-bindings","attributes":{"key":"tier","value":"1"}}]}}}}`
+	if patched != expected {
+		t.Fatalf("expected body %s, got %s", expected, patched)
+	}
+}
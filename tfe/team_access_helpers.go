@@ -0,0 +1,131 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// teamAccessPolicyOverridesOptions updates the policy override permission of
+// a team access, which is not exposed by tfe.TeamAccessUpdateOptions.
+type teamAccessPolicyOverridesOptions struct {
+	Type            string `jsonapi:"primary,team-workspaces"`
+	PolicyOverrides *bool  `jsonapi:"attr,policy-overrides"`
+}
+
+// teamAccessAttributes holds the raw attributes of a team access, so that
+// permissions which are missing on older releases of Terraform Enterprise
+// can be detected.
+type teamAccessAttributes struct {
+	Data struct {
+		Attributes map[string]interface{} `json:"attributes"`
+	} `json:"data"`
+}
+
+// readTeamAccessPolicyOverrides returns the policy override permission of a
+// team access, and whether the permission is supported at all.
+func readTeamAccessPolicyOverrides(ctx context.Context, client *tfe.Client, id string) (policyOverrides, supported bool, err error) {
+	u := fmt.Sprintf("team-workspaces/%s", url.QueryEscape(id))
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return false, false, err
+	}
+
+	tmAccess := &teamAccessAttributes{}
+	if err := req.DoJSON(ctx, tmAccess); err != nil {
+		return false, false, err
+	}
+
+	v, ok := tmAccess.Data.Attributes["policy-overrides"]
+	if !ok {
+		return false, false, nil
+	}
+
+	policyOverrides, _ = v.(bool)
+	return policyOverrides, true, nil
+}
+
+// updateTeamAccessPolicyOverrides sets the policy override permission of a
+// team access, failing when the permission is not supported.
+func updateTeamAccessPolicyOverrides(ctx context.Context, client *tfe.Client, id string, policyOverrides bool) error {
+	_, supported, err := readTeamAccessPolicyOverrides(ctx, client, id)
+	if err != nil {
+		return fmt.Errorf("Error reading policy override permission of team access %s: %w", id, err)
+	}
+	if !supported {
+		return fmt.Errorf("policy_overrides is not supported by this version of Terraform Enterprise")
+	}
+
+	u := fmt.Sprintf("team-workspaces/%s", url.QueryEscape(id))
+	req, err := client.NewRequest("PATCH", u, &teamAccessPolicyOverridesOptions{
+		PolicyOverrides: tfe.Bool(policyOverrides),
+	})
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
+
+// listProjectWorkspaceIDs returns the IDs of all workspaces in a project.
+func listProjectWorkspaceIDs(ctx context.Context, client *tfe.Client, projectID string) ([]string, error) {
+	project, err := client.Projects.Read(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading project %s: %w", projectID, err)
+	}
+
+	options := &tfe.WorkspaceListOptions{ProjectID: projectID}
+	options.PageSize = 100
+
+	var ids []string
+	for {
+		wl, err := client.Workspaces.List(ctx, project.Organization.Name, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving workspaces of project %s: %w", projectID, err)
+		}
+
+		for _, ws := range wl.Items {
+			ids = append(ids, ws.ID)
+		}
+
+		// Exit the loop when we've seen all pages.
+		if wl.CurrentPage >= wl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = wl.NextPage
+	}
+
+	return ids, nil
+}
+
+// findTeamAccess returns the access of a team to a workspace, or nil if the
+// team has no access.
+func findTeamAccess(ctx context.Context, client *tfe.Client, teamID, workspaceID string) (*tfe.TeamAccess, error) {
+	options := &tfe.TeamAccessListOptions{WorkspaceID: workspaceID}
+	for {
+		tal, err := client.TeamAccess.List(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving team access of workspace %s: %w", workspaceID, err)
+		}
+
+		for _, ta := range tal.Items {
+			if ta.Team != nil && ta.Team.ID == teamID {
+				return ta, nil
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if tal.CurrentPage >= tal.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = tal.NextPage
+	}
+
+	return nil, nil
+}
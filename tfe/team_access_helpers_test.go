@@ -0,0 +1,108 @@
+package tfe
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestReadTeamAccessPolicyOverrides(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/team-workspaces/tws-granted":
+			fmt.Fprint(w, `{"data":{"id":"tws-granted","type":"team-workspaces","attributes":{"access":"custom","policy-overrides":true}}}`)
+		case "/api/v2/team-workspaces/tws-unsupported":
+			fmt.Fprint(w, `{"data":{"id":"tws-unsupported","type":"team-workspaces","attributes":{"access":"write"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	tests := map[string]struct {
+		id              string
+		policyOverrides bool
+		supported       bool
+		err             bool
+	}{
+		"granted": {
+			id:              "tws-granted",
+			policyOverrides: true,
+			supported:       true,
+		},
+		"unsupported": {
+			id: "tws-unsupported",
+		},
+		"non existing team access": {
+			id:  "tws-missing",
+			err: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			policyOverrides, supported, err := readTeamAccessPolicyOverrides(ctx, client, test.id)
+			if (err != nil) != test.err {
+				t.Fatalf("expected error is %t, got %v", test.err, err)
+			}
+			if policyOverrides != test.policyOverrides {
+				t.Fatalf("expected policy overrides %t, got %t", test.policyOverrides, policyOverrides)
+			}
+			if supported != test.supported {
+				t.Fatalf("expected supported %t, got %t", test.supported, supported)
+			}
+		})
+	}
+}
+
+func TestFindTeamAccess(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v2/team-workspaces" && r.URL.Query().Get("page[number]") == "2":
+			fmt.Fprint(w, `{"data":[{"id":"tws-2","type":"team-workspaces","attributes":{"access":"write"},"relationships":{"team":{"data":{"id":"team-2","type":"teams"}}}}],"meta":{"pagination":{"current-page":2,"total-pages":2}}}`)
+		case r.URL.Path == "/api/v2/team-workspaces":
+			fmt.Fprint(w, `{"data":[{"id":"tws-1","type":"team-workspaces","attributes":{"access":"read"},"relationships":{"team":{"data":{"id":"team-1","type":"teams"}}}}],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	tests := map[string]struct {
+		teamID string
+		id     string
+	}{
+		"on the first page": {
+			teamID: "team-1",
+			id:     "tws-1",
+		},
+		"on the second page": {
+			teamID: "team-2",
+			id:     "tws-2",
+		},
+		"without access": {
+			teamID: "team-3",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ta, err := findTeamAccess(ctx, client, test.teamID, "ws-123")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			id := ""
+			if ta != nil {
+				id = ta.ID
+			}
+			if id != test.id {
+				t.Fatalf("expected team access %q, got %q", test.id, id)
+			}
+		})
+	}
+}
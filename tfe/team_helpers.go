@@ -0,0 +1,78 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// teamOrganizationAccess is the organization access of a team, including the
+// permissions which go-tfe does not know yet.
+type teamOrganizationAccess struct {
+	ManagePolicies        bool `jsonapi:"attr,manage-policies"`
+	ManagePolicyOverrides bool `jsonapi:"attr,manage-policy-overrides"`
+	ManageWorkspaces      bool `jsonapi:"attr,manage-workspaces"`
+	ManageVCSSettings     bool `jsonapi:"attr,manage-vcs-settings"`
+	ManageProviders       bool `jsonapi:"attr,manage-providers"`
+	ManageModules         bool `jsonapi:"attr,manage-modules"`
+	ManageRunTasks        bool `jsonapi:"attr,manage-run-tasks"`
+	ManageProjects        bool `jsonapi:"attr,manage-projects"`
+	ReadWorkspaces        bool `jsonapi:"attr,read-workspaces"`
+	ReadProjects          bool `jsonapi:"attr,read-projects"`
+	ManageMembership      bool `jsonapi:"attr,manage-membership"`
+	ManageTeams           bool `jsonapi:"attr,manage-teams"`
+	ManageAgentPools      bool `jsonapi:"attr,manage-agent-pools"`
+}
+
+// teamDetails is a team read with the settings which go-tfe does not know
+// yet. AllowMemberTokenManagement is nil when the server does not support it.
+type teamDetails struct {
+	ID                         string                  `jsonapi:"primary,teams"`
+	Name                       string                  `jsonapi:"attr,name"`
+	Visibility                 string                  `jsonapi:"attr,visibility"`
+	SSOTeamID                  string                  `jsonapi:"attr,sso-team-id"`
+	AllowMemberTokenManagement *bool                   `jsonapi:"attr,allow-member-token-management"`
+	OrganizationAccess         *teamOrganizationAccess `jsonapi:"attr,organization-access"`
+}
+
+// teamOrganizationAccessOptions updates the organization access permissions
+// of a team which go-tfe does not know yet.
+type teamOrganizationAccessOptions struct {
+	ManageTeams      *bool `json:"manage-teams,omitempty"`
+	ManageAgentPools *bool `json:"manage-agent-pools,omitempty"`
+}
+
+// teamDetailsUpdateOptions updates the settings of a team which go-tfe does
+// not know yet.
+type teamDetailsUpdateOptions struct {
+	Type                       string                         `jsonapi:"primary,teams"`
+	AllowMemberTokenManagement *bool                          `jsonapi:"attr,allow-member-token-management,omitempty"`
+	OrganizationAccess         *teamOrganizationAccessOptions `jsonapi:"attr,organization-access,omitempty"`
+}
+
+func readTeamDetails(ctx context.Context, client *tfe.Client, teamID string) (*teamDetails, error) {
+	u := fmt.Sprintf("teams/%s", url.QueryEscape(teamID))
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	team := &teamDetails{}
+	if err := req.Do(ctx, team); err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+func updateTeamDetails(ctx context.Context, client *tfe.Client, teamID string, options *teamDetailsUpdateOptions) error {
+	u := fmt.Sprintf("teams/%s", url.QueryEscape(teamID))
+	req, err := client.NewRequest("PATCH", u, options)
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
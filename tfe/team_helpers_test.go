@@ -0,0 +1,77 @@
+package tfe
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestTeamDetails(t *testing.T) {
+	var body string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v2/teams/team-123":
+			fmt.Fprint(w, `{"data":{"id":"team-123","type":"teams","attributes":{"name":"platform","visibility":"secret","allow-member-token-management":false,"organization-access":{"read-workspaces":true,"manage-teams":true,"manage-agent-pools":false}}}}`)
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/teams/team-123":
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+			fmt.Fprint(w, `{"data":{"id":"team-123","type":"teams"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	team, err := readTeamDetails(ctx, client, "team-123")
+	if err != nil {
+		t.Fatalf("unexpected error reading: %v", err)
+	}
+	if team.Name != "platform" || team.OrganizationAccess == nil || team.AllowMemberTokenManagement == nil || *team.AllowMemberTokenManagement {
+		t.Fatalf("wrong team: %#v", team)
+	}
+	if !team.OrganizationAccess.ReadWorkspaces || !team.OrganizationAccess.ManageTeams || team.OrganizationAccess.ManageAgentPools {
+		t.Fatalf("wrong organization access: %#v", team.OrganizationAccess)
+	}
+
+	err = updateTeamDetails(ctx, client, "team-123", &teamDetailsUpdateOptions{
+		OrganizationAccess: &teamOrganizationAccessOptions{
+			ManageAgentPools: tfe.Bool(true),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error updating: %v", err)
+	}
+
+	// Only the given permissions are sent, so the others are left as they are.
+	if !strings.Contains(body, `"organization-access":{"manage-agent-pools":true}`) {
+		t.Fatalf("unexpected request body: %s", body)
+	}
+
+	// New teams only send the settings which differ from the server defaults.
+	d := resourceTFETeam().TestResourceData()
+	d.SetId("team-123")
+	d.MarkNewResource()
+	d.Set("allow_member_token_management", true)
+
+	body = ""
+	if err := updateTeamDetailsFromConfig(ctx, d, client); err != nil {
+		t.Fatalf("unexpected error updating from config: %v", err)
+	}
+	if body != "" {
+		t.Fatalf("expected no request for the default settings, got: %s", body)
+	}
+
+	d.Set("allow_member_token_management", false)
+	if err := updateTeamDetailsFromConfig(ctx, d, client); err != nil {
+		t.Fatalf("unexpected error updating from config: %v", err)
+	}
+	if !strings.Contains(body, `"allow-member-token-management":false`) {
+		t.Fatalf("unexpected request body: %s", body)
+	}
+}
@@ -0,0 +1,81 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// teamNotificationConfiguration is a notification configuration which is
+// subscribed to a team. go-tfe only models notification configurations of
+// workspaces, and fails to read the team relationship.
+type teamNotificationConfiguration struct {
+	ID                string                  `jsonapi:"primary,notification-configurations"`
+	DestinationType   string                  `jsonapi:"attr,destination-type"`
+	Enabled           bool                    `jsonapi:"attr,enabled"`
+	Name              string                  `jsonapi:"attr,name"`
+	Triggers          []string                `jsonapi:"attr,triggers"`
+	URL               string                  `jsonapi:"attr,url"`
+	EmailAddresses    []string                `jsonapi:"attr,email-addresses"`
+	DeliveryResponses []*tfe.DeliveryResponse `jsonapi:"attr,delivery-responses"`
+
+	EmailUsers   []*tfe.User `jsonapi:"relation,users"`
+	Subscribable *tfe.Team   `jsonapi:"relation,subscribable"`
+}
+
+// teamNotificationConfigurationOptions creates or updates a team
+// notification configuration. The triggers are always sent, so that all
+// triggers can be removed.
+type teamNotificationConfigurationOptions struct {
+	Type            string      `jsonapi:"primary,notification-configurations"`
+	DestinationType *string     `jsonapi:"attr,destination-type,omitempty"`
+	Enabled         *bool       `jsonapi:"attr,enabled,omitempty"`
+	Name            *string     `jsonapi:"attr,name,omitempty"`
+	Token           *string     `jsonapi:"attr,token,omitempty"`
+	Triggers        []string    `jsonapi:"attr,triggers"`
+	URL             *string     `jsonapi:"attr,url,omitempty"`
+	EmailAddresses  []string    `jsonapi:"attr,email-addresses,omitempty"`
+	EmailUsers      []*tfe.User `jsonapi:"relation,users,omitempty"`
+}
+
+func createTeamNotificationConfiguration(ctx context.Context, client *tfe.Client, teamID string, options *teamNotificationConfigurationOptions) (*teamNotificationConfiguration, error) {
+	u := fmt.Sprintf("teams/%s/notification-configurations", url.QueryEscape(teamID))
+	req, err := client.NewRequest("POST", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	nc := &teamNotificationConfiguration{}
+	if err := req.Do(ctx, nc); err != nil {
+		return nil, err
+	}
+
+	return nc, nil
+}
+
+func readTeamNotificationConfiguration(ctx context.Context, client *tfe.Client, id string) (*teamNotificationConfiguration, error) {
+	u := fmt.Sprintf("notification-configurations/%s", url.QueryEscape(id))
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	nc := &teamNotificationConfiguration{}
+	if err := req.Do(ctx, nc); err != nil {
+		return nil, err
+	}
+
+	return nc, nil
+}
+
+func updateTeamNotificationConfiguration(ctx context.Context, client *tfe.Client, id string, options *teamNotificationConfigurationOptions) error {
+	u := fmt.Sprintf("notification-configurations/%s", url.QueryEscape(id))
+	req, err := client.NewRequest("PATCH", u, options)
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
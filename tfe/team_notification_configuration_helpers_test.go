@@ -0,0 +1,72 @@
+package tfe
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestTeamNotificationConfiguration(t *testing.T) {
+	var body string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := `{"data":{"id":"nc-123","type":"notification-configurations","attributes":{"name":"changes","destination-type":"slack","enabled":true,"url":"https://example.com","triggers":["change_request:created"]},"relationships":{"subscribable":{"data":{"id":"team-123","type":"teams"}},"users":{"data":[]}}}}`
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v2/teams/team-123/notification-configurations":
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, response)
+		case r.Method == "PATCH" && r.URL.Path == "/api/v2/notification-configurations/nc-123":
+			b, _ := io.ReadAll(r.Body)
+			body = string(b)
+			fmt.Fprint(w, response)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/notification-configurations/nc-123":
+			fmt.Fprint(w, response)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	nc, err := createTeamNotificationConfiguration(ctx, client, "team-123", &teamNotificationConfigurationOptions{
+		DestinationType: tfe.String("slack"),
+		Name:            tfe.String("changes"),
+		URL:             tfe.String("https://example.com"),
+		Triggers:        []string{"change_request:created"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating: %v", err)
+	}
+	if nc.ID != "nc-123" || !strings.Contains(body, `"triggers":["change_request:created"]`) {
+		t.Fatalf("wrong result creating: %#v, body: %s", nc, body)
+	}
+
+	nc, err = readTeamNotificationConfiguration(ctx, client, "nc-123")
+	if err != nil {
+		t.Fatalf("unexpected error reading: %v", err)
+	}
+	if nc.Subscribable == nil || nc.Subscribable.ID != "team-123" || nc.DestinationType != "slack" || !nc.Enabled {
+		t.Fatalf("wrong result reading: %#v", nc)
+	}
+
+	// All triggers can be removed.
+	err = updateTeamNotificationConfiguration(ctx, client, "nc-123", &teamNotificationConfigurationOptions{
+		Triggers: []string{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error updating: %v", err)
+	}
+	if !strings.Contains(body, `"triggers":[]`) {
+		t.Fatalf("expected the triggers to be removed, body: %s", body)
+	}
+
+	if _, err := readTeamNotificationConfiguration(ctx, client, "nc-missing"); !isErrResourceNotFound(err) {
+		t.Fatalf("expected a not found error, got: %v", err)
+	}
+}
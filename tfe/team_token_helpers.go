@@ -0,0 +1,108 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// teamAuthenticationToken is one of the multiple tokens a team can have where
+// supported. Unlike the single team token of go-tfe, these tokens are
+// addressed by their own ID and have a description.
+type teamAuthenticationToken struct {
+	ID          string    `jsonapi:"primary,authentication-tokens"`
+	CreatedAt   time.Time `jsonapi:"attr,created-at,iso8601"`
+	Description string    `jsonapi:"attr,description"`
+	Token       string    `jsonapi:"attr,token"`
+	ExpiredAt   time.Time `jsonapi:"attr,expired-at,iso8601"`
+}
+
+type teamAuthenticationTokenCreateOptions struct {
+	Type        string     `jsonapi:"primary,authentication-tokens"`
+	Description *string    `jsonapi:"attr,description,omitempty"`
+	ExpiredAt   *time.Time `jsonapi:"attr,expired-at,iso8601,omitempty"`
+}
+
+// isTeamAuthenticationTokenID reports whether a team token resource ID is
+// the ID of one of multiple team tokens, rather than the ID of the team of
+// the single team token.
+func isTeamAuthenticationTokenID(id string) bool {
+	return strings.HasPrefix(id, "at-")
+}
+
+func createTeamAuthenticationToken(ctx context.Context, client *tfe.Client, teamID string, options *teamAuthenticationTokenCreateOptions) (*teamAuthenticationToken, error) {
+	u := fmt.Sprintf("teams/%s/authentication-tokens", url.QueryEscape(teamID))
+	req, err := client.NewRequest("POST", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &teamAuthenticationToken{}
+	if err := req.Do(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func readTeamAuthenticationToken(ctx context.Context, client *tfe.Client, tokenID string) (*teamTokenDocument, error) {
+	return readTeamToken(ctx, client, fmt.Sprintf("authentication-tokens/%s", url.QueryEscape(tokenID)))
+}
+
+// teamTokenDocument holds a team token as returned by the API. The token can
+// be created by either a user or a team, which jsonapi cannot decode, so it is
+// decoded as plain JSON.
+type teamTokenDocument struct {
+	Data struct {
+		ID         string `json:"id"`
+		Attributes struct {
+			Description string `json:"description"`
+		} `json:"attributes"`
+		Relationships struct {
+			CreatedBy struct {
+				Data *struct {
+					ID   string `json:"id"`
+					Type string `json:"type"`
+				} `json:"data"`
+			} `json:"created-by"`
+		} `json:"relationships"`
+	} `json:"data"`
+}
+
+// readTeamToken reads the single token of a team, or one of its multiple
+// tokens, together with its creator.
+func readTeamToken(ctx context.Context, client *tfe.Client, path string) (*teamTokenDocument, error) {
+	req, err := client.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &teamTokenDocument{}
+	if err := req.DoJSON(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// createdBy returns the ID of the user or team which created the token.
+func (t *teamTokenDocument) createdBy() string {
+	if t.Data.Relationships.CreatedBy.Data == nil {
+		return ""
+	}
+	return t.Data.Relationships.CreatedBy.Data.ID
+}
+
+func deleteTeamAuthenticationToken(ctx context.Context, client *tfe.Client, tokenID string) error {
+	u := fmt.Sprintf("authentication-tokens/%s", url.QueryEscape(tokenID))
+	req, err := client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
@@ -0,0 +1,5 @@
+resource "null_resource" "test" {}
+
+output "name" {
+  value = "config-version"
+}
@@ -1,18 +1,34 @@
 package tfe
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log"
 
 	tfe "github.com/hashicorp/go-tfe"
+	version "github.com/hashicorp/go-version"
 )
 
+var errTerraformVersionNotFound = errors.New("terraform version not found")
+
 // fetchTerraformVersionID returns a Terraform Version ID for the given Terraform version number
-func fetchTerraformVersionID(version string, client *tfe.Client) (string, error) {
+func fetchTerraformVersionID(ctx context.Context, version string, client *tfe.Client) (string, error) {
+	v, err := fetchTerraformVersion(ctx, version, client)
+	if err != nil {
+		return "", err
+	}
+
+	return v.ID, nil
+}
+
+// fetchTerraformVersion returns the Terraform Version for the given Terraform version number
+func fetchTerraformVersion(ctx context.Context, version string, client *tfe.Client) (*tfe.AdminTerraformVersion, error) {
 	versions, err := client.Admin.TerraformVersions.List(ctx, &tfe.AdminTerraformVersionsListOptions{
 		Filter: version,
 	})
 	if err != nil {
-		return "", fmt.Errorf("error reading Terraform versions: %w", err)
+		return nil, fmt.Errorf("error reading Terraform versions: %w", err)
 	}
 
 	// filter[version] returns 1 item or 0, if however
@@ -21,15 +37,15 @@ func fetchTerraformVersionID(version string, client *tfe.Client) (string, error)
 	// and so we'll use a fallback search mechanism
 	switch len(versions.Items) {
 	case 0:
-		return "", fmt.Errorf("terraform version not found")
+		return nil, errTerraformVersionNotFound
 	case 1:
-		return versions.Items[0].ID, nil
+		return versions.Items[0], nil
 	default:
 		options := &tfe.AdminTerraformVersionsListOptions{}
 		for {
 			for _, v := range versions.Items {
 				if v.Version == version {
-					return v.ID, nil
+					return v, nil
 				}
 			}
 
@@ -41,10 +57,133 @@ func fetchTerraformVersionID(version string, client *tfe.Client) (string, error)
 
 			versions, err = client.Admin.TerraformVersions.List(ctx, options)
 			if err != nil {
-				return "", fmt.Errorf("error reading Terraform Versions: %w", err)
+				return nil, fmt.Errorf("error reading Terraform Versions: %w", err)
 			}
 		}
 	}
 
-	return "", fmt.Errorf("terraform version not found")
+	return nil, errTerraformVersionNotFound
+}
+
+// validateTerraformVersion checks that the given Terraform version number is
+// available and enabled. Listing Terraform versions requires an admin token,
+// so the check is skipped when the versions can not be read.
+func validateTerraformVersion(ctx context.Context, version string, client *tfe.Client) error {
+	if version == "latest" {
+		return nil
+	}
+
+	v, err := fetchTerraformVersion(ctx, version, client)
+	switch {
+	case errors.Is(err, errTerraformVersionNotFound):
+		return fmt.Errorf("Terraform version %s is not available", version)
+	case errors.Is(err, tfe.ErrUnauthorized) || errors.Is(err, tfe.ErrResourceNotFound):
+		log.Printf("[DEBUG] Skipping validation of Terraform version %s: %s", version, err)
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if !v.Enabled {
+		return fmt.Errorf("Terraform version %s is disabled", version)
+	}
+	if v.Deprecated {
+		log.Printf("[WARN] Terraform version %s is deprecated", version)
+	}
+
+	return nil
+}
+
+// listTerraformVersions returns all Terraform versions matching the given
+// search string, which may be empty.
+func listTerraformVersions(ctx context.Context, client *tfe.Client, search string) ([]*tfe.AdminTerraformVersion, error) {
+	var versions []*tfe.AdminTerraformVersion
+
+	options := &tfe.AdminTerraformVersionsListOptions{Search: search}
+	for {
+		vl, err := client.Admin.TerraformVersions.List(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("error reading Terraform versions: %w", err)
+		}
+
+		versions = append(versions, vl.Items...)
+
+		// Exit the loop when we've seen all pages.
+		if vl.CurrentPage >= vl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = vl.NextPage
+	}
+
+	return versions, nil
+}
+
+// latestTerraformVersion returns the highest enabled version which is neither
+// a beta nor deprecated and matches the constraints, if any. It returns nil
+// when there is no such version. Versions which are not valid semantic
+// versions are ignored.
+func latestTerraformVersion(versions []*tfe.AdminTerraformVersion, constraints version.Constraints) *tfe.AdminTerraformVersion {
+	var latest *tfe.AdminTerraformVersion
+	var latestVersion *version.Version
+
+	for _, v := range versions {
+		if !v.Enabled || v.Beta || v.Deprecated {
+			continue
+		}
+
+		parsed, err := version.NewVersion(v.Version)
+		if err != nil {
+			log.Printf("[DEBUG] Ignoring Terraform version %s: %s", v.Version, err)
+			continue
+		}
+
+		if constraints != nil && !constraints.Check(parsed) {
+			continue
+		}
+
+		if latestVersion == nil || parsed.GreaterThan(latestVersion) {
+			latest = v
+			latestVersion = parsed
+		}
+	}
+
+	return latest
+}
+
+// isTerraformVersionConstraint reports whether the given Terraform version is
+// a version constraint, like "~> 1.7", rather than an exact version or latest.
+func isTerraformVersionConstraint(v string) bool {
+	if v == "" || v == "latest" {
+		return false
+	}
+
+	if _, err := version.NewVersion(v); err == nil {
+		return false
+	}
+
+	_, err := version.NewConstraint(v)
+	return err == nil
+}
+
+// resolveTerraformVersionConstraint returns the latest Terraform version
+// matching the given version constraint.
+func resolveTerraformVersionConstraint(ctx context.Context, client *tfe.Client, constraint string) (string, error) {
+	constraints, err := version.NewConstraint(constraint)
+	if err != nil {
+		return "", fmt.Errorf("invalid Terraform version constraint %s: %w", constraint, err)
+	}
+
+	versions, err := listTerraformVersions(ctx, client, "")
+	if err != nil {
+		return "", err
+	}
+
+	v := latestTerraformVersion(versions, constraints)
+	if v == nil {
+		return "", fmt.Errorf("no enabled Terraform version matches the constraint %s", constraint)
+	}
+
+	return v.Version, nil
 }
@@ -0,0 +1,81 @@
+package tfe
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+)
+
+func TestIsTerraformVersionConstraint(t *testing.T) {
+	tests := map[string]bool{
+		"":              false,
+		"latest":        false,
+		"1.7.5":         false,
+		"1.7.5-custom":  false,
+		"~> 1.7":        true,
+		">= 1.5, < 2.0": true,
+		"not-a-version": false,
+	}
+
+	for v, want := range tests {
+		if got := isTerraformVersionConstraint(v); got != want {
+			t.Errorf("expected %q to be a constraint is %t, got %t", v, want, got)
+		}
+	}
+}
+
+func TestResolveTerraformVersionConstraint(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/admin/terraform-versions":
+			fmt.Fprint(w, `{"data":[
+				{"id":"tool-1","type":"terraform-versions","attributes":{"version":"1.6.6","enabled":true}},
+				{"id":"tool-2","type":"terraform-versions","attributes":{"version":"1.7.4","enabled":true}},
+				{"id":"tool-3","type":"terraform-versions","attributes":{"version":"1.7.5","enabled":false}},
+				{"id":"tool-4","type":"terraform-versions","attributes":{"version":"1.8.0-beta1","enabled":true,"beta":true}},
+				{"id":"tool-5","type":"terraform-versions","attributes":{"version":"1.8.1","enabled":true}}
+			],"meta":{"pagination":{"current-page":1,"total-pages":1}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	tests := map[string]struct {
+		constraint string
+		version    string
+		err        bool
+	}{
+		"latest patch": {
+			constraint: "~> 1.7.0",
+			version:    "1.7.4",
+		},
+		"latest minor": {
+			constraint: "~> 1.6",
+			version:    "1.8.1",
+		},
+		"range": {
+			constraint: ">= 1.6, < 1.7",
+			version:    "1.6.6",
+		},
+		"no match": {
+			constraint: "~> 2.0",
+			err:        true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			version, err := resolveTerraformVersionConstraint(ctx, client, test.constraint)
+			if (err != nil) != test.err {
+				t.Fatalf("expected error is %t, got %v", test.err, err)
+			}
+			if version != test.version {
+				t.Fatalf("expected version %q, got %q", test.version, version)
+			}
+		})
+	}
+}
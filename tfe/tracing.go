@@ -0,0 +1,299 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	// EnvOTLPEndpoint and EnvOTLPTracesEndpoint enable tracing of API calls
+	// when set. They follow the OpenTelemetry exporter specification, so the
+	// traces endpoint is used as-is and /v1/traces is appended to the
+	// general endpoint.
+	EnvOTLPEndpoint       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	EnvOTLPTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+
+	// EnvOTLPHeaders holds additional headers sent to the collector, as a
+	// comma separated list of key=value pairs.
+	EnvOTLPHeaders = "OTEL_EXPORTER_OTLP_HEADERS"
+
+	// EnvOTELServiceName overrides the service name reported with the spans.
+	EnvOTELServiceName = "OTEL_SERVICE_NAME"
+
+	defaultTracingServiceName = "terraform-provider-tfe"
+
+	// Spans are queued and exported in batches, spans which don't fit in the
+	// queue are dropped rather than slowing down API calls.
+	tracingQueueSize = 1024
+	tracingBatchSize = 128
+)
+
+// otlpTracesDefaultPath is appended to the general OTLP endpoint.
+const otlpTracesDefaultPath = "/v1/traces"
+
+// tracingTransport emits an OpenTelemetry span for each API call. Spans are
+// exported to an OTLP/HTTP collector by a batch span processor, which is
+// flushed when the provider is stopped and shut down when the process exits.
+type tracingTransport struct {
+	delegate http.RoundTripper
+	tracer   trace.Tracer
+}
+
+var (
+	tracerProviderMu sync.Mutex
+	tracerProvider   *sdktrace.TracerProvider
+)
+
+// NewTracingTransport wraps the given transport with a tracer if an OTLP
+// endpoint is configured in the environment. Otherwise the transport is
+// returned as-is.
+func NewTracingTransport(t http.RoundTripper) http.RoundTripper {
+	endpoint := tracingEndpoint()
+	if endpoint == "" {
+		return t
+	}
+
+	tp, err := sharedTracerProvider(endpoint)
+	if err != nil {
+		log.Printf("[WARN] Error setting up the export of API call traces: %s", err)
+		return t
+	}
+
+	return &tracingTransport{
+		delegate: t,
+		tracer:   tp.Tracer(defaultTracingServiceName),
+	}
+}
+
+// sharedTracerProvider returns the tracer provider of the process, creating
+// it on first use. All clients share it, so there is a single queue of spans
+// to flush.
+func sharedTracerProvider(endpoint string) (*sdktrace.TracerProvider, error) {
+	tracerProviderMu.Lock()
+	defer tracerProviderMu.Unlock()
+
+	if tracerProvider != nil {
+		return tracerProvider, nil
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(),
+		otlptracehttp.WithEndpointURL(endpoint),
+		otlptracehttp.WithHeaders(parseOTLPHeaders(os.Getenv(EnvOTLPHeaders))),
+		otlptracehttp.WithTimeout(10*time.Second),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName := os.Getenv(EnvOTELServiceName)
+	if serviceName == "" {
+		serviceName = defaultTracingServiceName
+	}
+
+	log.Printf("[DEBUG] Exporting API call traces to %s", endpoint)
+	tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter,
+			sdktrace.WithMaxQueueSize(tracingQueueSize),
+			sdktrace.WithMaxExportBatchSize(tracingBatchSize),
+		),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+	return tracerProvider, nil
+}
+
+// FlushTracing exports the spans which are still queued.
+func FlushTracing(ctx context.Context) error {
+	tracerProviderMu.Lock()
+	defer tracerProviderMu.Unlock()
+
+	if tracerProvider == nil {
+		return nil
+	}
+	return tracerProvider.ForceFlush(ctx)
+}
+
+// ShutdownTracing exports the spans which are still queued and stops the
+// export of spans. Clients created afterwards set up a new tracer provider.
+func ShutdownTracing(ctx context.Context) error {
+	tracerProviderMu.Lock()
+	defer tracerProviderMu.Unlock()
+
+	if tracerProvider == nil {
+		return nil
+	}
+	err := tracerProvider.Shutdown(ctx)
+	tracerProvider = nil
+	return err
+}
+
+// tracingEndpoint returns the URL spans are exported to, if any.
+func tracingEndpoint() string {
+	if endpoint := os.Getenv(EnvOTLPTracesEndpoint); endpoint != "" {
+		return endpoint
+	}
+	if endpoint := os.Getenv(EnvOTLPEndpoint); endpoint != "" {
+		return strings.TrimSuffix(endpoint, "/") + otlpTracesDefaultPath
+	}
+	return ""
+}
+
+// parseOTLPHeaders parses a list of headers like "key1=value1,key2=value2".
+func parseOTLPHeaders(v string) map[string]string {
+	headers := make(map[string]string)
+	for _, pair := range strings.Split(v, ",") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			continue
+		}
+		headers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return headers
+}
+
+// RoundTrip is a transport method that records the API call as a span. The
+// span is a child of the span of the Terraform operation which made the call.
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attributes := []attribute.KeyValue{
+		attribute.String("http.request.method", req.Method),
+		attribute.String("server.address", req.URL.Host),
+		attribute.String("url.path", req.URL.Path),
+	}
+	if op, ok := req.Context().Value(tracingOperationKey{}).(tracingOperation); ok {
+		attributes = append(attributes,
+			attribute.String("tfe.resource_type", op.resourceType),
+			attribute.String("tfe.operation", op.operation),
+		)
+	}
+
+	ctx, span := t.tracer.Start(req.Context(), req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+	defer span.End()
+
+	// Propagate the trace context, so the API calls can be correlated with
+	// traces of Terraform Enterprise.
+	req = req.Clone(ctx)
+	propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := t.delegate.RoundTrip(req)
+
+	switch {
+	case err != nil:
+		span.SetStatus(codes.Error, err.Error())
+	case resp.StatusCode >= 400:
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		span.SetStatus(codes.Error, resp.Status)
+	default:
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		span.SetStatus(codes.Ok, "")
+	}
+
+	return resp, err
+}
+
+// tracingOperation is the Terraform operation which makes API calls, e.g. a
+// read of a tfe_workspace.
+type tracingOperation struct {
+	resourceType string
+	operation    string
+}
+
+type tracingOperationKey struct{}
+
+// startOperationSpan starts the span of a Terraform operation, which is the
+// parent of the spans of the API calls made with the returned context. The
+// span is a no-op if no OTLP endpoint is configured.
+func startOperationSpan(ctx context.Context, resourceType, operation string) (context.Context, trace.Span) {
+	tracer := noop.NewTracerProvider().Tracer(defaultTracingServiceName)
+	if endpoint := tracingEndpoint(); endpoint != "" {
+		tp, err := sharedTracerProvider(endpoint)
+		if err != nil {
+			log.Printf("[WARN] Error setting up the export of API call traces: %s", err)
+		} else {
+			tracer = tp.Tracer(defaultTracingServiceName)
+		}
+	}
+
+	ctx = context.WithValue(ctx, tracingOperationKey{}, tracingOperation{
+		resourceType: resourceType,
+		operation:    operation,
+	})
+	return tracer.Start(ctx, fmt.Sprintf("%s %s", resourceType, operation),
+		trace.WithAttributes(
+			attribute.String("tfe.resource_type", resourceType),
+			attribute.String("tfe.operation", operation),
+		),
+	)
+}
+
+// endOperationSpan ends the span of a Terraform operation, with an error
+// status if the operation failed.
+func endOperationSpan(span trace.Span, failed bool) {
+	if failed {
+		span.SetStatus(codes.Error, "")
+	}
+	span.End()
+}
+
+// crudContextFunc is the signature of the context aware CRUD functions of
+// the SDK, which are all distinct types.
+type crudContextFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+// traceCRUD wraps a CRUD function of a resource or data source in the span
+// of its operation.
+func traceCRUD(resourceType, operation string, f crudContextFunc) crudContextFunc {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx, span := startOperationSpan(ctx, resourceType, operation)
+		diags := f(ctx, d, meta)
+		endOperationSpan(span, diags.HasError())
+		return diags
+	}
+}
+
+// traceResource wraps the CRUD and import functions of a resource or data
+// source in spans named after the resource type and the operation.
+func traceResource(resourceType string, r *schema.Resource) {
+	r.CreateContext = traceCRUD(resourceType, "create", r.CreateContext)
+	r.ReadContext = traceCRUD(resourceType, "read", r.ReadContext)
+	r.UpdateContext = traceCRUD(resourceType, "update", r.UpdateContext)
+	r.DeleteContext = traceCRUD(resourceType, "delete", r.DeleteContext)
+
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		importState := r.Importer.StateContext
+		r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			ctx, span := startOperationSpan(ctx, resourceType, "import")
+			result, err := importState(ctx, d, meta)
+			endOperationSpan(span, err != nil)
+			return result, err
+		}
+	}
+}
+
+// crudContext adapts a CRUD function which returns an error to the context
+// aware CRUD functions of the SDK.
+func crudContext(f func(context.Context, *schema.ResourceData, interface{}) error) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return diag.FromErr(f(ctx, d, meta))
+	}
+}
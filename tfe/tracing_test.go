@@ -0,0 +1,166 @@
+package tfe
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseOTLPHeaders(t *testing.T) {
+	headers := parseOTLPHeaders("api-key=secret, x-team = platform,invalid")
+	if len(headers) != 2 || headers["api-key"] != "secret" || headers["x-team"] != "platform" {
+		t.Fatalf("unexpected headers: %v", headers)
+	}
+}
+
+func TestTracingTransport(t *testing.T) {
+	requests := make(chan *collectortrace.ExportTraceServiceRequest, 1)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" || r.Header.Get("api-key") != "secret" {
+			t.Errorf("unexpected export request: %s %v", r.URL, r.Header)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("unexpected export body: %v", err)
+		}
+		req := &collectortrace.ExportTraceServiceRequest{}
+		if err := proto.Unmarshal(body, req); err != nil {
+			t.Errorf("unexpected export body: %v", err)
+		}
+		requests <- req
+	}))
+	defer collector.Close()
+
+	var traceparent string
+	api := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	t.Setenv(EnvOTLPTracesEndpoint, "")
+	t.Setenv(EnvOTLPEndpoint, collector.URL+"/")
+	t.Setenv(EnvOTLPHeaders, "api-key=secret")
+	t.Cleanup(func() { ShutdownTracing(context.Background()) })
+
+	client := &http.Client{Transport: NewTracingTransport(http.DefaultTransport)}
+	r := &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, api.URL+"/api/v2/workspaces/ws-123", nil)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			resp.Body.Close()
+			return diag.Errorf("Error reading workspace: %s", resp.Status)
+		},
+	}
+	traceResource("tfe_workspace", r)
+
+	if diags := r.ReadContext(context.Background(), nil, nil); !diags.HasError() {
+		t.Fatal("expected the read to fail")
+	}
+
+	// Stopping the provider exports the queued spans right away.
+	if _, err := PluginProviderServer().StopProvider(context.Background(), &tfprotov5.StopProviderRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var req *collectortrace.ExportTraceServiceRequest
+	select {
+	case req = <-requests:
+	default:
+		t.Fatal("expected spans to be exported when the provider is stopped")
+	}
+
+	spans := make(map[string]*tracev1.Span)
+	for _, s := range req.ResourceSpans[0].ScopeSpans[0].Spans {
+		spans[s.Name] = s
+	}
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+
+	operation, call := spans["tfe_workspace read"], spans["GET"]
+	if operation == nil || call == nil {
+		t.Fatalf("unexpected spans: %v", spans)
+	}
+	if operation.Status.Code != tracev1.Status_STATUS_CODE_ERROR || call.Status.Code != tracev1.Status_STATUS_CODE_ERROR {
+		t.Fatalf("expected error statuses for a failed read, got %s and %s", operation.Status.Code, call.Status.Code)
+	}
+	if string(call.ParentSpanId) != string(operation.SpanId) {
+		t.Fatal("expected the API call to be a child of the read")
+	}
+	if !strings.Contains(traceparent, hex.EncodeToString(call.TraceId)+"-"+hex.EncodeToString(call.SpanId)) {
+		t.Fatalf("expected the trace context to be propagated, got %q", traceparent)
+	}
+
+	attributes := make(map[string]string)
+	for _, a := range call.Attributes {
+		switch v := a.Value.Value.(type) {
+		case *commonv1.AnyValue_StringValue:
+			attributes[a.Key] = v.StringValue
+		case *commonv1.AnyValue_IntValue:
+			attributes[a.Key] = strconv.FormatInt(v.IntValue, 10)
+		}
+	}
+	if attributes["tfe.resource_type"] != "tfe_workspace" || attributes["tfe.operation"] != "read" || attributes["http.response.status_code"] != "404" {
+		t.Fatalf("unexpected attributes: %v", attributes)
+	}
+}
+
+func TestShutdownTracing(t *testing.T) {
+	exported := make(chan struct{}, 1)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		exported <- struct{}{}
+	}))
+	defer collector.Close()
+
+	api := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	t.Setenv(EnvOTLPEndpoint, "")
+	t.Setenv(EnvOTLPTracesEndpoint, collector.URL+"/v1/traces")
+
+	client := &http.Client{Transport: NewTracingTransport(http.DefaultTransport)}
+	resp, err := client.Get(api.URL + "/api/v2/ping")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if err := ShutdownTracing(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-exported:
+	default:
+		t.Fatal("expected spans to be exported on shutdown")
+	}
+}
+
+func TestNewTracingTransport_disabled(t *testing.T) {
+	t.Setenv(EnvOTLPEndpoint, "")
+	t.Setenv(EnvOTLPTracesEndpoint, "")
+
+	if transport := NewTracingTransport(http.DefaultTransport); transport != http.DefaultTransport {
+		t.Fatalf("expected the transport to be returned as-is, got %T", transport)
+	}
+}
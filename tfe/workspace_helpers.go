@@ -3,6 +3,7 @@ package tfe
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
@@ -10,7 +11,7 @@ import (
 
 // fetchWorkspaceExternalID returns the external id for a workspace
 // when given a workspace id of the form ORGANIZATION_AME/WORKSPACE_NAME
-func fetchWorkspaceExternalID(id string, client *tfe.Client) (string, error) {
+func fetchWorkspaceExternalID(ctx context.Context, id string, client *tfe.Client) (string, error) {
 	orgName, wsName, err := unpackWorkspaceID(id)
 	if err != nil {
 		return "", fmt.Errorf("Error unpacking workspace ID: %w", err)
@@ -30,7 +31,7 @@ type workspaceIDReader interface {
 
 // fetchWorkspaceHumanID returns the human readable id "org/workspace"
 // when given a workspace external id
-func fetchWorkspaceHumanID(id string, r workspaceIDReader) (string, error) {
+func fetchWorkspaceHumanID(ctx context.Context, id string, r workspaceIDReader) (string, error) {
 	workspace, err := r.ReadByID(ctx, id)
 	if err != nil {
 		return "", fmt.Errorf("Error reading configuration of workspace %s: %w", id, err)
@@ -66,7 +67,7 @@ func unpackWorkspaceID(id string) (organization, name string, err error) {
 	return s[0], s[1], nil
 }
 
-func readWorkspaceStateConsumers(id string, client *tfe.Client) (bool, []interface{}, error) {
+func readWorkspaceStateConsumers(ctx context.Context, id string, client *tfe.Client) (bool, []interface{}, error) {
 	options := &tfe.RemoteStateConsumersListOptions{ListOptions: tfe.ListOptions{PageSize: 100}}
 	var remoteStateConsumerIDs []interface{}
 
@@ -104,3 +105,281 @@ func readWorkspaceStateConsumers(id string, client *tfe.Client) (bool, []interfa
 
 	return false, remoteStateConsumerIDs, nil
 }
+
+// workspaceLock mirrors the lock of a workspace. The locked-by relationship,
+// pointing to the run, user or team holding the lock, is not exposed by
+// go-tfe.
+type workspaceLock struct {
+	Data struct {
+		Attributes struct {
+			Locked bool `json:"locked"`
+		} `json:"attributes"`
+		Relationships struct {
+			LockedBy struct {
+				Data *struct {
+					ID   string `json:"id"`
+					Type string `json:"type"`
+				} `json:"data"`
+			} `json:"locked-by"`
+		} `json:"relationships"`
+	} `json:"data"`
+}
+
+// fetchWorkspaceLock returns whether a workspace is locked, and the ID of the
+// run, user or team holding the lock.
+func fetchWorkspaceLock(ctx context.Context, client *tfe.Client, workspaceID string) (bool, string, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("workspaces/%s", url.QueryEscape(workspaceID)), nil)
+	if err != nil {
+		return false, "", err
+	}
+
+	lock := &workspaceLock{}
+	if err := req.DoJSON(ctx, lock); err != nil {
+		return false, "", err
+	}
+
+	lockedBy := ""
+	if lock.Data.Relationships.LockedBy.Data != nil {
+		lockedBy = lock.Data.Relationships.LockedBy.Data.ID
+	}
+
+	return lock.Data.Attributes.Locked, lockedBy, nil
+}
+
+// workspaceAssociations holds the objects attached to a workspace which are
+// managed by their own resources.
+type workspaceAssociations struct {
+	Variables                  []*tfe.Variable
+	NotificationConfigurations []*tfe.NotificationConfiguration
+	TeamAccesses               []*tfe.TeamAccess
+	RunTasks                   []*tfe.WorkspaceRunTask
+}
+
+// fetchWorkspaceAssociations lists the variables, notification
+// configurations, team accesses and run tasks of a workspace. The run task
+// of each workspace run task is read, so that its name is available.
+func fetchWorkspaceAssociations(ctx context.Context, client *tfe.Client, workspaceID string) (*workspaceAssociations, error) {
+	associations := &workspaceAssociations{}
+
+	variableOptions := &tfe.VariableListOptions{}
+	for {
+		vl, err := client.Variables.List(ctx, workspaceID, variableOptions)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving variables of workspace %s: %w", workspaceID, err)
+		}
+
+		associations.Variables = append(associations.Variables, vl.Items...)
+
+		// Exit the loop when we've seen all pages.
+		if vl.CurrentPage >= vl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		variableOptions.PageNumber = vl.NextPage
+	}
+
+	notificationConfigurations, err := listWorkspaceNotificationConfigurations(ctx, client, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	associations.NotificationConfigurations = notificationConfigurations
+
+	teamAccesses, err := listWorkspaceTeamAccesses(ctx, client, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	associations.TeamAccesses = teamAccesses
+
+	runTaskOptions := &tfe.WorkspaceRunTaskListOptions{}
+	for {
+		rl, err := client.WorkspaceRunTasks.List(ctx, workspaceID, runTaskOptions)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving run tasks of workspace %s: %w", workspaceID, err)
+		}
+
+		associations.RunTasks = append(associations.RunTasks, rl.Items...)
+
+		// Exit the loop when we've seen all pages.
+		if rl.CurrentPage >= rl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		runTaskOptions.PageNumber = rl.NextPage
+	}
+
+	for _, wrt := range associations.RunTasks {
+		if wrt.RunTask == nil {
+			continue
+		}
+
+		task, err := client.RunTasks.Read(ctx, wrt.RunTask.ID)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving run task %s: %w", wrt.RunTask.ID, err)
+		}
+		wrt.RunTask = task
+	}
+
+	return associations, nil
+}
+
+// listWorkspaceTeamAccesses returns all team access grants of a workspace.
+func listWorkspaceTeamAccesses(ctx context.Context, client *tfe.Client, workspaceID string) ([]*tfe.TeamAccess, error) {
+	var teamAccesses []*tfe.TeamAccess
+
+	options := &tfe.TeamAccessListOptions{WorkspaceID: workspaceID}
+	for {
+		l, err := client.TeamAccess.List(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving team access of workspace %s: %w", workspaceID, err)
+		}
+
+		teamAccesses = append(teamAccesses, l.Items...)
+
+		// Exit the loop when we've seen all pages.
+		if l.CurrentPage >= l.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = l.NextPage
+	}
+
+	return teamAccesses, nil
+}
+
+// listOrganizationWorkspaceIDs returns the IDs of all workspaces of an
+// organization.
+func listOrganizationWorkspaceIDs(ctx context.Context, client *tfe.Client, organization string) ([]string, error) {
+	var ids []string
+
+	options := &tfe.WorkspaceListOptions{}
+	for {
+		wl, err := client.Workspaces.List(ctx, organization, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving workspaces of organization %s: %w", organization, err)
+		}
+
+		for _, w := range wl.Items {
+			ids = append(ids, w.ID)
+		}
+
+		// Exit the loop when we've seen all pages.
+		if wl.CurrentPage >= wl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = wl.NextPage
+	}
+
+	return ids, nil
+}
+
+// importIDs returns the import IDs of the associated objects by resource
+// type, for the workspace with the given organization and name.
+func (a *workspaceAssociations) importIDs(organization, workspace string) map[string][]string {
+	ids := make(map[string][]string)
+
+	for _, v := range a.Variables {
+		ids["tfe_variable"] = append(ids["tfe_variable"], fmt.Sprintf("%s/%s/%s", organization, workspace, v.ID))
+	}
+	for _, nc := range a.NotificationConfigurations {
+		ids["tfe_notification_configuration"] = append(ids["tfe_notification_configuration"], nc.ID)
+	}
+	for _, ta := range a.TeamAccesses {
+		ids["tfe_team_access"] = append(ids["tfe_team_access"], fmt.Sprintf("%s/%s/%s", organization, workspace, ta.ID))
+	}
+	for _, wrt := range a.RunTasks {
+		if wrt.RunTask == nil {
+			continue
+		}
+		ids["tfe_workspace_run_task"] = append(ids["tfe_workspace_run_task"], fmt.Sprintf("%s/%s/%s", organization, workspace, wrt.RunTask.Name))
+	}
+
+	return ids
+}
+
+// workspaceAutoDestroyOptions updates when the resources of a workspace are
+// automatically destroyed, which is not exposed by
+// tfe.WorkspaceUpdateOptions. Unset settings are sent as null to clear them.
+type workspaceAutoDestroyOptions struct {
+	Type                        string  `jsonapi:"primary,workspaces"`
+	AutoDestroyAt               *string `jsonapi:"attr,auto-destroy-at"`
+	AutoDestroyActivityDuration *string `jsonapi:"attr,auto-destroy-activity-duration"`
+}
+
+// workspaceAttributes holds the raw attributes of a workspace, so that
+// settings which are missing on older releases of Terraform Enterprise can be
+// detected.
+type workspaceAttributes struct {
+	Data struct {
+		Attributes map[string]interface{} `json:"attributes"`
+	} `json:"data"`
+}
+
+// workspaceAutoDestroy is the auto-destroy schedule of a workspace.
+type workspaceAutoDestroy struct {
+	At               string
+	ActivityDuration string
+}
+
+// readWorkspaceAutoDestroy returns when the resources of a workspace are
+// automatically destroyed, and whether the setting is supported at all.
+func readWorkspaceAutoDestroy(ctx context.Context, client *tfe.Client, workspaceID string) (autoDestroy workspaceAutoDestroy, supported bool, err error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("workspaces/%s", url.QueryEscape(workspaceID)), nil)
+	if err != nil {
+		return autoDestroy, false, err
+	}
+
+	ws := &workspaceAttributes{}
+	if err := req.DoJSON(ctx, ws); err != nil {
+		return autoDestroy, false, err
+	}
+
+	at, ok := ws.Data.Attributes["auto-destroy-at"]
+	if !ok {
+		return autoDestroy, false, nil
+	}
+
+	autoDestroy.At, _ = at.(string)
+	autoDestroy.ActivityDuration, _ = ws.Data.Attributes["auto-destroy-activity-duration"].(string)
+	return autoDestroy, true, nil
+}
+
+// updateWorkspaceAutoDestroy sets when the resources of a workspace are
+// automatically destroyed, failing when the setting is not supported.
+func updateWorkspaceAutoDestroy(ctx context.Context, client *tfe.Client, workspaceID string, autoDestroy workspaceAutoDestroy) error {
+	_, supported, err := readWorkspaceAutoDestroy(ctx, client, workspaceID)
+	if err != nil {
+		return fmt.Errorf("Error reading auto-destroy settings of workspace %s: %w", workspaceID, err)
+	}
+	if !supported {
+		return fmt.Errorf("auto_destroy_at and auto_destroy_activity_duration are not supported by this version of Terraform Enterprise")
+	}
+
+	options := &workspaceAutoDestroyOptions{}
+	if autoDestroy.At != "" {
+		options.AutoDestroyAt = tfe.String(autoDestroy.At)
+	}
+	if autoDestroy.ActivityDuration != "" {
+		options.AutoDestroyActivityDuration = tfe.String(autoDestroy.ActivityDuration)
+	}
+
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("workspaces/%s", url.QueryEscape(workspaceID)), options)
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
+
+// workspaceHTMLURL returns the URL of a workspace in the UI, preferring the
+// link returned by the API.
+func workspaceHTMLURL(config ConfiguredClient, ws *tfe.Workspace) string {
+	if link, ok := ws.Links["self-html"].(string); ok && link != "" {
+		return config.htmlURL(link)
+	}
+	return config.htmlURL(fmt.Sprintf("/app/%s/workspaces/%s", url.PathEscape(ws.Organization.Name), url.PathEscape(ws.Name)))
+}
@@ -2,9 +2,14 @@ package tfe
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-provider-tfe/testhelper"
 )
 
 func TestFetchWorkspaceExternalID(t *testing.T) {
@@ -38,7 +43,7 @@ func TestFetchWorkspaceExternalID(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			got, err := fetchWorkspaceExternalID(test.def, client)
+			got, err := fetchWorkspaceExternalID(ctx, test.def, client)
 
 			if (err != nil) != test.err {
 				t.Fatalf("expected error is %t, got %v", test.err, err)
@@ -88,7 +93,7 @@ func TestFetchWorkspaceHumanID(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			got, err := fetchWorkspaceHumanID(test.def, reader)
+			got, err := fetchWorkspaceHumanID(ctx, test.def, reader)
 
 			if (err != nil) != test.err {
 				t.Fatalf("expected error is %t, got %v", test.err, err)
@@ -213,7 +218,7 @@ func TestReadWorkspaceStateConsumers(t *testing.T) {
 			defaultWorkspaceID:           "ws-123",
 			remoteStateConsumersResponse: tc.remoteStateConsumersResponse,
 		})
-		actualGlobalRemoteState, actualRemoteStateConsumerIds, err := readWorkspaceStateConsumers("ws-123", client)
+		actualGlobalRemoteState, actualRemoteStateConsumerIds, err := readWorkspaceStateConsumers(ctx, "ws-123", client)
 		if (err != nil) != tc.err {
 			t.Fatalf("expected error is %t, got %v", tc.err, err)
 		}
@@ -227,3 +232,159 @@ func TestReadWorkspaceStateConsumers(t *testing.T) {
 		}
 	}
 }
+
+func TestFetchWorkspaceLock(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-locked":
+			fmt.Fprint(w, `{"data":{"id":"ws-locked","type":"workspaces","attributes":{"locked":true},"relationships":{"locked-by":{"data":{"id":"run-123","type":"runs"}}}}}`)
+		case "/api/v2/workspaces/ws-unlocked":
+			fmt.Fprint(w, `{"data":{"id":"ws-unlocked","type":"workspaces","attributes":{"locked":false},"relationships":{"locked-by":{"data":null}}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	tests := map[string]struct {
+		id       string
+		locked   bool
+		lockedBy string
+		err      bool
+	}{
+		"locked by a run": {
+			id:       "ws-locked",
+			locked:   true,
+			lockedBy: "run-123",
+		},
+		"unlocked": {
+			id: "ws-unlocked",
+		},
+		"non existing workspace": {
+			id:  "ws-missing",
+			err: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			locked, lockedBy, err := fetchWorkspaceLock(ctx, client, test.id)
+			if (err != nil) != test.err {
+				t.Fatalf("expected error is %t, got %v", test.err, err)
+			}
+			if locked != test.locked || lockedBy != test.lockedBy {
+				t.Fatalf("expected locked %t by %q, got %t by %q", test.locked, test.lockedBy, locked, lockedBy)
+			}
+		})
+	}
+}
+
+func TestFetchWorkspaceAssociations(t *testing.T) {
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pagination := `"meta":{"pagination":{"current-page":1,"total-pages":1}}`
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-123/vars":
+			fmt.Fprintf(w, `{"data":[{"id":"var-1","type":"vars","attributes":{"key":"region","category":"terraform"}}],%s}`, pagination)
+		case "/api/v2/workspaces/ws-123/notification-configurations":
+			fmt.Fprintf(w, `{"data":[{"id":"nc-1","type":"notification-configurations","attributes":{"name":"slack"}}],%s}`, pagination)
+		case "/api/v2/team-workspaces":
+			if r.URL.Query().Get("filter[workspace][id]") != "ws-123" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			fmt.Fprintf(w, `{"data":[{"id":"tws-1","type":"team-workspaces","attributes":{"access":"write"},"relationships":{"team":{"data":{"id":"team-1","type":"teams"}}}}],%s}`, pagination)
+		case "/api/v2/workspaces/ws-123/tasks":
+			fmt.Fprintf(w, `{"data":[{"id":"wstask-1","type":"workspace-tasks","attributes":{"enforcement-level":"advisory"},"relationships":{"task":{"data":{"id":"task-1","type":"tasks"}}}}],%s}`, pagination)
+		case "/api/v2/tasks/task-1":
+			fmt.Fprint(w, `{"data":{"id":"task-1","type":"tasks","attributes":{"name":"scanner"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	associations, err := fetchWorkspaceAssociations(ctx, client, "ws-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := associations.importIDs("hashicorp", "a-workspace")
+	want := map[string][]string{
+		"tfe_variable":                   {"hashicorp/a-workspace/var-1"},
+		"tfe_notification_configuration": {"nc-1"},
+		"tfe_team_access":                {"hashicorp/a-workspace/tws-1"},
+		"tfe_workspace_run_task":         {"hashicorp/a-workspace/scanner"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("wrong import IDs\ngot: %#v\nwant: %#v", got, want)
+	}
+	for resourceType, ids := range want {
+		if len(got[resourceType]) != 1 || got[resourceType][0] != ids[0] {
+			t.Fatalf("wrong import IDs for %s\ngot: %#v\nwant: %#v", resourceType, got[resourceType], ids)
+		}
+	}
+
+	if _, err := fetchWorkspaceAssociations(ctx, client, "ws-missing"); err == nil {
+		t.Fatal("expected an error listing the objects of a non existing workspace")
+	}
+}
+
+func TestUpdateWorkspaceAutoDestroy(t *testing.T) {
+	var body string
+	server := testhelper.NewFixtureServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-supported":
+			if r.Method == "PATCH" {
+				b, _ := io.ReadAll(r.Body)
+				body = string(b)
+			}
+			fmt.Fprint(w, `{"data":{"id":"ws-supported","type":"workspaces","attributes":{"auto-destroy-at":"2030-01-01T00:00:00.000Z","auto-destroy-activity-duration":null}}}`)
+		case "/api/v2/workspaces/ws-unsupported":
+			fmt.Fprint(w, `{"data":{"id":"ws-unsupported","type":"workspaces","attributes":{"name":"a-workspace"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := server.Client
+
+	autoDestroy, supported, err := readWorkspaceAutoDestroy(ctx, client, "ws-supported")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !supported || autoDestroy.At != "2030-01-01T00:00:00.000Z" || autoDestroy.ActivityDuration != "" {
+		t.Fatalf("wrong auto-destroy settings: %#v (supported %t)", autoDestroy, supported)
+	}
+
+	err = updateWorkspaceAutoDestroy(ctx, client, "ws-supported", workspaceAutoDestroy{ActivityDuration: "14d"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(body, `"auto-destroy-activity-duration":"14d"`) || !strings.Contains(body, `"auto-destroy-at":null`) {
+		t.Fatalf("expected the activity duration to be set and the timestamp to be cleared, got %s", body)
+	}
+
+	err = updateWorkspaceAutoDestroy(ctx, client, "ws-unsupported", workspaceAutoDestroy{ActivityDuration: "14d"})
+	if err == nil {
+		t.Fatal("expected an error updating auto-destroy settings of an unsupported workspace")
+	}
+}
+
+func TestWorkspaceHTMLURL(t *testing.T) {
+	config := ConfiguredClient{Hostname: "tfe.example.com"}
+
+	ws := &tfe.Workspace{
+		Name:         "my workspace",
+		Organization: &tfe.Organization{Name: "my-org"},
+	}
+	if u := workspaceHTMLURL(config, ws); u != "https://tfe.example.com/app/my-org/workspaces/my%20workspace" {
+		t.Fatalf("unexpected URL: %s", u)
+	}
+
+	ws.Links = map[string]interface{}{"self-html": "/app/my-org/workspaces/my-workspace"}
+	if u := workspaceHTMLURL(config, ws); u != "https://tfe.example.com/app/my-org/workspaces/my-workspace" {
+		t.Fatalf("unexpected URL: %s", u)
+	}
+}
@@ -0,0 +1,161 @@
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// workspaceProjectMovedFromKey is the key of the private state of a workspace
+// which holds the project it was moved from outside of Terraform.
+const workspaceProjectMovedFromKey = "project_moved_from"
+
+// workspaceProjectMoveServer wraps the terraform-plugin-sdk provider server to
+// warn about workspaces moved to another project outside of Terraform. A move
+// is only seen while refreshing the workspace, when the configuration is not
+// known, so the previous project is kept in the private state of the
+// workspace until it is planned.
+type workspaceProjectMoveServer struct {
+	tfprotov5.ProviderServer
+
+	workspaceType cty.Type
+}
+
+func newWorkspaceProjectMoveServer(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return workspaceProjectMoveServer{
+		ProviderServer: server,
+		workspaceType:  resourceTFEWorkspace().CoreConfigSchema().ImpliedType(),
+	}
+}
+
+func (s workspaceProjectMoveServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	if err != nil || req.TypeName != "tfe_workspace" || resp.NewState == nil {
+		return resp, err
+	}
+
+	previous, err := s.workspaceValue(req.CurrentState)
+	if err != nil {
+		return resp, nil
+	}
+	current, err := s.workspaceValue(resp.NewState)
+	if err != nil {
+		return resp, nil
+	}
+
+	previousProjectID := stringAttr(previous, "project_id")
+	projectID := stringAttr(current, "project_id")
+	if previousProjectID == "" || projectID == "" || previousProjectID == projectID {
+		return resp, nil
+	}
+
+	log.Printf("[WARN] Workspace %s was moved from project %s to project %s", stringAttr(current, "id"), previousProjectID, projectID)
+
+	private := make(map[string]interface{})
+	if len(resp.Private) > 0 {
+		if err := json.Unmarshal(resp.Private, &private); err != nil {
+			return resp, nil
+		}
+	}
+	private[workspaceProjectMovedFromKey] = previousProjectID
+
+	if b, err := json.Marshal(private); err == nil {
+		resp.Private = b
+	}
+
+	return resp, nil
+}
+
+func (s workspaceProjectMoveServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || req.TypeName != "tfe_workspace" || len(req.PriorPrivate) == 0 {
+		return resp, err
+	}
+
+	private := make(map[string]interface{})
+	if err := json.Unmarshal(req.PriorPrivate, &private); err != nil {
+		return resp, nil
+	}
+	movedFrom, _ := private[workspaceProjectMovedFromKey].(string)
+	if movedFrom == "" {
+		return resp, nil
+	}
+
+	config, err := s.workspaceValue(req.Config)
+	if err != nil {
+		return resp, nil
+	}
+	prior, err := s.workspaceValue(req.PriorState)
+	if err != nil {
+		return resp, nil
+	}
+
+	if diag := workspaceProjectMoveDiagnostic(prior, config, movedFrom); diag != nil {
+		resp.Diagnostics = append(resp.Diagnostics, diag)
+	}
+
+	return resp, nil
+}
+
+// workspaceProjectMoveDiagnostic returns a warning when the configuration of
+// a workspace moves it back to the project it was moved from outside of
+// Terraform. Nothing is moved back when project_id is not configured, or when
+// ignore_project_changes is set.
+func workspaceProjectMoveDiagnostic(prior, config cty.Value, movedFrom string) *tfprotov5.Diagnostic {
+	if prior.IsNull() || config.IsNull() {
+		return nil
+	}
+
+	configured := config.GetAttr("project_id")
+	if !configured.IsKnown() || configured.IsNull() || configured.AsString() != movedFrom {
+		return nil
+	}
+
+	ignore := config.GetAttr("ignore_project_changes")
+	if ignore.IsKnown() && !ignore.IsNull() && ignore.True() {
+		return nil
+	}
+
+	projectID := stringAttr(prior, "project_id")
+	if projectID == "" || projectID == movedFrom {
+		return nil
+	}
+
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  "Workspace was moved to another project outside of Terraform",
+		Detail: fmt.Sprintf(
+			"Workspace %s was moved from project %s to project %s. If the move is intended, "+
+				"set project_id = %q in the configuration to keep the workspace in its new project, "+
+				"otherwise this apply moves it back to project %s.",
+			stringAttr(prior, "id"), movedFrom, projectID, projectID, movedFrom),
+		Attribute: tftypes.NewAttributePath().WithAttributeName("project_id"),
+	}
+}
+
+// workspaceValue decodes a workspace configuration, plan or state.
+func (s workspaceProjectMoveServer) workspaceValue(v *tfprotov5.DynamicValue) (cty.Value, error) {
+	if v == nil || len(v.MsgPack) == 0 {
+		return cty.NullVal(s.workspaceType), nil
+	}
+	return msgpack.Unmarshal(v.MsgPack, s.workspaceType)
+}
+
+// stringAttr returns a string attribute of an object, or an empty string when
+// the object or the attribute is null or unknown.
+func stringAttr(v cty.Value, name string) string {
+	if v.IsNull() || !v.IsKnown() {
+		return ""
+	}
+	attr := v.GetAttr(name)
+	if attr.IsNull() || !attr.IsKnown() {
+		return ""
+	}
+	return attr.AsString()
+}
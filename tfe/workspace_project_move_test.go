@@ -0,0 +1,164 @@
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+type testWorkspaceServer struct {
+	tfprotov5.ProviderServer
+
+	newState *tfprotov5.DynamicValue
+}
+
+func (s testWorkspaceServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	return &tfprotov5.ReadResourceResponse{NewState: s.newState}, nil
+}
+
+func (s testWorkspaceServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	return &tfprotov5.PlanResourceChangeResponse{PlannedState: req.ProposedNewState}, nil
+}
+
+func testWorkspaceValue(t *testing.T, attrs map[string]cty.Value) *tfprotov5.DynamicValue {
+	ty := resourceTFEWorkspace().CoreConfigSchema().ImpliedType()
+
+	values := make(map[string]cty.Value)
+	for name, attrType := range ty.AttributeTypes() {
+		if v, ok := attrs[name]; ok {
+			values[name] = v
+			continue
+		}
+		values[name] = cty.NullVal(attrType)
+	}
+
+	b, err := msgpack.Marshal(cty.ObjectVal(values), ty)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return &tfprotov5.DynamicValue{MsgPack: b}
+}
+
+func TestWorkspaceProjectMoveServer_ReadResource(t *testing.T) {
+	cases := map[string]struct {
+		previous  string
+		movedFrom string
+	}{
+		"moved": {
+			previous:  "prj-old",
+			movedFrom: "prj-old",
+		},
+		"not moved": {
+			previous: "prj-new",
+		},
+		"imported": {},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := newWorkspaceProjectMoveServer(testWorkspaceServer{
+				newState: testWorkspaceValue(t, map[string]cty.Value{
+					"id":         cty.StringVal("ws-123"),
+					"project_id": cty.StringVal("prj-new"),
+				}),
+			})
+
+			current := map[string]cty.Value{"id": cty.StringVal("ws-123")}
+			if tc.previous != "" {
+				current["project_id"] = cty.StringVal(tc.previous)
+			}
+
+			resp, err := server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+				TypeName:     "tfe_workspace",
+				CurrentState: testWorkspaceValue(t, current),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var movedFrom string
+			if len(resp.Private) > 0 {
+				private := make(map[string]interface{})
+				if err := json.Unmarshal(resp.Private, &private); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				movedFrom, _ = private[workspaceProjectMovedFromKey].(string)
+			}
+			if movedFrom != tc.movedFrom {
+				t.Fatalf("expected the workspace to be moved from %q, got %q", tc.movedFrom, movedFrom)
+			}
+		})
+	}
+}
+
+func TestWorkspaceProjectMoveServer_PlanResourceChange(t *testing.T) {
+	cases := map[string]struct {
+		projectID cty.Value
+		ignore    bool
+		warning   bool
+	}{
+		"moved back": {
+			projectID: cty.StringVal("prj-old"),
+			warning:   true,
+		},
+		"not configured": {
+			projectID: cty.NullVal(cty.String),
+		},
+		"moved elsewhere": {
+			projectID: cty.StringVal("prj-other"),
+		},
+		"unknown": {
+			projectID: cty.UnknownVal(cty.String),
+		},
+		"ignore_project_changes": {
+			projectID: cty.StringVal("prj-old"),
+			ignore:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := newWorkspaceProjectMoveServer(testWorkspaceServer{})
+
+			private, err := json.Marshal(map[string]interface{}{workspaceProjectMovedFromKey: "prj-old"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			resp, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName: "tfe_workspace",
+				PriorState: testWorkspaceValue(t, map[string]cty.Value{
+					"id":         cty.StringVal("ws-123"),
+					"project_id": cty.StringVal("prj-new"),
+				}),
+				Config: testWorkspaceValue(t, map[string]cty.Value{
+					"project_id":             tc.projectID,
+					"ignore_project_changes": cty.BoolVal(tc.ignore),
+				}),
+				PriorPrivate: private,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !tc.warning {
+				if len(resp.Diagnostics) != 0 {
+					t.Fatalf("expected no diagnostics, got: %#v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Severity != tfprotov5.DiagnosticSeverityWarning {
+				t.Fatalf("expected a single warning, got: %#v", resp.Diagnostics)
+			}
+			if !strings.Contains(resp.Diagnostics[0].Detail, `project_id = "prj-new"`) {
+				t.Fatalf("expected the warning to suggest the new project, got: %s", resp.Diagnostics[0].Detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package tfe
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// writeOnlyValue returns the configured value of a write-only argument. The
+// value is never stored in the plan or the state, so it can only be read from
+// the configuration while creating or updating a resource.
+func writeOnlyValue(d *schema.ResourceData, key string) (string, error) {
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath(key))
+	if diags.HasError() {
+		return "", fmt.Errorf("Error reading %s: %s", key, diags[0].Detail)
+	}
+	if v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+		return "", nil
+	}
+	return v.AsString(), nil
+}
+
+// valueOrWriteOnly returns the configured value of the write-only variant
+// <key>_wo of an argument when it is set, and the value of the argument
+// itself otherwise.
+func valueOrWriteOnly(d *schema.ResourceData, key string) (string, error) {
+	v, err := writeOnlyValue(d, key+"_wo")
+	if err != nil || v != "" {
+		return v, err
+	}
+	return d.Get(key).(string), nil
+}
+
+// writeOnlyConfigured returns whether a write-only argument is set in the
+// configuration of a resource which is planned.
+func writeOnlyConfigured(d *schema.ResourceDiff, key string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute(key) {
+		return false
+	}
+	return !config.GetAttr(key).IsNull()
+}